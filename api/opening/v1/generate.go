@@ -0,0 +1,5 @@
+// Package openingv1 holds the protobuf messages and gRPC client and server
+// of the opening service.
+package openingv1
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative opening.proto
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: opening.proto
+
+package openingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OpeningService_CreateOpening_FullMethodName = "/opening.v1.OpeningService/CreateOpening"
+	OpeningService_GetOpening_FullMethodName    = "/opening.v1.OpeningService/GetOpening"
+	OpeningService_UpdateOpening_FullMethodName = "/opening.v1.OpeningService/UpdateOpening"
+	OpeningService_DeleteOpening_FullMethodName = "/opening.v1.OpeningService/DeleteOpening"
+	OpeningService_ListOpenings_FullMethodName  = "/opening.v1.OpeningService/ListOpenings"
+)
+
+// OpeningServiceClient is the client API for OpeningService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OpeningService exposes the operations of the REST API on openings.
+// Errors use the usual status codes: INVALID_ARGUMENT for a rejected
+// request, NOT_FOUND for a missing opening and INTERNAL otherwise.
+type OpeningServiceClient interface {
+	CreateOpening(ctx context.Context, in *CreateOpeningRequest, opts ...grpc.CallOption) (*Opening, error)
+	GetOpening(ctx context.Context, in *GetOpeningRequest, opts ...grpc.CallOption) (*Opening, error)
+	UpdateOpening(ctx context.Context, in *UpdateOpeningRequest, opts ...grpc.CallOption) (*Opening, error)
+	DeleteOpening(ctx context.Context, in *DeleteOpeningRequest, opts ...grpc.CallOption) (*DeleteOpeningResponse, error)
+	// ListOpenings streams the openings matching the filter, oldest first.
+	ListOpenings(ctx context.Context, in *ListOpeningsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Opening], error)
+}
+
+type openingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOpeningServiceClient(cc grpc.ClientConnInterface) OpeningServiceClient {
+	return &openingServiceClient{cc}
+}
+
+func (c *openingServiceClient) CreateOpening(ctx context.Context, in *CreateOpeningRequest, opts ...grpc.CallOption) (*Opening, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Opening)
+	err := c.cc.Invoke(ctx, OpeningService_CreateOpening_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openingServiceClient) GetOpening(ctx context.Context, in *GetOpeningRequest, opts ...grpc.CallOption) (*Opening, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Opening)
+	err := c.cc.Invoke(ctx, OpeningService_GetOpening_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openingServiceClient) UpdateOpening(ctx context.Context, in *UpdateOpeningRequest, opts ...grpc.CallOption) (*Opening, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Opening)
+	err := c.cc.Invoke(ctx, OpeningService_UpdateOpening_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openingServiceClient) DeleteOpening(ctx context.Context, in *DeleteOpeningRequest, opts ...grpc.CallOption) (*DeleteOpeningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOpeningResponse)
+	err := c.cc.Invoke(ctx, OpeningService_DeleteOpening_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openingServiceClient) ListOpenings(ctx context.Context, in *ListOpeningsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Opening], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OpeningService_ServiceDesc.Streams[0], OpeningService_ListOpenings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListOpeningsRequest, Opening]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpeningService_ListOpeningsClient = grpc.ServerStreamingClient[Opening]
+
+// OpeningServiceServer is the server API for OpeningService service.
+// All implementations must embed UnimplementedOpeningServiceServer
+// for forward compatibility.
+//
+// OpeningService exposes the operations of the REST API on openings.
+// Errors use the usual status codes: INVALID_ARGUMENT for a rejected
+// request, NOT_FOUND for a missing opening and INTERNAL otherwise.
+type OpeningServiceServer interface {
+	CreateOpening(context.Context, *CreateOpeningRequest) (*Opening, error)
+	GetOpening(context.Context, *GetOpeningRequest) (*Opening, error)
+	UpdateOpening(context.Context, *UpdateOpeningRequest) (*Opening, error)
+	DeleteOpening(context.Context, *DeleteOpeningRequest) (*DeleteOpeningResponse, error)
+	// ListOpenings streams the openings matching the filter, oldest first.
+	ListOpenings(*ListOpeningsRequest, grpc.ServerStreamingServer[Opening]) error
+	mustEmbedUnimplementedOpeningServiceServer()
+}
+
+// UnimplementedOpeningServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOpeningServiceServer struct{}
+
+func (UnimplementedOpeningServiceServer) CreateOpening(context.Context, *CreateOpeningRequest) (*Opening, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOpening not implemented")
+}
+func (UnimplementedOpeningServiceServer) GetOpening(context.Context, *GetOpeningRequest) (*Opening, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpening not implemented")
+}
+func (UnimplementedOpeningServiceServer) UpdateOpening(context.Context, *UpdateOpeningRequest) (*Opening, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOpening not implemented")
+}
+func (UnimplementedOpeningServiceServer) DeleteOpening(context.Context, *DeleteOpeningRequest) (*DeleteOpeningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOpening not implemented")
+}
+func (UnimplementedOpeningServiceServer) ListOpenings(*ListOpeningsRequest, grpc.ServerStreamingServer[Opening]) error {
+	return status.Errorf(codes.Unimplemented, "method ListOpenings not implemented")
+}
+func (UnimplementedOpeningServiceServer) mustEmbedUnimplementedOpeningServiceServer() {}
+func (UnimplementedOpeningServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeOpeningServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OpeningServiceServer will
+// result in compilation errors.
+type UnsafeOpeningServiceServer interface {
+	mustEmbedUnimplementedOpeningServiceServer()
+}
+
+func RegisterOpeningServiceServer(s grpc.ServiceRegistrar, srv OpeningServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOpeningServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OpeningService_ServiceDesc, srv)
+}
+
+func _OpeningService_CreateOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOpeningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpeningServiceServer).CreateOpening(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpeningService_CreateOpening_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpeningServiceServer).CreateOpening(ctx, req.(*CreateOpeningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpeningService_GetOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpeningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpeningServiceServer).GetOpening(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpeningService_GetOpening_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpeningServiceServer).GetOpening(ctx, req.(*GetOpeningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpeningService_UpdateOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOpeningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpeningServiceServer).UpdateOpening(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpeningService_UpdateOpening_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpeningServiceServer).UpdateOpening(ctx, req.(*UpdateOpeningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpeningService_DeleteOpening_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOpeningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpeningServiceServer).DeleteOpening(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpeningService_DeleteOpening_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpeningServiceServer).DeleteOpening(ctx, req.(*DeleteOpeningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpeningService_ListOpenings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListOpeningsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OpeningServiceServer).ListOpenings(m, &grpc.GenericServerStream[ListOpeningsRequest, Opening]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OpeningService_ListOpeningsServer = grpc.ServerStreamingServer[Opening]
+
+// OpeningService_ServiceDesc is the grpc.ServiceDesc for OpeningService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OpeningService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opening.v1.OpeningService",
+	HandlerType: (*OpeningServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOpening",
+			Handler:    _OpeningService_CreateOpening_Handler,
+		},
+		{
+			MethodName: "GetOpening",
+			Handler:    _OpeningService_GetOpening_Handler,
+		},
+		{
+			MethodName: "UpdateOpening",
+			Handler:    _OpeningService_UpdateOpening_Handler,
+		},
+		{
+			MethodName: "DeleteOpening",
+			Handler:    _OpeningService_DeleteOpening_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListOpenings",
+			Handler:       _OpeningService_ListOpenings_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "opening.proto",
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"os"
+
 	"github.com/valdir-alves3000/go-opportunities/config"
 	"github.com/valdir-alves3000/go-opportunities/internal/router"
+	"github.com/valdir-alves3000/go-opportunities/internal/rpc"
 )
 
 var (
@@ -18,5 +21,20 @@ func main() {
 		return
 	}
 
-	router.SetupRouter()
+	r, openings := router.NewRouter()
+
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+
+	go func() {
+		if err := rpc.ListenAndServe(":"+grpcPort, openings); err != nil {
+			logger.Errorf("grpc server error: %v", err)
+		}
+	}()
+
+	if err := router.Run(r); err != nil {
+		logger.Errorf("http server error: %v", err)
+	}
 }
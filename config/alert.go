@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func InitializeAlertPolicy() (schemas.AlertPolicy, error) {
+	var policy schemas.AlertPolicy
+
+	maxAttempts, err := strconv.Atoi(getEnv("ALERT_MAX_ATTEMPTS", "5"))
+	if err != nil || maxAttempts < 1 {
+		return policy, fmt.Errorf("ALERT_MAX_ATTEMPTS must be a positive number")
+	}
+
+	durations := []struct {
+		key      string
+		fallback string
+		value    *time.Duration
+	}{
+		{"ALERT_RETRY_BASE_DELAY", "1m", &policy.BaseDelay},
+		{"ALERT_RETRY_MAX_DELAY", "1h", &policy.MaxDelay},
+		{"ALERT_POLL_INTERVAL", "5s", &policy.PollInterval},
+	}
+	for _, d := range durations {
+		value, err := time.ParseDuration(getEnv(d.key, d.fallback))
+		if err != nil || value <= 0 {
+			return policy, fmt.Errorf("%s must be a positive duration", d.key)
+		}
+		*d.value = value
+	}
+
+	policy.MaxAttempts = maxAttempts
+	return policy, nil
+}
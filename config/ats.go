@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/ats"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// InitializeATSPolicy reads the feeds listed in ATS_FEEDS, separated by
+// ";", each written as name|format|url with an optional |company.
+func InitializeATSPolicy() (schemas.ATSPolicy, error) {
+	var policy schemas.ATSPolicy
+
+	names := map[string]bool{}
+	for _, entry := range strings.Split(os.Getenv("ATS_FEEDS"), ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		fields := strings.Split(entry, "|")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if len(fields) < 3 || len(fields) > 4 || fields[0] == "" {
+			return policy, fmt.Errorf("invalid ATS_FEEDS entry %q, must be name|format|url[|company]", entry)
+		}
+
+		source := schemas.ATSSource{Name: fields[0], Format: strings.ToLower(fields[1]), URL: fields[2]}
+		if len(fields) == 4 {
+			source.Company = fields[3]
+		}
+
+		if names[source.Name] {
+			return policy, fmt.Errorf("ATS_FEEDS lists the source %q twice", source.Name)
+		}
+		names[source.Name] = true
+
+		if !containsFormat(source.Format) {
+			return policy, fmt.Errorf("unknown ATS format %q, must be one of: %s", source.Format, strings.Join(ats.Formats, ", "))
+		}
+		if u, err := url.Parse(source.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return policy, fmt.Errorf("ATS source %q must have an http or https URL", source.Name)
+		}
+
+		policy.Sources = append(policy.Sources, source)
+	}
+
+	durations := []struct {
+		key      string
+		fallback string
+		value    *time.Duration
+	}{
+		{"ATS_SYNC_INTERVAL", "1h", &policy.Interval},
+		{"ATS_FETCH_TIMEOUT", "30s", &policy.Timeout},
+	}
+	for _, d := range durations {
+		value, err := time.ParseDuration(getEnv(d.key, d.fallback))
+		if err != nil || value <= 0 {
+			return policy, fmt.Errorf("%s must be a positive duration", d.key)
+		}
+		*d.value = value
+	}
+
+	return policy, nil
+}
+
+func containsFormat(format string) bool {
+	for _, f := range ats.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package config
+
+import "strconv"
+
+// IfMatchRequired reports whether writes to an opening must send an
+// If-Match header. Off by default, so existing clients keep working.
+func IfMatchRequired() bool {
+	required, err := strconv.ParseBool(getEnv("IF_MATCH_REQUIRED", "false"))
+	if err != nil {
+		return false
+	}
+	return required
+}
@@ -3,12 +3,30 @@ package config
 import (
 	"fmt"
 
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
 	"gorm.io/gorm"
 )
 
 var (
-	db     *gorm.DB
-	logger *Logger
+	db      *gorm.DB
+	blobs   storage.BlobStorage
+	mail    mailer.Mailer
+	digest  schemas.DigestSchedule
+	hooks   schemas.WebhookPolicy
+	alerts  schemas.AlertPolicy
+	outbox  schemas.OutboxPolicy
+	pubs    []events.Publisher
+	stream  schemas.StreamPolicy
+	idem    schemas.IdempotencyPolicy
+	posting schemas.JobPostingPolicy
+	atsFeed schemas.ATSPolicy
+	dupes   schemas.DuplicatePolicy
+	links   schemas.LinkCheckPolicy
+	denied  schemas.LinkPolicy
+	logger  *Logger
 )
 
 func Init() error {
@@ -20,6 +38,90 @@ func Init() error {
 		return fmt.Errorf("error initializing sqlite: %v", err)
 	}
 
+	blobs, err = InitializeStorage()
+
+	if err != nil {
+		return fmt.Errorf("error initializing storage: %v", err)
+	}
+
+	mail, err = InitializeMailer()
+
+	if err != nil {
+		return fmt.Errorf("error initializing mailer: %v", err)
+	}
+
+	digest, err = InitializeDigestSchedule()
+
+	if err != nil {
+		return fmt.Errorf("error initializing digest schedule: %v", err)
+	}
+
+	hooks, err = InitializeWebhookPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing webhook policy: %v", err)
+	}
+
+	alerts, err = InitializeAlertPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing alert policy: %v", err)
+	}
+
+	outbox, err = InitializeOutboxPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing outbox policy: %v", err)
+	}
+
+	pubs, err = InitializeEventPublishers()
+
+	if err != nil {
+		return fmt.Errorf("error initializing event publishers: %v", err)
+	}
+
+	stream, err = InitializeStreamPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing stream policy: %v", err)
+	}
+
+	idem, err = InitializeIdempotencyPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing idempotency policy: %v", err)
+	}
+
+	posting, err = InitializeJobPostingPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing job posting policy: %v", err)
+	}
+
+	atsFeed, err = InitializeATSPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing ATS policy: %v", err)
+	}
+
+	dupes, err = InitializeDuplicatePolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing duplicate policy: %v", err)
+	}
+
+	links, err = InitializeLinkCheckPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing link check policy: %v", err)
+	}
+
+	denied, err = InitializeLinkPolicy()
+
+	if err != nil {
+		return fmt.Errorf("error initializing link policy: %v", err)
+	}
+
 	return nil
 }
 
@@ -27,6 +129,62 @@ func GetSQLite() *gorm.DB {
 	return db
 }
 
+func GetStorage() storage.BlobStorage {
+	return blobs
+}
+
+func GetMailer() mailer.Mailer {
+	return mail
+}
+
+func GetDigestSchedule() schemas.DigestSchedule {
+	return digest
+}
+
+func GetWebhookPolicy() schemas.WebhookPolicy {
+	return hooks
+}
+
+func GetAlertPolicy() schemas.AlertPolicy {
+	return alerts
+}
+
+func GetOutboxPolicy() schemas.OutboxPolicy {
+	return outbox
+}
+
+func GetEventPublishers() []events.Publisher {
+	return pubs
+}
+
+func GetStreamPolicy() schemas.StreamPolicy {
+	return stream
+}
+
+func GetIdempotencyPolicy() schemas.IdempotencyPolicy {
+	return idem
+}
+
+func GetJobPostingPolicy() schemas.JobPostingPolicy {
+	return posting
+}
+
+func GetATSPolicy() schemas.ATSPolicy {
+	return atsFeed
+}
+
+func GetDuplicatePolicy() schemas.DuplicatePolicy {
+	return dupes
+}
+
+func GetLinkCheckPolicy() schemas.LinkCheckPolicy {
+	return links
+}
+
+func GetLinkPolicy() schemas.LinkPolicy {
+	return denied
+}
+
 func GetLogger(p string) *Logger {
 	logger = NewLogger(p)
 	return logger
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func InitializeDigestSchedule() (schemas.DigestSchedule, error) {
+	var schedule schemas.DigestSchedule
+
+	hour, err := strconv.Atoi(getEnv("DIGEST_SEND_HOUR", "8"))
+	if err != nil || hour < 0 || hour > 23 {
+		return schedule, fmt.Errorf("DIGEST_SEND_HOUR must be an hour between 0 and 23")
+	}
+
+	weekday, ok := weekdays[strings.ToLower(getEnv("DIGEST_WEEKLY_DAY", "monday"))]
+	if !ok {
+		return schedule, fmt.Errorf("DIGEST_WEEKLY_DAY must be a day of the week")
+	}
+
+	location, err := time.LoadLocation(getEnv("DIGEST_TIMEZONE", "UTC"))
+	if err != nil {
+		return schedule, fmt.Errorf("invalid DIGEST_TIMEZONE: %v", err)
+	}
+
+	interval, err := time.ParseDuration(getEnv("DIGEST_CHECK_INTERVAL", "1m"))
+	if err != nil || interval <= 0 {
+		return schedule, fmt.Errorf("DIGEST_CHECK_INTERVAL must be a positive duration")
+	}
+
+	schedule = schemas.DigestSchedule{
+		Hour:          hour,
+		Weekday:       weekday,
+		Location:      location,
+		CheckInterval: interval,
+	}
+	return schedule, nil
+}
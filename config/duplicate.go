@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func InitializeDuplicatePolicy() (schemas.DuplicatePolicy, error) {
+	var policy schemas.DuplicatePolicy
+
+	policy.Mode = strings.ToLower(getEnv("DUPLICATE_OPENINGS", schemas.DuplicateModeFlag))
+	switch policy.Mode {
+	case schemas.DuplicateModeOff, schemas.DuplicateModeFlag, schemas.DuplicateModeReject:
+	default:
+		return policy, fmt.Errorf("DUPLICATE_OPENINGS must be one of: off, flag, reject")
+	}
+
+	threshold, err := strconv.ParseFloat(getEnv("DUPLICATE_SIMILARITY", strconv.FormatFloat(schemas.DefaultDuplicateThreshold, 'f', -1, 64)), 64)
+	if err != nil || threshold <= 0 || threshold > 1 {
+		return policy, fmt.Errorf("DUPLICATE_SIMILARITY must be a number greater than 0 and at most 1")
+	}
+	policy.Threshold = threshold
+
+	return policy, nil
+}
@@ -0,0 +1,15 @@
+package config
+
+import "strconv"
+
+// GraphiQLEnabled reports whether the GraphiQL playground is served. It
+// defaults to on, except when gin runs in release mode.
+func GraphiQLEnabled() bool {
+	fallback := getEnv("GIN_MODE", "debug") != "release"
+
+	enabled, err := strconv.ParseBool(getEnv("GRAPHIQL_ENABLED", strconv.FormatBool(fallback)))
+	if err != nil {
+		return fallback
+	}
+	return enabled
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func InitializeIdempotencyPolicy() (schemas.IdempotencyPolicy, error) {
+	var policy schemas.IdempotencyPolicy
+
+	ttl, err := time.ParseDuration(getEnv("IDEMPOTENCY_KEY_TTL", "24h"))
+	if err != nil || ttl <= 0 {
+		return policy, fmt.Errorf("IDEMPOTENCY_KEY_TTL must be a positive duration")
+	}
+
+	pendingTimeout, err := time.ParseDuration(getEnv("IDEMPOTENCY_PENDING_TIMEOUT", "1m"))
+	if err != nil || pendingTimeout <= 0 {
+		return policy, fmt.Errorf("IDEMPOTENCY_PENDING_TIMEOUT must be a positive duration")
+	}
+
+	policy.TTL = ttl
+	policy.PendingTimeout = pendingTimeout
+	return policy, nil
+}
@@ -0,0 +1,15 @@
+package config
+
+import "strconv"
+
+const defaultImportAsyncRows = 1000
+
+// ImportAsyncRows is the number of openings above which an import file is
+// run as a background job instead of within the request.
+func ImportAsyncRows() int {
+	rows, err := strconv.Atoi(getEnv("IMPORT_ASYNC_ROWS", strconv.Itoa(defaultImportAsyncRows)))
+	if err != nil || rows < 0 {
+		return defaultImportAsyncRows
+	}
+	return rows
+}
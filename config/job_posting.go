@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func InitializeJobPostingPolicy() (schemas.JobPostingPolicy, error) {
+	policy := schemas.DefaultJobPostingPolicy
+
+	validity, err := time.ParseDuration(getEnv("JOB_POSTING_VALIDITY", policy.Validity.String()))
+	if err != nil || validity <= 0 {
+		return policy, fmt.Errorf("JOB_POSTING_VALIDITY must be a positive duration")
+	}
+	policy.Validity = validity
+
+	policy.Currency = strings.ToUpper(getEnv("SALARY_CURRENCY", policy.Currency))
+	if !currencyCode.MatchString(policy.Currency) {
+		return policy, fmt.Errorf("SALARY_CURRENCY must be an ISO 4217 code such as BRL")
+	}
+
+	policy.SalaryUnit = strings.ToUpper(getEnv("SALARY_UNIT", policy.SalaryUnit))
+	switch policy.SalaryUnit {
+	case "HOUR", "DAY", "WEEK", "MONTH", "YEAR":
+	default:
+		return policy, fmt.Errorf("SALARY_UNIT must be one of: HOUR, DAY, WEEK, MONTH, YEAR")
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func InitializeLinkCheckPolicy() (schemas.LinkCheckPolicy, error) {
+	var policy schemas.LinkCheckPolicy
+
+	enabled, err := strconv.ParseBool(getEnv("LINK_CHECK_ENABLED", "true"))
+	if err != nil {
+		return policy, fmt.Errorf("LINK_CHECK_ENABLED must be true or false")
+	}
+
+	allowPrivate, err := strconv.ParseBool(getEnv("LINK_CHECK_ALLOW_PRIVATE_HOSTS", "false"))
+	if err != nil {
+		return policy, fmt.Errorf("LINK_CHECK_ALLOW_PRIVATE_HOSTS must be true or false")
+	}
+
+	maxFailures, err := strconv.Atoi(getEnv("LINK_CHECK_MAX_FAILURES", "3"))
+	if err != nil || maxFailures < 0 {
+		return policy, fmt.Errorf("LINK_CHECK_MAX_FAILURES must be zero or a positive number")
+	}
+
+	durations := []struct {
+		key      string
+		fallback string
+		value    *time.Duration
+	}{
+		{"LINK_CHECK_INTERVAL", "24h", &policy.Interval},
+		{"LINK_CHECK_POLL_INTERVAL", "1m", &policy.PollInterval},
+		{"LINK_CHECK_HOST_DELAY", "1s", &policy.HostDelay},
+		{"LINK_CHECK_TIMEOUT", "10s", &policy.Timeout},
+	}
+	for _, d := range durations {
+		value, err := time.ParseDuration(getEnv(d.key, d.fallback))
+		if err != nil || value <= 0 {
+			return policy, fmt.Errorf("%s must be a positive duration", d.key)
+		}
+		*d.value = value
+	}
+
+	policy.Enabled = enabled
+	policy.MaxFailures = maxFailures
+	policy.AllowPrivateHosts = allowPrivate
+	return policy, nil
+}
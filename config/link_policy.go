@@ -0,0 +1,29 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// InitializeLinkPolicy reads the hosts listed in LINK_DENYLIST, separated
+// by commas.
+func InitializeLinkPolicy() (schemas.LinkPolicy, error) {
+	var policy schemas.LinkPolicy
+
+	for _, host := range strings.Split(os.Getenv("LINK_DENYLIST"), ",") {
+		host = strings.Trim(strings.ToLower(strings.TrimSpace(host)), ".")
+		host = strings.TrimPrefix(host, "*.")
+		if host == "" {
+			continue
+		}
+		if strings.ContainsAny(host, "/:@ ") {
+			return policy, fmt.Errorf("invalid LINK_DENYLIST host %q, must be a host name such as example.com", host)
+		}
+		policy.DeniedHosts = append(policy.DeniedHosts, host)
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"os"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+)
+
+func InitializeMailer() (mailer.Mailer, error) {
+	logger := GetLogger("mailer")
+
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		logger.Warn("SMTP_HOST not set, emails will only be logged")
+		return mailer.NewLogMailer(logger.Infof), nil
+	}
+
+	return mailer.NewSMTPMailer(mailer.SMTPConfig{
+		Host:     host,
+		Port:     getEnv("SMTP_PORT", "25"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     getEnv("SMTP_FROM", "Go Opportunities <no-reply@localhost>"),
+	})
+}
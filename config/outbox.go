@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+)
+
+func InitializeOutboxPolicy() (schemas.OutboxPolicy, error) {
+	var policy schemas.OutboxPolicy
+
+	maxAttempts, err := strconv.Atoi(getEnv("OUTBOX_MAX_ATTEMPTS", "20"))
+	if err != nil || maxAttempts < 0 {
+		return policy, fmt.Errorf("OUTBOX_MAX_ATTEMPTS must be zero or a positive number")
+	}
+
+	durations := []struct {
+		key      string
+		fallback string
+		value    *time.Duration
+	}{
+		{"OUTBOX_POLL_INTERVAL", "1s", &policy.PollInterval},
+		{"OUTBOX_RETRY_BASE_DELAY", "1s", &policy.BaseDelay},
+		{"OUTBOX_RETRY_MAX_DELAY", "5m", &policy.MaxDelay},
+		{"OUTBOX_RETENTION", "168h", &policy.Retention},
+	}
+	for _, d := range durations {
+		value, err := time.ParseDuration(getEnv(d.key, d.fallback))
+		if err != nil || value <= 0 {
+			return policy, fmt.Errorf("%s must be a positive duration", d.key)
+		}
+		*d.value = value
+	}
+
+	policy.MaxAttempts = maxAttempts
+	return policy, nil
+}
+
+// InitializeEventPublishers builds the external publishers listed in
+// EVENT_PUBLISHERS. Webhooks and job alerts are always fed by the relay.
+func InitializeEventPublishers() ([]events.Publisher, error) {
+	var publishers []events.Publisher
+
+	for _, name := range strings.Split(os.Getenv("EVENT_PUBLISHERS"), ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case "log":
+			publishers = append(publishers, events.NewLogPublisher(GetLogger("events").Infof))
+		case "nats":
+			nats, err := events.NewNATSPublisher(events.NATSConfig{
+				URL:           getEnv("NATS_URL", "nats://localhost:4222"),
+				SubjectPrefix: getEnv("NATS_SUBJECT_PREFIX", "go-opportunities"),
+			})
+			if err != nil {
+				return nil, err
+			}
+			publishers = append(publishers, nats)
+		default:
+			return nil, fmt.Errorf("unknown event publisher %q, must be one of: log, nats", name)
+		}
+	}
+
+	return publishers, nil
+}
@@ -9,8 +9,8 @@ import (
 type RestErr struct {
 	Message string   `json:"message"`
 	Err     string   `json:"err"`
-	Code    int      `json:"code"`
-	Causes  []Causes `json:"causes"`
+	Code    int      `json:"errorCode"`
+	Causes  []Causes `json:"causes,omitempty"`
 }
 
 type Causes struct {
@@ -25,11 +25,21 @@ func (r *RestErr) Error() string {
 func ConvertError(internalError *internal_error.InternalError) *RestErr {
 	switch internalError.Err {
 	case "bad_request":
-		return NewBadRequestError(internalError.Error())
+		causes := make([]Causes, len(internalError.Causes))
+		for i, cause := range internalError.Causes {
+			causes[i] = Causes{Field: cause.Field, Message: cause.Message}
+		}
+		return NewBadRequestError(internalError.Error(), causes...)
 	case "not_found":
 		return NewNotFoundError(internalError.Error())
 	case "internal_server_error":
 		return NewInternalServerError(internalError.Error())
+	case "conflict":
+		return NewConflictError(internalError.Error())
+	case "precondition_failed":
+		return NewPreconditionFailedError(internalError.Error())
+	case "unprocessable_entity":
+		return NewUnprocessableEntityError(internalError.Error())
 	default:
 		return NewInternalServerError(internalError.Error())
 	}
@@ -66,3 +76,75 @@ func NewNotFoundError(message string) *RestErr {
 		Causes:  nil,
 	}
 }
+
+func NewConflictError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "conflict",
+		Code:    http.StatusConflict,
+		Causes:  nil,
+	}
+}
+
+func NewPreconditionFailedError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "precondition_failed",
+		Code:    http.StatusPreconditionFailed,
+		Causes:  nil,
+	}
+}
+
+func NewUnprocessableEntityError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "unprocessable_entity",
+		Code:    http.StatusUnprocessableEntity,
+		Causes:  nil,
+	}
+}
+
+func NewForbiddenError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "forbidden",
+		Code:    http.StatusForbidden,
+		Causes:  nil,
+	}
+}
+
+func NewMethodNotAllowedError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "method_not_allowed",
+		Code:    http.StatusMethodNotAllowed,
+		Causes:  nil,
+	}
+}
+
+func NewPreconditionRequiredError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "precondition_required",
+		Code:    http.StatusPreconditionRequired,
+		Causes:  nil,
+	}
+}
+
+func NewRequestEntityTooLargeError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "request_entity_too_large",
+		Code:    http.StatusRequestEntityTooLarge,
+		Causes:  nil,
+	}
+}
+
+func NewUnsupportedMediaTypeError(message string) *RestErr {
+	return &RestErr{
+		Message: message,
+		Err:     "unsupported_media_type",
+		Code:    http.StatusUnsupportedMediaType,
+		Causes:  nil,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/dedup"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -33,11 +34,55 @@ func InitializeSQLite() (*gorm.DB, error) {
 		return nil, err
 	}
 
-	err = db.AutoMigrate(&schemas.Opening{})
+	err = db.AutoMigrate(
+		&schemas.Opening{},
+		&schemas.OpeningRevision{},
+		&schemas.Application{},
+		&schemas.CandidateProfile{},
+		&schemas.Pipeline{},
+		&schemas.ApplicationStageChange{},
+		&schemas.JobAlert{},
+		&schemas.JobAlertDelivery{},
+		&schemas.DigestSubscription{},
+		&schemas.DigestDelivery{},
+		&schemas.Webhook{},
+		&schemas.WebhookDelivery{},
+		&schemas.WebhookAttempt{},
+		&schemas.OutboxEvent{},
+		&schemas.IdempotencyRecord{},
+		&schemas.ImportJob{},
+	)
 	if err != nil {
 		logger.Errorf("sqlite automigration error: %v", err)
 		return nil, err
 	}
 
+	if err := backfillDuplicateKeys(db); err != nil {
+		logger.Errorf("sqlite duplicate keys backfill error: %v", err)
+		return nil, err
+	}
+
 	return db, nil
 }
+
+// backfillDuplicateKeys fills in the normalized link and company of the
+// openings stored before duplicate detection existed. It does not bump
+// their version, as nothing visible changes.
+func backfillDuplicateKeys(db *gorm.DB) error {
+	var openings []schemas.Opening
+	err := db.Unscoped().Where("link_key IS NULL OR link_key = ''").Where("link <> ''").Find(&openings).Error
+	if err != nil {
+		return err
+	}
+
+	for _, opening := range openings {
+		err := db.Unscoped().Model(&opening).UpdateColumns(map[string]interface{}{
+			"link_key":    dedup.LinkKey(opening.Link),
+			"company_key": dedup.CompanyKey(opening.Company),
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
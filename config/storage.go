@@ -0,0 +1,50 @@
+package config
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
+)
+
+func InitializeStorage() (storage.BlobStorage, error) {
+	logger := GetLogger("storage")
+
+	switch backend := getEnv("STORAGE_BACKEND", "local"); backend {
+	case "local":
+		secret := []byte(os.Getenv("STORAGE_SIGNING_SECRET"))
+		if len(secret) == 0 {
+			logger.Warn("STORAGE_SIGNING_SECRET not set, signed URLs will not survive a restart")
+			secret = make([]byte, 32)
+			if _, err := rand.Read(secret); err != nil {
+				return nil, err
+			}
+		}
+
+		return storage.NewLocalStorage(getEnv("STORAGE_LOCAL_DIR", "./storage"), GetBaseURL(), secret)
+	case "s3":
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			Region:          os.Getenv("S3_REGION"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			UsePathStyle:    getEnv("S3_PATH_STYLE", "true") == "true",
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", backend)
+	}
+}
+
+// GetBaseURL is the public URL of the API used in links sent to users.
+func GetBaseURL() string {
+	return getEnv("APP_BASE_URL", "http://"+getEnv("APP_HOST", "localhost:8080")+"/api/v1")
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
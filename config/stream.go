@@ -0,0 +1,26 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func InitializeStreamPolicy() (schemas.StreamPolicy, error) {
+	var policy schemas.StreamPolicy
+
+	size, err := strconv.Atoi(getEnv("SSE_REPLAY_SIZE", "1000"))
+	if err != nil || size <= 0 {
+		return policy, fmt.Errorf("SSE_REPLAY_SIZE must be a positive number")
+	}
+
+	heartbeat, err := time.ParseDuration(getEnv("SSE_HEARTBEAT_INTERVAL", "15s"))
+	if err != nil || heartbeat <= 0 {
+		return policy, fmt.Errorf("SSE_HEARTBEAT_INTERVAL must be a positive duration")
+	}
+
+	policy = schemas.StreamPolicy{ReplaySize: size, Heartbeat: heartbeat}
+	return policy, nil
+}
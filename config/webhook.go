@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func InitializeWebhookPolicy() (schemas.WebhookPolicy, error) {
+	var policy schemas.WebhookPolicy
+
+	maxAttempts, err := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", "8"))
+	if err != nil || maxAttempts < 1 {
+		return policy, fmt.Errorf("WEBHOOK_MAX_ATTEMPTS must be a positive number")
+	}
+
+	allowPrivate, err := strconv.ParseBool(getEnv("WEBHOOK_ALLOW_PRIVATE_HOSTS", "false"))
+	if err != nil {
+		return policy, fmt.Errorf("WEBHOOK_ALLOW_PRIVATE_HOSTS must be true or false")
+	}
+
+	durations := []struct {
+		key      string
+		fallback string
+		value    *time.Duration
+	}{
+		{"WEBHOOK_RETRY_BASE_DELAY", "30s", &policy.BaseDelay},
+		{"WEBHOOK_RETRY_MAX_DELAY", "1h", &policy.MaxDelay},
+		{"WEBHOOK_TIMEOUT", "10s", &policy.Timeout},
+		{"WEBHOOK_POLL_INTERVAL", "5s", &policy.PollInterval},
+	}
+	for _, d := range durations {
+		value, err := time.ParseDuration(getEnv(d.key, d.fallback))
+		if err != nil || value <= 0 {
+			return policy, fmt.Errorf("%s must be a positive duration", d.key)
+		}
+		*d.value = value
+	}
+
+	policy.MaxAttempts = maxAttempts
+	policy.AllowPrivateHosts = allowPrivate
+	return policy, nil
+}
@@ -0,0 +1,95 @@
+// Package ats reads the job feeds published by applicant tracking systems
+// into openings.
+package ats
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// Formats are the feed formats Parse understands.
+var Formats = []string{schemas.ATSFormatGreenhouse, schemas.ATSFormatLever, schemas.ATSFormatIndeed}
+
+// Parse reads the jobs of a feed in format. Every opening has its
+// ExternalID set from the feed; fields the feed does not carry are left
+// empty, and a salary that is not stated, or not a monthly or yearly
+// amount, is 0.
+func Parse(format string, r io.Reader) ([]schemas.Opening, error) {
+	switch format {
+	case schemas.ATSFormatGreenhouse:
+		return parseGreenhouse(r)
+	case schemas.ATSFormatLever:
+		return parseLever(r)
+	case schemas.ATSFormatIndeed:
+		return parseIndeed(r)
+	}
+	return nil, fmt.Errorf("unknown ATS format %q, must be one of: %s", format, strings.Join(Formats, ", "))
+}
+
+// Accept is the media type to request feeds in format with.
+func Accept(format string) string {
+	if format == schemas.ATSFormatIndeed {
+		return "application/xml, text/xml"
+	}
+	return "application/json"
+}
+
+// isRemote tells whether a location or workplace label describes a fully
+// remote job. Hybrid jobs are not remote.
+func isRemote(label string) bool {
+	label = strings.ToLower(label)
+	if strings.Contains(label, "hybrid") || strings.Contains(label, "híbrido") {
+		return false
+	}
+	return strings.Contains(label, "remote") || strings.Contains(label, "remoto")
+}
+
+// monthly converts an amount paid per interval to a monthly salary, or 0
+// for any other interval.
+func monthly(amount float64, interval string) int64 {
+	interval = strings.ToLower(interval)
+	switch {
+	case strings.Contains(interval, "month") || strings.Contains(interval, "mês") || strings.Contains(interval, "mes"):
+		return int64(amount)
+	case strings.Contains(interval, "year") || strings.Contains(interval, "annu") || strings.Contains(interval, "ano"):
+		return int64(amount / 12)
+	}
+	return 0
+}
+
+// amountPattern matches an amount in free text, either with thousands
+// separators ("5.000", "120,000") or without ("5000"), and an optional
+// "k" for thousands.
+var amountPattern = regexp.MustCompile(`(\d{1,3}(?:[.,]\d{3})+|\d+)\s*([kK]\b)?`)
+
+// parseSalaryText reads a salary such as "R$ 5.000 - 7.000 por mês" or
+// "$120,000 a year" as a monthly amount, using the lower bound of ranges.
+func parseSalaryText(text string) int64 {
+	match := amountPattern.FindStringSubmatch(text)
+	if match == nil {
+		return 0
+	}
+	amount, err := strconv.ParseFloat(strings.NewReplacer(".", "", ",", "").Replace(match[1]), 64)
+	if err != nil {
+		return 0
+	}
+	if match[2] != "" {
+		amount *= 1000
+	}
+	return monthly(amount, text)
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	var kept []string
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, sep)
+}
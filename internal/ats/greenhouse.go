@@ -0,0 +1,50 @@
+package ats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// greenhouseBoard is the response of the Greenhouse job board API,
+// GET /v1/boards/{board}/jobs.
+type greenhouseBoard struct {
+	Jobs []struct {
+		ID          int64  `json:"id"`
+		Title       string `json:"title"`
+		AbsoluteURL string `json:"absolute_url"`
+		CompanyName string `json:"company_name"`
+		Location    struct {
+			Name string `json:"name"`
+		} `json:"location"`
+	} `json:"jobs"`
+}
+
+// parseGreenhouse reads a Greenhouse board. The API has no remote flag or
+// salary, so a job is remote when its location says so.
+func parseGreenhouse(r io.Reader) ([]schemas.Opening, error) {
+	var board greenhouseBoard
+	if err := json.NewDecoder(r).Decode(&board); err != nil {
+		return nil, fmt.Errorf("invalid Greenhouse feed: %w", err)
+	}
+
+	openings := make([]schemas.Opening, 0, len(board.Jobs))
+	for _, job := range board.Jobs {
+		var externalID string
+		if job.ID != 0 {
+			externalID = strconv.FormatInt(job.ID, 10)
+		}
+		openings = append(openings, schemas.Opening{
+			ExternalID: externalID,
+			Role:       job.Title,
+			Company:    job.CompanyName,
+			Location:   job.Location.Name,
+			Remote:     isRemote(job.Location.Name),
+			Link:       job.AbsoluteURL,
+		})
+	}
+	return openings, nil
+}
@@ -0,0 +1,54 @@
+package ats
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// indeedSource is an XML feed in the format Indeed reads from employers,
+// which other job boards accept as well.
+type indeedSource struct {
+	Publisher string `xml:"publisher"`
+	Jobs      []struct {
+		ReferenceNumber string `xml:"referencenumber"`
+		Title           string `xml:"title"`
+		URL             string `xml:"url"`
+		Company         string `xml:"company"`
+		City            string `xml:"city"`
+		State           string `xml:"state"`
+		Country         string `xml:"country"`
+		Salary          string `xml:"salary"`
+		RemoteType      string `xml:"remotetype"`
+	} `xml:"job"`
+}
+
+// parseIndeed reads an Indeed XML feed. Jobs without a company are taken
+// to be from the publisher.
+func parseIndeed(r io.Reader) ([]schemas.Opening, error) {
+	var source indeedSource
+	if err := xml.NewDecoder(r).Decode(&source); err != nil {
+		return nil, fmt.Errorf("invalid Indeed feed: %w", err)
+	}
+
+	openings := make([]schemas.Opening, 0, len(source.Jobs))
+	for _, job := range source.Jobs {
+		company := strings.TrimSpace(job.Company)
+		if company == "" {
+			company = strings.TrimSpace(source.Publisher)
+		}
+		openings = append(openings, schemas.Opening{
+			ExternalID: strings.TrimSpace(job.ReferenceNumber),
+			Role:       strings.TrimSpace(job.Title),
+			Company:    company,
+			Location:   joinNonEmpty(", ", job.City, job.State, job.Country),
+			Remote:     isRemote(job.RemoteType),
+			Link:       strings.TrimSpace(job.URL),
+			Salary:     parseSalaryText(job.Salary),
+		})
+	}
+	return openings, nil
+}
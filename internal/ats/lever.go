@@ -0,0 +1,53 @@
+package ats
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// leverPosting is a posting of the Lever postings API,
+// GET /v0/postings/{site}?mode=json.
+type leverPosting struct {
+	ID         string `json:"id"`
+	Text       string `json:"text"`
+	HostedURL  string `json:"hostedUrl"`
+	Categories struct {
+		Location string `json:"location"`
+	} `json:"categories"`
+	WorkplaceType string `json:"workplaceType"`
+	SalaryRange   *struct {
+		Min      float64 `json:"min"`
+		Interval string  `json:"interval"`
+	} `json:"salaryRange"`
+}
+
+// parseLever reads the postings of a Lever site. Lever does not name the
+// company, so it is left to the source.
+func parseLever(r io.Reader) ([]schemas.Opening, error) {
+	var postings []leverPosting
+	if err := json.NewDecoder(r).Decode(&postings); err != nil {
+		return nil, fmt.Errorf("invalid Lever feed: %w", err)
+	}
+
+	openings := make([]schemas.Opening, 0, len(postings))
+	for _, posting := range postings {
+		opening := schemas.Opening{
+			ExternalID: posting.ID,
+			Role:       posting.Text,
+			Location:   posting.Categories.Location,
+			Remote:     posting.WorkplaceType == "remote",
+			Link:       posting.HostedURL,
+		}
+		if posting.WorkplaceType == "" || posting.WorkplaceType == "unspecified" {
+			opening.Remote = isRemote(posting.Categories.Location)
+		}
+		if posting.SalaryRange != nil {
+			opening.Salary = monthly(posting.SalaryRange.Min, posting.SalaryRange.Interval)
+		}
+		openings = append(openings, opening)
+	}
+	return openings, nil
+}
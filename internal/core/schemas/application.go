@@ -0,0 +1,39 @@
+package schemas
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Application struct {
+	gorm.Model
+	OpeningID      uint `gorm:"index"`
+	CandidateName  string
+	Email          string
+	Phone          string
+	CoverLetter    string
+	Status         string
+	StageChangedAt *time.Time
+}
+
+type ApplicationResponse struct {
+	ID             uint       `json:"id"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+	DeletedAt      *time.Time `json:"deteledAt,omitempty"`
+	OpeningID      uint       `json:"openingId"`
+	CandidateName  string     `json:"candidateName"`
+	Email          string     `json:"email"`
+	Phone          string     `json:"phone"`
+	CoverLetter    string     `json:"coverLetter"`
+	Status         string     `json:"status"`
+	StageChangedAt *time.Time `json:"stageChangedAt"`
+}
+
+type CreateApplicationRequest struct {
+	CandidateName string `json:"candidateName"`
+	Email         string `json:"email"`
+	Phone         string `json:"phone"`
+	CoverLetter   string `json:"coverLetter"`
+}
@@ -0,0 +1,40 @@
+package schemas
+
+import "time"
+
+const (
+	ATSFormatGreenhouse = "greenhouse"
+	ATSFormatLever      = "lever"
+	ATSFormatIndeed     = "indeed"
+)
+
+// ATSSource is a job feed published by an applicant tracking system.
+// Name namespaces the external IDs of its openings, so it must not change
+// once the source has been synced. Company is used for jobs whose feed
+// does not name the company.
+type ATSSource struct {
+	Name    string
+	Format  string
+	URL     string
+	Company string
+}
+
+// ATSPolicy lists the feeds to sync and how often they are fetched.
+type ATSPolicy struct {
+	Sources  []ATSSource
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// ATSSyncReport counts what a sync did with the jobs of a feed. Skipped
+// jobs are invalid, repeated, or were deleted here; they are left as they
+// are and not closed.
+type ATSSyncReport struct {
+	Source    string
+	Fetched   int
+	Created   int
+	Updated   int
+	Unchanged int
+	Closed    int
+	Skipped   int
+}
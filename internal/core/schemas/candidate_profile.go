@@ -0,0 +1,57 @@
+package schemas
+
+import (
+	"io"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ResumeContentTypePDF  = "application/pdf"
+	ResumeContentTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+)
+
+type CandidateProfile struct {
+	gorm.Model
+	OpeningID         uint `gorm:"index"`
+	Name              string
+	Email             string
+	Phone             string
+	ResumeKey         string `json:"-"`
+	ResumeFileName    string
+	ResumeContentType string
+	ResumeSize        int64
+}
+
+type CandidateProfileResponse struct {
+	ID                uint       `json:"id"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	DeletedAt         *time.Time `json:"deteledAt,omitempty"`
+	OpeningID         uint       `json:"openingId"`
+	Name              string     `json:"name"`
+	Email             string     `json:"email"`
+	Phone             string     `json:"phone"`
+	ResumeFileName    string     `json:"resumeFileName"`
+	ResumeContentType string     `json:"resumeContentType"`
+	ResumeSize        int64      `json:"resumeSize"`
+}
+
+type CreateCandidateProfileRequest struct {
+	Name  string `form:"name"`
+	Email string `form:"email"`
+	Phone string `form:"phone"`
+}
+
+// ResumeUpload is the file part of a candidate profile submission.
+type ResumeUpload struct {
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+type ResumeURLResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
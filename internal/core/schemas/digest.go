@@ -0,0 +1,83 @@
+package schemas
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DigestDaily  = "daily"
+	DigestWeekly = "weekly"
+
+	DigestDeliveryPending = "pending"
+	DigestDeliverySent    = "sent"
+	DigestDeliveryEmpty   = "empty"
+	DigestDeliveryFailed  = "failed"
+)
+
+type DigestSubscription struct {
+	gorm.Model
+	Email            string `gorm:"index"`
+	Cadence          string
+	ConfirmToken     string `gorm:"uniqueIndex" json:"-"`
+	UnsubscribeToken string `gorm:"uniqueIndex" json:"-"`
+	ConfirmedAt      *time.Time
+	UnsubscribedAt   *time.Time
+	// LastPeriodEnd is where the last completed digest stopped; the next one
+	// covers openings created after it.
+	LastPeriodEnd *time.Time
+	NextRunAt     *time.Time `gorm:"index"`
+}
+
+// DigestDelivery records one digest period of a subscription. The unique
+// index makes sure a period is only ever delivered once.
+type DigestDelivery struct {
+	gorm.Model
+	SubscriptionID uint `gorm:"uniqueIndex:idx_digest_period"`
+	PeriodStart    time.Time
+	PeriodEnd      time.Time `gorm:"uniqueIndex:idx_digest_period"`
+	Status         string
+	Openings       int
+	Attempts       int
+	LastError      string
+	SentAt         *time.Time
+}
+
+// DigestSchedule sets when digests go out: daily ones every day at Hour
+// and weekly ones on Weekday at Hour, in Location.
+type DigestSchedule struct {
+	Hour          int
+	Weekday       time.Weekday
+	Location      *time.Location
+	CheckInterval time.Duration
+}
+
+// Next returns the first scheduled send time of the cadence after t.
+func (s DigestSchedule) Next(cadence string, t time.Time) time.Time {
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	local := t.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), s.Hour, 0, 0, 0, loc)
+	for !next.After(t) || (cadence == DigestWeekly && next.Weekday() != s.Weekday) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+type DigestSubscriptionResponse struct {
+	ID          uint       `json:"id"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	Email       string     `json:"email"`
+	Cadence     string     `json:"cadence"`
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty"`
+	NextRunAt   *time.Time `json:"nextRunAt,omitempty"`
+}
+
+type CreateDigestSubscriptionRequest struct {
+	Email   string `json:"email"`
+	Cadence string `json:"cadence"`
+}
@@ -0,0 +1,23 @@
+package schemas
+
+const (
+	DuplicateModeOff    = "off"
+	DuplicateModeFlag   = "flag"
+	DuplicateModeReject = "reject"
+
+	DefaultDuplicateThreshold = 0.85
+)
+
+// DuplicatePolicy sets what happens when a new opening scores Threshold or
+// more against an open one: with DuplicateModeReject it is not created,
+// with DuplicateModeFlag it is created with DuplicateOf set.
+type DuplicatePolicy struct {
+	Mode      string
+	Threshold float64
+}
+
+// DuplicateCluster is a group of open openings that look like the same
+// job, to be merged by hand.
+type DuplicateCluster struct {
+	Openings []Opening `json:"openings"`
+}
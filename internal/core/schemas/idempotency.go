@@ -0,0 +1,32 @@
+package schemas
+
+import "time"
+
+// IdempotencyRecord remembers the response to a POST sent with an
+// Idempotency-Key header, so that a retry gets the same answer instead of
+// repeating the request. StatusCode is 0 while the first request is still
+// running, which renews RenewedAt as it goes, and Header holds the response
+// headers worth replaying.
+type IdempotencyRecord struct {
+	ID          uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	RenewedAt   time.Time
+	Key         string `gorm:"uniqueIndex"`
+	Fingerprint string
+	StatusCode  int
+	Header      map[string]string `gorm:"serializer:json"`
+	Body        []byte
+	ExpiresAt   time.Time `gorm:"index"`
+}
+
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// IdempotencyPolicy sets how long an Idempotency-Key and its response are
+// kept. A key whose request has not renewed it for PendingTimeout is taken
+// as abandoned, e.g. because the server stopped while running it.
+type IdempotencyPolicy struct {
+	TTL            time.Duration
+	PendingTimeout time.Duration
+}
@@ -0,0 +1,104 @@
+package schemas
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	JobAlertDeliveryPending = "pending"
+	JobAlertDeliverySent    = "sent"
+	JobAlertDeliveryFailed  = "failed"
+)
+
+type JobAlert struct {
+	gorm.Model
+	Email string `gorm:"index"`
+	// Keywords are space separated; every one of them must appear in the
+	// opening role, company or location.
+	Keywords         string
+	Remote           *bool
+	Location         string
+	MinSalary        int64
+	ConfirmToken     string `gorm:"uniqueIndex" json:"-"`
+	UnsubscribeToken string `gorm:"uniqueIndex" json:"-"`
+	ConfirmedAt      *time.Time
+	UnsubscribedAt   *time.Time
+}
+
+// JobAlertDelivery is the email about one event queued for one alert. The
+// unique alert and event pair keeps an event relayed again from emailing
+// the alert twice. Payload is the opening as it was when the event fired.
+type JobAlertDelivery struct {
+	gorm.Model
+	AlertID       uint   `gorm:"uniqueIndex:idx_alert_event"`
+	EventID       string `gorm:"uniqueIndex:idx_alert_event"`
+	Payload       string
+	Status        string `gorm:"index"`
+	Attempts      int
+	NextAttemptAt *time.Time `gorm:"index"`
+	LastError     string
+	SentAt        *time.Time
+}
+
+// AlertPolicy sets how alert emails are retried: attempt n waits
+// BaseDelay * 2^(n-1), capped at MaxDelay, until MaxAttempts is reached.
+type AlertPolicy struct {
+	MaxAttempts  int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	PollInterval time.Duration
+}
+
+// Backoff returns the wait after the given failed attempt.
+func (p AlertPolicy) Backoff(attempt int) time.Duration {
+	return exponentialBackoff(p.BaseDelay, p.MaxDelay, attempt)
+}
+
+func (a *JobAlert) IsActive() bool {
+	return a.ConfirmedAt != nil && a.UnsubscribedAt == nil
+}
+
+func (a *JobAlert) Matches(o *Opening) bool {
+	if a.Remote != nil && *a.Remote != o.Remote {
+		return false
+	}
+
+	if o.Salary < a.MinSalary {
+		return false
+	}
+
+	if a.Location != "" && !strings.Contains(strings.ToLower(o.Location), strings.ToLower(a.Location)) {
+		return false
+	}
+
+	text := strings.ToLower(o.Role + " " + o.Company + " " + o.Location)
+	for _, keyword := range strings.Fields(strings.ToLower(a.Keywords)) {
+		if !strings.Contains(text, keyword) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type JobAlertResponse struct {
+	ID          uint       `json:"id"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	Email       string     `json:"email"`
+	Keywords    string     `json:"keywords"`
+	Remote      *bool      `json:"remote,omitempty"`
+	Location    string     `json:"location"`
+	MinSalary   int64      `json:"minSalary"`
+	ConfirmedAt *time.Time `json:"confirmedAt,omitempty"`
+}
+
+type CreateJobAlertRequest struct {
+	Email     string `json:"email"`
+	Keywords  string `json:"keywords"`
+	Remote    *bool  `json:"remote"`
+	Location  string `json:"location"`
+	MinSalary int64  `json:"minSalary"`
+}
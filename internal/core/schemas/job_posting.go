@@ -0,0 +1,164 @@
+package schemas
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const JobPostingMediaType = "application/ld+json"
+
+// JobPostingPolicy fills in what a schema.org JobPosting needs but an
+// opening does not store.
+type JobPostingPolicy struct {
+	// Validity is how long an open opening stays listed after its last
+	// change, reported as validThrough.
+	Validity time.Duration
+	// Currency is the ISO 4217 code of Opening.Salary.
+	Currency string
+	// SalaryUnit is the period Opening.Salary is paid for: HOUR, DAY, WEEK,
+	// MONTH or YEAR.
+	SalaryUnit string
+}
+
+var DefaultJobPostingPolicy = JobPostingPolicy{
+	Validity:   30 * 24 * time.Hour,
+	Currency:   "BRL",
+	SalaryUnit: "MONTH",
+}
+
+// JobPosting is the schema.org JobPosting of an opening, served as JSON-LD
+// for search engines and job aggregators.
+type JobPosting struct {
+	Context            string               `json:"@context"`
+	Type               string               `json:"@type"`
+	Identifier         JobPostingIdentifier `json:"identifier"`
+	Title              string               `json:"title"`
+	Description        string               `json:"description"`
+	DatePosted         string               `json:"datePosted"`
+	ValidThrough       string               `json:"validThrough"`
+	HiringOrganization JobPostingOrg        `json:"hiringOrganization"`
+	JobLocation        *JobPostingPlace     `json:"jobLocation,omitempty"`
+	JobLocationType    string               `json:"jobLocationType,omitempty"`
+	BaseSalary         *JobPostingSalary    `json:"baseSalary,omitempty"`
+	URL                string               `json:"url,omitempty"`
+}
+
+type JobPostingIdentifier struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type JobPostingOrg struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+type JobPostingPlace struct {
+	Type    string            `json:"@type"`
+	Address JobPostingAddress `json:"address"`
+}
+
+type JobPostingAddress struct {
+	Type            string `json:"@type"`
+	AddressLocality string `json:"addressLocality"`
+}
+
+type JobPostingSalary struct {
+	Type     string                `json:"@type"`
+	Currency string                `json:"currency"`
+	Value    JobPostingSalaryValue `json:"value"`
+}
+
+type JobPostingSalaryValue struct {
+	Type     string `json:"@type"`
+	Value    int64  `json:"value"`
+	UnitText string `json:"unitText"`
+}
+
+// NewJobPosting maps an opening to its JobPosting. A closed opening is
+// valid through the moment it was last changed, which tells indexers to
+// drop it.
+func NewJobPosting(o *Opening, policy JobPostingPolicy) JobPosting {
+	validThrough := o.UpdatedAt
+	if o.IsOpen() {
+		validThrough = validThrough.Add(policy.Validity)
+	}
+
+	posting := JobPosting{
+		Context: "https://schema.org/",
+		Type:    "JobPosting",
+		Identifier: JobPostingIdentifier{
+			Type:  "PropertyValue",
+			Name:  o.Company,
+			Value: strconv.FormatUint(uint64(o.ID), 10),
+		},
+		Title:        o.Role,
+		Description:  jobPostingDescription(o),
+		DatePosted:   o.CreatedAt.UTC().Format("2006-01-02"),
+		ValidThrough: validThrough.UTC().Format(time.RFC3339),
+		HiringOrganization: JobPostingOrg{
+			Type: "Organization",
+			Name: o.Company,
+		},
+		URL: o.Link,
+	}
+	if o.Location != "" {
+		posting.JobLocation = &JobPostingPlace{
+			Type:    "Place",
+			Address: JobPostingAddress{Type: "PostalAddress", AddressLocality: o.Location},
+		}
+	}
+	if o.Remote {
+		posting.JobLocationType = "TELECOMMUTE"
+	}
+	if o.Salary > 0 {
+		posting.BaseSalary = &JobPostingSalary{
+			Type:     "MonetaryAmount",
+			Currency: policy.Currency,
+			Value: JobPostingSalaryValue{
+				Type:     "QuantitativeValue",
+				Value:    o.Salary,
+				UnitText: policy.SalaryUnit,
+			},
+		}
+	}
+	return posting
+}
+
+func jobPostingDescription(o *Opening) string {
+	workplace := "on-site"
+	if o.Remote {
+		workplace = "remote"
+	}
+	return fmt.Sprintf("%s is hiring a %s in %s (%s).", o.Company, o.Role, o.Location, workplace)
+}
+
+// Validate checks the properties schema.org and search engines require of
+// a JobPosting: title, description, datePosted, hiringOrganization and
+// either jobLocation or jobLocationType TELECOMMUTE.
+func (p JobPosting) Validate() error {
+	var missing []string
+	if strings.TrimSpace(p.Title) == "" {
+		missing = append(missing, "title")
+	}
+	if strings.TrimSpace(p.Description) == "" {
+		missing = append(missing, "description")
+	}
+	if p.DatePosted == "" {
+		missing = append(missing, "datePosted")
+	}
+	if strings.TrimSpace(p.HiringOrganization.Name) == "" {
+		missing = append(missing, "hiringOrganization")
+	}
+	if p.JobLocation == nil && p.JobLocationType != "TELECOMMUTE" {
+		missing = append(missing, "jobLocation")
+	}
+	if len(missing) > 0 {
+		return errors.New("job posting is missing " + strings.Join(missing, ", "))
+	}
+	return nil
+}
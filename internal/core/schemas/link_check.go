@@ -0,0 +1,45 @@
+package schemas
+
+import (
+	"net/http"
+	"time"
+)
+
+// LinkCheckPolicy sets how the links of open openings are checked. A link
+// is checked again once Interval has passed since its last check, and
+// requests to the same host are at least HostDelay apart. An opening is
+// paused after MaxFailures failed checks in a row, and resumed by the next
+// healthy check; 0 never pauses it. Links to loopback, private and
+// link-local addresses fail without a request unless AllowPrivateHosts is
+// set.
+type LinkCheckPolicy struct {
+	Enabled           bool
+	Interval          time.Duration
+	PollInterval      time.Duration
+	HostDelay         time.Duration
+	Timeout           time.Duration
+	MaxFailures       int
+	AllowPrivateHosts bool
+}
+
+// LinkCheck is the outcome of requesting URL, the link of an opening.
+// Status is the status of the last response once redirects were followed,
+// or 0 when there was none, and Redirect is the URL it came from when it
+// differs from URL.
+type LinkCheck struct {
+	URL       string
+	Status    int
+	Redirect  string
+	Error     string
+	CheckedAt time.Time
+}
+
+func (c LinkCheck) Healthy() bool {
+	return c.Status >= 200 && c.Status <= 299
+}
+
+// Throttled reports whether the host asked to be called less often. Such a
+// check neither counts as a failure nor clears the previous ones.
+func (c LinkCheck) Throttled() bool {
+	return c.Status == http.StatusTooManyRequests
+}
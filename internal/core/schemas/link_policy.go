@@ -0,0 +1,7 @@
+package schemas
+
+// LinkPolicy lists the hosts openings must not link to. A host also
+// denies its subdomains.
+type LinkPolicy struct {
+	DeniedHosts []string
+}
@@ -6,6 +6,11 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	OpeningStatusOpen   = "open"
+	OpeningStatusClosed = "closed"
+)
+
 type Opening struct {
 	gorm.Model
 	Role     string
@@ -14,19 +19,78 @@ type Opening struct {
 	Remote   bool
 	Link     string
 	Salary   int64
+	Status   string `gorm:"default:open"`
+	// Source and ExternalID identify an opening synced from an ATS feed.
+	// Both are empty for openings created through the API.
+	Source     string `gorm:"index:idx_opening_external"`
+	ExternalID string `gorm:"index:idx_opening_external"`
+	// SyncClosedVersion is the version a sync closed the opening at because
+	// its job left the feed. While the opening is still at that version,
+	// nobody else touched it and a sync may reopen it.
+	SyncClosedVersion uint `json:",omitempty"`
+	// DuplicateOf is the opening this one was flagged as a likely duplicate
+	// of when it was created.
+	DuplicateOf *uint `gorm:"index" json:",omitempty"`
+	// LinkKey and CompanyKey are the normalized link and company that
+	// duplicates are looked up by. The repository keeps them up to date.
+	LinkKey    string `gorm:"index"`
+	CompanyKey string `gorm:"index"`
+	// LinkStatus, LinkRedirect, LinkError and LinkCheckedAt describe the
+	// last check of Link; LinkFailures counts the checks in a row that
+	// failed, and LinkPaused holds an open opening back while they reach
+	// the limit. Only the link checker writes them.
+	LinkStatus    int
+	LinkRedirect  string
+	LinkError     string
+	LinkCheckedAt *time.Time `gorm:"index"`
+	LinkFailures  int
+	LinkPaused    bool `gorm:"not null;default:false"`
+	// Version is bumped on every write and backs the ETag of the opening.
+	Version uint `gorm:"not null;default:1"`
+	// StageCounts holds how many applications sit in each pipeline stage.
+	// It is only filled in on the opening detail.
+	StageCounts map[string]int64 `gorm:"-" json:",omitempty"`
+}
+
+// IsOpen reports whether the opening takes applications: its status is
+// open and its link is not paused.
+func (o *Opening) IsOpen() bool {
+	return o.HasOpenStatus() && !o.LinkPaused
+}
+
+func (o *Opening) HasOpenStatus() bool {
+	return o.Status == "" || o.Status == OpeningStatusOpen
+}
+
+// ClosedBySync reports whether the opening was last written by a sync
+// that closed it.
+func (o *Opening) ClosedBySync() bool {
+	return o.Status == OpeningStatusClosed && o.SyncClosedVersion != 0 && o.SyncClosedVersion == o.Version
 }
 
 type OpeningResponse struct {
-	ID        uint       `json:"id"`
-	CreatedAt time.Time  `json:"createdAt"`
-	UpdatedAt time.Time  `json:"updatedAt"`
-	DeletedAt *time.Time `json:"deteledAt,omitempty"`
-	Role      string     `json:"role"`
-	Company   string     `json:"company"`
-	Location  string     `json:"location"`
-	Remote    bool       `json:"remote"`
-	Link      string     `json:"link"`
-	Salary    int64      `json:"salary"`
+	ID            uint             `json:"id"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+	DeletedAt     *time.Time       `json:"deteledAt,omitempty"`
+	Role          string           `json:"role"`
+	Company       string           `json:"company"`
+	Location      string           `json:"location"`
+	Remote        bool             `json:"remote"`
+	Link          string           `json:"link"`
+	Salary        int64            `json:"salary"`
+	Status        string           `json:"status"`
+	Source        string           `json:"source,omitempty"`
+	ExternalID    string           `json:"externalId,omitempty"`
+	DuplicateOf   *uint            `json:"duplicateOf,omitempty"`
+	LinkStatus    int              `json:"linkStatus,omitempty"`
+	LinkRedirect  string           `json:"linkRedirect,omitempty"`
+	LinkError     string           `json:"linkError,omitempty"`
+	LinkCheckedAt *time.Time       `json:"linkCheckedAt,omitempty"`
+	LinkFailures  int              `json:"linkFailures,omitempty"`
+	LinkPaused    bool             `json:"linkPaused,omitempty"`
+	Version       uint             `json:"version"`
+	StageCounts   map[string]int64 `json:"stageCounts,omitempty"`
 }
 
 type CreateOpeningRequest struct {
@@ -45,4 +109,41 @@ type UpdateOpeningRequest struct {
 	Remote   *bool  `json:"remote"`
 	Link     string `json:"link"`
 	Salary   int64  `json:"salary"`
+	Status   string `json:"status"`
+}
+
+const (
+	MergePatchMediaType = "application/merge-patch+json"
+	JSONPatchMediaType  = "application/json-patch+json"
+)
+
+// OpeningDocument is the JSON form of an opening that PATCH requests are
+// applied to. A nil field is missing from the document, e.g. after a merge
+// patch set it to null.
+type OpeningDocument struct {
+	Role     *string `json:"role,omitempty"`
+	Company  *string `json:"company,omitempty"`
+	Location *string `json:"location,omitempty"`
+	Remote   *bool   `json:"remote,omitempty"`
+	Link     *string `json:"link,omitempty"`
+	Salary   *int64  `json:"salary,omitempty"`
+	Status   *string `json:"status,omitempty"`
+}
+
+// Precondition limits a write to the listed versions of an opening, as
+// sent in an If-Match header. A nil Precondition allows any version.
+type Precondition struct {
+	Versions []uint
+}
+
+func (p *Precondition) Allows(version uint) bool {
+	if p == nil {
+		return true
+	}
+	for _, v := range p.Versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,27 @@
+package schemas
+
+const (
+	BulkModeTransactional = "transactional"
+	BulkModeBestEffort    = "best_effort"
+
+	BulkOpCreate = "create"
+	BulkOpUpdate = "update"
+	BulkOpDelete = "delete"
+)
+
+// BulkOpeningRequest is a batch of opening writes. In the transactional
+// mode, the default, either every operation is applied or none is; in the
+// best_effort mode each operation stands on its own.
+type BulkOpeningRequest struct {
+	Mode       string                 `json:"mode" enums:"transactional,best_effort"`
+	Operations []BulkOpeningOperation `json:"operations"`
+}
+
+// BulkOpeningOperation creates an opening, or updates or deletes the one
+// with ID. A non-zero Version works like an If-Match on that opening.
+type BulkOpeningOperation struct {
+	Op      string               `json:"op" enums:"create,update,delete"`
+	ID      uint                 `json:"id,omitempty"`
+	Version uint                 `json:"version,omitempty"`
+	Opening UpdateOpeningRequest `json:"opening"`
+}
@@ -0,0 +1,20 @@
+package schemas
+
+import "time"
+
+const (
+	OpeningCreatedEvent = "opening.created"
+	OpeningUpdatedEvent = "opening.updated"
+	OpeningDeletedEvent = "opening.deleted"
+)
+
+var OpeningEventTypes = []string{OpeningCreatedEvent, OpeningUpdatedEvent, OpeningDeletedEvent}
+
+// OpeningEvent describes a stored change of an opening. ID is unique per
+// event so that consumers can drop duplicates.
+type OpeningEvent struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Opening    Opening   `json:"data"`
+}
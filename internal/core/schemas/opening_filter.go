@@ -0,0 +1,69 @@
+package schemas
+
+import (
+	"errors"
+	"strings"
+)
+
+// OpeningFilter narrows the openings listed or streamed. Empty fields match
+// everything; text fields are case insensitive.
+type OpeningFilter struct {
+	// Query must appear in the role, company or location.
+	Query     string
+	Company   string
+	Location  string
+	Remote    *bool
+	MinSalary int64
+	Status    string
+}
+
+func (f *OpeningFilter) Validate() error {
+	if f.MinSalary < 0 {
+		return errors.New("minSalary must not be negative")
+	}
+
+	if f.Status != "" && f.Status != OpeningStatusOpen && f.Status != OpeningStatusClosed {
+		return errors.New("status must be one of: open, closed")
+	}
+
+	return nil
+}
+
+// Matches applies the filter to a single opening, the way the repository
+// applies it to the listing query.
+func (f *OpeningFilter) Matches(o *Opening) bool {
+	if f.Query != "" {
+		query := strings.ToLower(f.Query)
+		if !strings.Contains(strings.ToLower(o.Role), query) &&
+			!strings.Contains(strings.ToLower(o.Company), query) &&
+			!strings.Contains(strings.ToLower(o.Location), query) {
+			return false
+		}
+	}
+
+	if f.Company != "" && !strings.EqualFold(o.Company, f.Company) {
+		return false
+	}
+
+	if f.Location != "" && !strings.Contains(strings.ToLower(o.Location), strings.ToLower(f.Location)) {
+		return false
+	}
+
+	if f.Remote != nil && *f.Remote != o.Remote {
+		return false
+	}
+
+	if o.Salary < f.MinSalary {
+		return false
+	}
+
+	if f.Status == OpeningStatusOpen && !o.IsOpen() {
+		return false
+	}
+
+	if f.Status == OpeningStatusClosed && o.IsOpen() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+package schemas
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ImportFormatCSV    = "csv"
+	ImportFormatNDJSON = "ndjson"
+
+	// ImportImported, ImportValidated and ImportRejected are the outcomes
+	// of an import: stored, checked by a dry run, or refused because of
+	// invalid rows.
+	ImportImported  = "imported"
+	ImportValidated = "validated"
+	ImportRejected  = "rejected"
+
+	ImportJobPending   = "pending"
+	ImportJobRunning   = "running"
+	ImportJobCompleted = "completed"
+	ImportJobFailed    = "failed"
+
+	// ImportJobInterrupted is the error of a job that was still pending or
+	// running when the process stopped.
+	ImportJobInterrupted = "the import was interrupted by a restart, send the file again"
+)
+
+// ImportRow is one opening read from an import file. Line is where it
+// starts in the file; Error is set when the row could not be read.
+type ImportRow struct {
+	Line    int
+	Opening CreateOpeningRequest
+	Error   string
+}
+
+// ImportRowResult is the outcome of one row. Causes lists the fields that
+// failed validation, and Error joins their messages.
+type ImportRowResult struct {
+	Line   int              `json:"line"`
+	ID     uint             `json:"id,omitempty"`
+	Error  string           `json:"error,omitempty"`
+	Causes []ImportRowCause `json:"causes,omitempty"`
+}
+
+type ImportRowCause struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ImportReport struct {
+	Result  string            `json:"result" enums:"imported,validated,rejected"`
+	DryRun  bool              `json:"dryRun"`
+	Total   int               `json:"total"`
+	Invalid int               `json:"invalid"`
+	Rows    []ImportRowResult `json:"rows"`
+}
+
+// ImportJob is an import run in the background. Report holds the JSON
+// ImportReport once the job is completed.
+type ImportJob struct {
+	gorm.Model
+	Status      string
+	Format      string
+	DryRun      bool
+	Rows        int
+	Report      string
+	Error       string
+	CompletedAt *time.Time
+}
+
+type ImportJobResponse struct {
+	ID          uint          `json:"id"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	Status      string        `json:"status" enums:"pending,running,completed,failed"`
+	DryRun      bool          `json:"dryRun"`
+	Rows        int           `json:"rows"`
+	Report      *ImportReport `json:"report,omitempty"`
+	Error       string        `json:"error,omitempty"`
+	CompletedAt *time.Time    `json:"completedAt,omitempty"`
+}
+
+// ImportOptions describe how to read and run an import file. Mapping
+// names the CSV column to read for an opening field.
+type ImportOptions struct {
+	Format  string
+	Mapping map[string]string
+	DryRun  bool
+	Async   bool
+}
@@ -0,0 +1,21 @@
+package schemas
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OpeningRevision struct {
+	gorm.Model
+	OpeningID uint `gorm:"uniqueIndex:idx_opening_revision"`
+	Revision  uint `gorm:"uniqueIndex:idx_opening_revision"`
+	Snapshot  string
+}
+
+type OpeningRevisionResponse struct {
+	OpeningID uint      `json:"openingId"`
+	Revision  uint      `json:"revision"`
+	CreatedAt time.Time `json:"createdAt"`
+	Opening   Opening   `json:"opening"`
+}
@@ -0,0 +1,51 @@
+package schemas
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OutboxEvent is an opening event waiting to be relayed. It is written in
+// the transaction that changed the opening, so an event exists if and only
+// if the change was committed. An event still failing after MaxAttempts is
+// dead-lettered: DeadAt is set and the relay moves on without it.
+type OutboxEvent struct {
+	gorm.Model
+	EventID       string `gorm:"uniqueIndex"`
+	Type          string
+	Payload       string
+	PublishedAt   *time.Time `gorm:"index"`
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DeadAt        *time.Time `gorm:"index"`
+}
+
+// OutboxPolicy sets how often the relay looks for pending events and how it
+// backs off while a publisher is failing. An event is dead-lettered after
+// MaxAttempts failed attempts, or never when it is zero. Published events
+// are deleted after Retention.
+type OutboxPolicy struct {
+	MaxAttempts  int
+	PollInterval time.Duration
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	Retention    time.Duration
+}
+
+func (p OutboxPolicy) Backoff(attempt int) time.Duration {
+	return exponentialBackoff(p.BaseDelay, p.MaxDelay, attempt)
+}
+
+// exponentialBackoff returns base * 2^(attempt-1), capped at max.
+func exponentialBackoff(base, max time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
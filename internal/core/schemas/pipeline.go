@@ -0,0 +1,83 @@
+package schemas
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	StageApplied  = "applied"
+	StageHired    = "hired"
+	StageRejected = "rejected"
+
+	PipelineSourceOpening = "opening"
+	PipelineSourceCompany = "company"
+	PipelineSourceDefault = "default"
+)
+
+var DefaultPipelineStages = []string{StageApplied, "screening", "interview", "offer", StageHired, StageRejected}
+
+// Pipeline is the ordered list of stages applications move through. A
+// pipeline belongs either to one opening or, with OpeningID zero, to every
+// opening of a company.
+type Pipeline struct {
+	gorm.Model
+	OpeningID uint   `gorm:"index"`
+	Company   string `gorm:"index"`
+	Stages    string
+}
+
+func (p *Pipeline) StageList() []string {
+	if p.Stages == "" {
+		return nil
+	}
+	return strings.Split(p.Stages, ",")
+}
+
+func (p *Pipeline) HasStage(stage string) bool {
+	for _, s := range p.StageList() {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+func IsTerminalStage(stage string) bool {
+	return stage == StageHired || stage == StageRejected
+}
+
+type ApplicationStageChange struct {
+	gorm.Model
+	ApplicationID uint `gorm:"index"`
+	FromStage     string
+	ToStage       string
+	Note          string
+	ChangedAt     time.Time
+}
+
+type PipelineResponse struct {
+	OpeningID uint     `json:"openingId,omitempty"`
+	Company   string   `json:"company,omitempty"`
+	Source    string   `json:"source"`
+	Stages    []string `json:"stages"`
+}
+
+type ApplicationStageChangeResponse struct {
+	ApplicationID uint      `json:"applicationId"`
+	FromStage     string    `json:"fromStage"`
+	ToStage       string    `json:"toStage"`
+	Note          string    `json:"note"`
+	ChangedAt     time.Time `json:"changedAt"`
+}
+
+type DefinePipelineRequest struct {
+	Stages []string `json:"stages"`
+}
+
+type TransitionApplicationRequest struct {
+	Stage string `json:"stage"`
+	Note  string `json:"note"`
+}
@@ -0,0 +1,11 @@
+package schemas
+
+import "time"
+
+// StreamPolicy configures the live stream of opening events. The last
+// ReplaySize events are kept in memory so that a client reconnecting with
+// Last-Event-ID does not miss anything.
+type StreamPolicy struct {
+	ReplaySize int
+	Heartbeat  time.Duration
+}
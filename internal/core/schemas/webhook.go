@@ -0,0 +1,120 @@
+package schemas
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// WebhookAllEvents subscribes a webhook to every opening event.
+	WebhookAllEvents = "*"
+
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+type Webhook struct {
+	gorm.Model
+	URL    string
+	Secret string `json:"-"`
+	// Events is a comma separated list of the event types sent to the
+	// webhook, or "*" for all of them.
+	Events string
+	Active bool
+}
+
+func (w *Webhook) EventList() []string {
+	return strings.Split(w.Events, ",")
+}
+
+func (w *Webhook) Accepts(eventType string) bool {
+	for _, event := range w.EventList() {
+		if event == WebhookAllEvents || event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is one event queued for one webhook. Payload is stored as
+// sent so that a redelivery is byte for byte the original request.
+type WebhookDelivery struct {
+	gorm.Model
+	WebhookID     uint   `gorm:"uniqueIndex:idx_webhook_event"`
+	EventID       string `gorm:"uniqueIndex:idx_webhook_event"`
+	EventType     string
+	Payload       string
+	Status        string `gorm:"index"`
+	Attempts      int
+	NextAttemptAt *time.Time `gorm:"index"`
+	LastError     string
+	DeliveredAt   *time.Time
+	AttemptLog    []WebhookAttempt `gorm:"foreignKey:DeliveryID"`
+}
+
+// WebhookAttempt records one request of a delivery. The body of the answer
+// is not kept, since webhook URLs are supplied by anyone.
+type WebhookAttempt struct {
+	gorm.Model
+	DeliveryID uint `gorm:"index"`
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// WebhookPolicy sets how deliveries are retried: attempt n waits
+// BaseDelay * 2^(n-1), capped at MaxDelay, until MaxAttempts is reached.
+// Deliveries to loopback, private and link-local addresses are refused
+// unless AllowPrivateHosts is set.
+type WebhookPolicy struct {
+	MaxAttempts       int
+	BaseDelay         time.Duration
+	MaxDelay          time.Duration
+	Timeout           time.Duration
+	PollInterval      time.Duration
+	AllowPrivateHosts bool
+}
+
+// Backoff returns the wait after the given failed attempt.
+func (p WebhookPolicy) Backoff(attempt int) time.Duration {
+	return exponentialBackoff(p.BaseDelay, p.MaxDelay, attempt)
+}
+
+type WebhookResponse struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	URL       string    `json:"url"`
+	Events    string    `json:"events"`
+	Active    bool      `json:"active"`
+}
+
+type WebhookAttemptResponse struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	StatusCode int       `json:"statusCode"`
+	Error      string    `json:"error"`
+	Duration   int64     `json:"duration"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID            uint                     `json:"id"`
+	CreatedAt     time.Time                `json:"createdAt"`
+	WebhookID     uint                     `json:"webhookId"`
+	EventID       string                   `json:"eventId"`
+	EventType     string                   `json:"eventType"`
+	Payload       string                   `json:"payload"`
+	Status        string                   `json:"status"`
+	Attempts      int                      `json:"attempts"`
+	NextAttemptAt *time.Time               `json:"nextAttemptAt,omitempty"`
+	LastError     string                   `json:"lastError"`
+	DeliveredAt   *time.Time               `json:"deliveredAt,omitempty"`
+	AttemptLog    []WebhookAttemptResponse `json:"attemptLog"`
+}
+
+type CreateWebhookRequest struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events"`
+}
@@ -0,0 +1,189 @@
+package alert_usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+// dueBatchSize caps how many emails a single RunDue sends.
+const dueBatchSize = 100
+
+// Dispatcher emails every confirmed alert matching a newly created opening,
+// at most once per alert and event. Publish only queues the emails, so a
+// slow or failing mail server never holds back the outbox relay; Run sends
+// them and retries the failed ones.
+type Dispatcher struct {
+	repo    repositories.JobAlertRepository
+	mailer  mailer.Mailer
+	baseURL string
+	policy  schemas.AlertPolicy
+	now     func() time.Time
+	wake    chan struct{}
+	logger  *config.Logger
+}
+
+func NewDispatcher(repo repositories.JobAlertRepository, m mailer.Mailer, baseURL string, policy schemas.AlertPolicy) *Dispatcher {
+	return &Dispatcher{
+		repo:    repo,
+		mailer:  m,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		policy:  policy,
+		now:     time.Now,
+		wake:    make(chan struct{}, 1),
+		logger:  config.GetLogger("alerts"),
+	}
+}
+
+// Publish queues an email for every active alert matching a new opening.
+// Other events are ignored, and so is an event that was already queued.
+func (d *Dispatcher) Publish(event schemas.OpeningEvent) error {
+	if event.Type != schemas.OpeningCreatedEvent {
+		return nil
+	}
+	if err := d.enqueue(event); err != nil {
+		return err
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (d *Dispatcher) enqueue(event schemas.OpeningEvent) error {
+	alerts, err := d.repo.FindActive()
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event.Opening)
+	if err != nil {
+		return err
+	}
+
+	now := d.now().UTC()
+	var deliveries []schemas.JobAlertDelivery
+	for i := range alerts {
+		if !alerts[i].Matches(&event.Opening) {
+			continue
+		}
+		deliveries = append(deliveries, schemas.JobAlertDelivery{
+			AlertID:       alerts[i].ID,
+			EventID:       event.ID,
+			Payload:       string(payload),
+			Status:        schemas.JobAlertDeliveryPending,
+			NextAttemptAt: &now,
+		})
+	}
+
+	return d.repo.CreateDeliveries(deliveries)
+}
+
+// Run sends due emails every PollInterval, or as soon as an event is
+// published, until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	interval := d.policy.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.RunDue(d.now()); err != nil {
+			d.logger.Errorf("alert dispatcher error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// RunDue sends every email scheduled at or before now. Failed sends are
+// not errors; only storage failures are returned.
+func (d *Dispatcher) RunDue(now time.Time) error {
+	deliveries, err := d.repo.FindDueDeliveries(now, dueBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		if err := d.attempt(delivery); err != nil {
+			errs = append(errs, fmt.Errorf("alert delivery %d: %w", delivery.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) attempt(delivery schemas.JobAlertDelivery) error {
+	alert, err := d.repo.FindByID(delivery.AlertID)
+	if err != nil || !alert.IsActive() {
+		delivery.Status = schemas.JobAlertDeliveryFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = "alert is no longer active"
+		return d.repo.UpdateDelivery(delivery)
+	}
+
+	var opening schemas.Opening
+	err = json.Unmarshal([]byte(delivery.Payload), &opening)
+	if err == nil {
+		err = d.mailer.Send(d.message(alert, &opening))
+	}
+
+	delivery.Attempts++
+	if err != nil {
+		delivery.LastError = err.Error()
+		if delivery.Attempts >= d.policy.MaxAttempts {
+			delivery.Status = schemas.JobAlertDeliveryFailed
+			delivery.NextAttemptAt = nil
+		} else {
+			next := d.now().Add(d.policy.Backoff(delivery.Attempts)).UTC()
+			delivery.NextAttemptAt = &next
+		}
+		return d.repo.UpdateDelivery(delivery)
+	}
+
+	sentAt := d.now().UTC()
+	delivery.Status = schemas.JobAlertDeliverySent
+	delivery.NextAttemptAt = nil
+	delivery.LastError = ""
+	delivery.SentAt = &sentAt
+	return d.repo.UpdateDelivery(delivery)
+}
+
+func (d *Dispatcher) message(alert *schemas.JobAlert, opening *schemas.Opening) mailer.Message {
+	unsubscribeURL := fmt.Sprintf("%s/alerts/unsubscribe?token=%s", d.baseURL, alert.UnsubscribeToken)
+
+	remote := "no"
+	if opening.Remote {
+		remote = "yes"
+	}
+
+	return mailer.Message{
+		To:      []string{alert.Email},
+		Subject: fmt.Sprintf("New opening: %s at %s", opening.Role, opening.Company),
+		Text: fmt.Sprintf("A new opening matches your alert for %s.\n\n%s at %s\nLocation: %s\nRemote: %s\nSalary: %d\nApply: %s\n\nUnsubscribe: %s\n",
+			describe(alert), opening.Role, opening.Company, opening.Location, remote, opening.Salary, opening.Link, unsubscribeURL),
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}
+}
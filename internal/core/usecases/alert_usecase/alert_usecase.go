@@ -0,0 +1,35 @@
+package alert_usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+type AlertUsecase interface {
+	Subscribe(ca schemas.CreateJobAlertRequest) *internal_error.InternalError
+	Confirm(token string) (*schemas.JobAlert, *internal_error.InternalError)
+	Unsubscribe(token string) *internal_error.InternalError
+}
+
+type AlertUseCase struct {
+	repo    repositories.JobAlertRepository
+	mailer  mailer.Mailer
+	baseURL string
+	now     func() time.Time
+}
+
+// NewAlertUseCase builds the usecase. baseURL is the public API root used
+// in the confirmation and unsubscribe links sent by email.
+func NewAlertUseCase(repo repositories.JobAlertRepository, m mailer.Mailer, baseURL string) *AlertUseCase {
+	return &AlertUseCase{
+		repo:    repo,
+		mailer:  m,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		now:     time.Now,
+	}
+}
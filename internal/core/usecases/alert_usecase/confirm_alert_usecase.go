@@ -0,0 +1,34 @@
+package alert_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// Confirm activates the alert. Opening the link again is harmless.
+func (uc *AlertUseCase) Confirm(token string) (*schemas.JobAlert, *internal_error.InternalError) {
+	if token == "" {
+		return nil, errParamIsRequired("token", "string")
+	}
+
+	alert, err := uc.repo.FindByConfirmToken(token)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("alert not found")
+	}
+
+	if alert.UnsubscribedAt != nil {
+		return nil, internal_error.NewBadRequestError("alert was unsubscribed")
+	}
+
+	if alert.ConfirmedAt != nil {
+		return alert, nil
+	}
+
+	now := uc.now()
+	alert.ConfirmedAt = &now
+	if err := uc.repo.Update(*alert); err != nil {
+		return nil, internal_error.NewInternalServerError("error confirming alert")
+	}
+
+	return alert, nil
+}
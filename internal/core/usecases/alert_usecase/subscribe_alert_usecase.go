@@ -0,0 +1,105 @@
+package alert_usecase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+)
+
+func errParamIsRequired(name, typ string) *internal_error.InternalError {
+	message := fmt.Sprintf("param: %s (type: %s) is required", name, typ)
+	return internal_error.NewBadRequestError(message)
+}
+
+// Subscribe stores an unconfirmed alert and emails the confirmation link.
+// Nothing is sent to the address until the link is opened.
+func (uc *AlertUseCase) Subscribe(ca schemas.CreateJobAlertRequest) *internal_error.InternalError {
+	err := validate(&ca)
+	if err != nil {
+		return err
+	}
+
+	alert := schemas.JobAlert{
+		Email:            strings.TrimSpace(ca.Email),
+		Keywords:         strings.Join(strings.Fields(ca.Keywords), " "),
+		Remote:           ca.Remote,
+		Location:         strings.TrimSpace(ca.Location),
+		MinSalary:        ca.MinSalary,
+		ConfirmToken:     newToken(),
+		UnsubscribeToken: newToken(),
+	}
+
+	if errRepo := uc.repo.Create(alert); errRepo != nil {
+		return internal_error.NewInternalServerError("error creating alert")
+	}
+
+	errMail := uc.mailer.Send(mailer.Message{
+		To:      []string{alert.Email},
+		Subject: "Confirm your job alert",
+		Text: fmt.Sprintf("Hi,\n\nPlease confirm your job alert for %s by opening the link below:\n\n%s/alerts/confirm?token=%s\n\nIf you did not ask for it, just ignore this email.\n",
+			describe(&alert), uc.baseURL, alert.ConfirmToken),
+	})
+	if errMail != nil {
+		return internal_error.NewInternalServerError("error sending confirmation email")
+	}
+
+	return nil
+}
+
+func validate(ca *schemas.CreateJobAlertRequest) *internal_error.InternalError {
+	if strings.TrimSpace(ca.Email) == "" {
+		return errParamIsRequired("email", "string")
+	}
+
+	if _, err := mail.ParseAddress(strings.TrimSpace(ca.Email)); err != nil {
+		return internal_error.NewBadRequestError("email must be a valid address")
+	}
+
+	if ca.MinSalary < 0 {
+		return internal_error.NewBadRequestError("minSalary must not be negative")
+	}
+
+	return nil
+}
+
+// describe renders the alert criteria for humans, e.g.
+// `"golang" remote openings in Brazil paying at least 5000`.
+func describe(alert *schemas.JobAlert) string {
+	var b strings.Builder
+	if alert.Keywords != "" {
+		fmt.Fprintf(&b, "%q ", alert.Keywords)
+	}
+
+	if alert.Remote != nil {
+		if *alert.Remote {
+			b.WriteString("remote ")
+		} else {
+			b.WriteString("on-site ")
+		}
+	}
+
+	b.WriteString("openings")
+	if alert.Location != "" {
+		fmt.Fprintf(&b, " in %s", alert.Location)
+	}
+
+	if alert.MinSalary > 0 {
+		fmt.Fprintf(&b, " paying at least %d", alert.MinSalary)
+	}
+
+	return b.String()
+}
+
+func newToken() string {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(token)
+}
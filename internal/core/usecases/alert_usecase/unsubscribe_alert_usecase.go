@@ -0,0 +1,28 @@
+package alert_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *AlertUseCase) Unsubscribe(token string) *internal_error.InternalError {
+	if token == "" {
+		return errParamIsRequired("token", "string")
+	}
+
+	alert, err := uc.repo.FindByUnsubscribeToken(token)
+	if err != nil {
+		return internal_error.NewNotFoundError("alert not found")
+	}
+
+	if alert.UnsubscribedAt != nil {
+		return nil
+	}
+
+	now := uc.now()
+	alert.UnsubscribedAt = &now
+	if err := uc.repo.Update(*alert); err != nil {
+		return internal_error.NewInternalServerError("error unsubscribing alert")
+	}
+
+	return nil
+}
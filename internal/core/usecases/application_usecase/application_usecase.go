@@ -0,0 +1,33 @@
+package application_usecase
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+type ApplicationUsecase interface {
+	Create(openingID uint, ca schemas.CreateApplicationRequest) *internal_error.InternalError
+	GetByID(id uint) (*schemas.Application, *internal_error.InternalError)
+	ListByOpening(openingID uint, page int) ([]schemas.Application, *internal_error.InternalError)
+	Transition(id uint, ta schemas.TransitionApplicationRequest) *internal_error.InternalError
+	History(id uint) ([]schemas.ApplicationStageChange, *internal_error.InternalError)
+}
+
+type ApplicationUseCase struct {
+	repo         repositories.ApplicationRepository
+	openingRepo  repositories.OpeningRepository
+	pipelineRepo repositories.PipelineRepository
+	now          func() time.Time
+}
+
+func NewApplicationUseCase(repo repositories.ApplicationRepository, openingRepo repositories.OpeningRepository, pipelineRepo repositories.PipelineRepository) *ApplicationUseCase {
+	return &ApplicationUseCase{
+		repo:         repo,
+		openingRepo:  openingRepo,
+		pipelineRepo: pipelineRepo,
+		now:          time.Now,
+	}
+}
@@ -0,0 +1,71 @@
+package application_usecase
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/pipeline_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func errParamIsRequired(name, typ string) *internal_error.InternalError {
+	message := fmt.Sprintf("param: %s (type: %s) is required", name, typ)
+	return internal_error.NewBadRequestError(message)
+}
+
+func (uc *ApplicationUseCase) Create(openingID uint, ca schemas.CreateApplicationRequest) *internal_error.InternalError {
+	err := validate(&ca)
+	if err != nil {
+		return err
+	}
+
+	opening, errRepo := uc.openingRepo.FindByID(openingID)
+	if errRepo != nil {
+		return internal_error.NewNotFoundError("opening not found")
+	}
+
+	if !opening.IsOpen() {
+		return internal_error.NewBadRequestError("opening is closed for applications")
+	}
+
+	pipeline, errPipeline := pipeline_usecase.ResolvePipeline(uc.pipelineRepo, opening)
+	if errPipeline != nil {
+		return internal_error.NewInternalServerError("error creating application")
+	}
+
+	now := uc.now()
+	application := schemas.Application{
+		OpeningID:      opening.ID,
+		CandidateName:  strings.TrimSpace(ca.CandidateName),
+		Email:          strings.TrimSpace(ca.Email),
+		Phone:          strings.TrimSpace(ca.Phone),
+		CoverLetter:    ca.CoverLetter,
+		Status:         pipeline.Stages[0],
+		StageChangedAt: &now,
+	}
+
+	errRepo = uc.repo.Create(application)
+	if errRepo != nil {
+		return internal_error.NewInternalServerError("error creating application")
+	}
+
+	return nil
+}
+
+func validate(ca *schemas.CreateApplicationRequest) *internal_error.InternalError {
+	if strings.TrimSpace(ca.CandidateName) == "" {
+		return errParamIsRequired("candidateName", "string")
+	}
+
+	if strings.TrimSpace(ca.Email) == "" {
+		return errParamIsRequired("email", "string")
+	}
+
+	if _, err := mail.ParseAddress(strings.TrimSpace(ca.Email)); err != nil {
+		return internal_error.NewBadRequestError("email must be a valid address")
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package application_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *ApplicationUseCase) GetByID(id uint) (*schemas.Application, *internal_error.InternalError) {
+	application, err := uc.repo.FindByID(id)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("application not found")
+	}
+
+	return application, nil
+}
@@ -0,0 +1,25 @@
+package application_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *ApplicationUseCase) ListByOpening(openingID uint, page int) ([]schemas.Application, *internal_error.InternalError) {
+	if _, err := uc.openingRepo.FindByID(openingID); err != nil {
+		return nil, internal_error.NewNotFoundError("opening not found")
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	limit := 10
+	offset := (page - 1) * limit
+
+	applications, err := uc.repo.FindAllByOpeningID(openingID, limit, offset)
+	if err != nil || len(applications) == 0 {
+		return nil, internal_error.NewNotFoundError("application record not found")
+	}
+
+	return applications, nil
+}
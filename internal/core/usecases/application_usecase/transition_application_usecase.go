@@ -0,0 +1,85 @@
+package application_usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/pipeline_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// Transition moves an application to another stage of its opening's
+// pipeline. Stages can be skipped or revisited, Kanban style, but nothing
+// leaves a terminal stage (hired or rejected).
+func (uc *ApplicationUseCase) Transition(id uint, ta schemas.TransitionApplicationRequest) *internal_error.InternalError {
+	stage := strings.TrimSpace(ta.Stage)
+	if stage == "" {
+		return errParamIsRequired("stage", "string")
+	}
+
+	application, err := uc.repo.FindByID(id)
+	if err != nil {
+		return internal_error.NewNotFoundError("application not found")
+	}
+
+	opening, err := uc.openingRepo.FindByID(application.OpeningID)
+	if err != nil {
+		return internal_error.NewNotFoundError("opening not found")
+	}
+
+	pipeline, err := pipeline_usecase.ResolvePipeline(uc.pipelineRepo, opening)
+	if err != nil {
+		return internal_error.NewInternalServerError("error moving application")
+	}
+
+	if !contains(pipeline.Stages, stage) {
+		message := fmt.Sprintf("stage %s is not part of the pipeline", stage)
+		return internal_error.NewBadRequestError(message)
+	}
+
+	if application.Status == stage {
+		message := fmt.Sprintf("application is already in stage %s", stage)
+		return internal_error.NewBadRequestError(message)
+	}
+
+	if schemas.IsTerminalStage(application.Status) {
+		message := fmt.Sprintf("application in stage %s cannot be moved", application.Status)
+		return internal_error.NewBadRequestError(message)
+	}
+
+	change := schemas.ApplicationStageChange{
+		FromStage: application.Status,
+		ToStage:   stage,
+		Note:      ta.Note,
+		ChangedAt: uc.now(),
+	}
+
+	if err := uc.repo.UpdateStage(*application, change); err != nil {
+		return internal_error.NewInternalServerError("error moving application")
+	}
+
+	return nil
+}
+
+func (uc *ApplicationUseCase) History(id uint) ([]schemas.ApplicationStageChange, *internal_error.InternalError) {
+	if _, err := uc.repo.FindByID(id); err != nil {
+		return nil, internal_error.NewNotFoundError("application not found")
+	}
+
+	history, err := uc.repo.FindHistory(id)
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error reading application history")
+	}
+
+	return history, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
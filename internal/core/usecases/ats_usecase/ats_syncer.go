@@ -0,0 +1,120 @@
+package ats_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/ats"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+)
+
+// maxFeedSize caps how much of a feed is read.
+const maxFeedSize = 32 << 20
+
+// Syncer keeps the openings of every ATS source in line with its feed.
+// A feed that cannot be fetched or parsed leaves its openings untouched,
+// so an outage of the ATS never closes them.
+type Syncer struct {
+	openings opening_usecase.OpeningUsecase
+	policy   schemas.ATSPolicy
+	client   *http.Client
+	logger   *config.Logger
+}
+
+func NewSyncer(openings opening_usecase.OpeningUsecase, policy schemas.ATSPolicy) *Syncer {
+	return &Syncer{
+		openings: openings,
+		policy:   policy,
+		client:   &http.Client{Timeout: policy.Timeout},
+		logger:   config.GetLogger("ats"),
+	}
+}
+
+// Run syncs every source each Interval until ctx is done.
+func (s *Syncer) Run(ctx context.Context) {
+	if len(s.policy.Sources) == 0 {
+		return
+	}
+
+	interval := s.policy.Interval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.SyncAll(ctx); err != nil {
+			s.logger.Errorf("ATS sync error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncAll syncs every source, carrying on past the ones that fail.
+func (s *Syncer) SyncAll(ctx context.Context) error {
+	var errs []error
+	for _, source := range s.policy.Sources {
+		report, err := s.Sync(ctx, source)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ATS source %s: %w", source.Name, err))
+			continue
+		}
+		s.logger.Infof("ATS source %s: %d jobs, %d created, %d updated, %d unchanged, %d closed, %d skipped",
+			source.Name, report.Fetched, report.Created, report.Updated, report.Unchanged, report.Closed, report.Skipped)
+	}
+	return errors.Join(errs...)
+}
+
+// Sync fetches the feed of source and syncs its openings.
+func (s *Syncer) Sync(ctx context.Context, source schemas.ATSSource) (*schemas.ATSSyncReport, error) {
+	jobs, err := s.fetch(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range jobs {
+		if jobs[i].Company == "" {
+			jobs[i].Company = source.Company
+		}
+	}
+
+	report, errCase := s.openings.Sync(source.Name, jobs)
+	if errCase != nil {
+		return nil, errCase
+	}
+	return report, nil
+}
+
+func (s *Syncer) fetch(ctx context.Context, source schemas.ATSSource) ([]schemas.Opening, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", ats.Accept(source.Format))
+	req.Header.Set("User-Agent", "go-opportunities-ats-sync")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("feed responded with status %d", resp.StatusCode)
+	}
+
+	return ats.Parse(source.Format, io.LimitReader(resp.Body, maxFeedSize))
+}
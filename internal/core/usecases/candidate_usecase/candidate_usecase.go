@@ -0,0 +1,44 @@
+package candidate_usecase
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
+)
+
+type CandidateUsecase interface {
+	Create(openingID uint, cp schemas.CreateCandidateProfileRequest, resume schemas.ResumeUpload) *internal_error.InternalError
+	GetByID(id uint) (*schemas.CandidateProfile, *internal_error.InternalError)
+	ResumeURL(id uint) (*schemas.ResumeURLResponse, *internal_error.InternalError)
+}
+
+type ResumeLimits struct {
+	MaxSize   int64
+	URLExpiry time.Duration
+}
+
+var DefaultResumeLimits = ResumeLimits{
+	MaxSize:   5 << 20,
+	URLExpiry: 15 * time.Minute,
+}
+
+type CandidateUseCase struct {
+	repo        repositories.CandidateProfileRepository
+	openingRepo repositories.OpeningRepository
+	blobs       storage.BlobStorage
+	limits      ResumeLimits
+	now         func() time.Time
+}
+
+func NewCandidateUseCase(repo repositories.CandidateProfileRepository, openingRepo repositories.OpeningRepository, blobs storage.BlobStorage, limits ResumeLimits) *CandidateUseCase {
+	return &CandidateUseCase{
+		repo:        repo,
+		openingRepo: openingRepo,
+		blobs:       blobs,
+		limits:      limits,
+		now:         time.Now,
+	}
+}
@@ -0,0 +1,127 @@
+package candidate_usecase
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"path/filepath"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func errParamIsRequired(name, typ string) *internal_error.InternalError {
+	message := fmt.Sprintf("param: %s (type: %s) is required", name, typ)
+	return internal_error.NewBadRequestError(message)
+}
+
+func (uc *CandidateUseCase) Create(openingID uint, cp schemas.CreateCandidateProfileRequest, resume schemas.ResumeUpload) *internal_error.InternalError {
+	err := validate(&cp)
+	if err != nil {
+		return err
+	}
+
+	if resume.Content == nil || resume.Size <= 0 {
+		return errParamIsRequired("resume", "file")
+	}
+
+	if resume.Size > uc.limits.MaxSize {
+		message := fmt.Sprintf("resume must be at most %d bytes", uc.limits.MaxSize)
+		return internal_error.NewBadRequestError(message)
+	}
+
+	opening, errRepo := uc.openingRepo.FindByID(openingID)
+	if errRepo != nil {
+		return internal_error.NewNotFoundError("opening not found")
+	}
+
+	if !opening.IsOpen() {
+		return internal_error.NewBadRequestError("opening is closed for applications")
+	}
+
+	head := make([]byte, 512)
+	n, errRead := io.ReadFull(resume.Content, head)
+	if errRead != nil && errRead != io.ErrUnexpectedEOF {
+		return internal_error.NewBadRequestError("error reading resume")
+	}
+	head = head[:n]
+
+	contentType, ext := detectResumeType(head, resume.FileName)
+	if contentType == "" {
+		return internal_error.NewBadRequestError("resume must be a PDF or DOCX file")
+	}
+
+	key, errKey := newResumeKey(ext)
+	if errKey != nil {
+		return internal_error.NewInternalServerError("error storing resume")
+	}
+
+	content := io.MultiReader(bytes.NewReader(head), resume.Content)
+	if err := uc.blobs.Put(key, content, resume.Size, contentType); err != nil {
+		return internal_error.NewInternalServerError("error storing resume")
+	}
+
+	profile := schemas.CandidateProfile{
+		OpeningID:         opening.ID,
+		Name:              strings.TrimSpace(cp.Name),
+		Email:             strings.TrimSpace(cp.Email),
+		Phone:             strings.TrimSpace(cp.Phone),
+		ResumeKey:         key,
+		ResumeFileName:    filepath.Base(resume.FileName),
+		ResumeContentType: contentType,
+		ResumeSize:        resume.Size,
+	}
+
+	errRepo = uc.repo.Create(profile)
+	if errRepo != nil {
+		uc.blobs.Delete(key)
+		return internal_error.NewInternalServerError("error creating candidate profile")
+	}
+
+	return nil
+}
+
+func validate(cp *schemas.CreateCandidateProfileRequest) *internal_error.InternalError {
+	if strings.TrimSpace(cp.Name) == "" {
+		return errParamIsRequired("name", "string")
+	}
+
+	if strings.TrimSpace(cp.Email) == "" {
+		return errParamIsRequired("email", "string")
+	}
+
+	if _, err := mail.ParseAddress(strings.TrimSpace(cp.Email)); err != nil {
+		return internal_error.NewBadRequestError("email must be a valid address")
+	}
+
+	return nil
+}
+
+// detectResumeType sniffs the file content instead of trusting the client
+// supplied content type. DOCX files are zip archives, so the extension is
+// used to tell them apart from any other zip.
+func detectResumeType(head []byte, fileName string) (string, string) {
+	detected := http.DetectContentType(head)
+	ext := strings.ToLower(filepath.Ext(fileName))
+
+	switch {
+	case detected == schemas.ResumeContentTypePDF:
+		return schemas.ResumeContentTypePDF, ".pdf"
+	case detected == "application/zip" && ext == ".docx":
+		return schemas.ResumeContentTypeDOCX, ".docx"
+	}
+	return "", ""
+}
+
+func newResumeKey(ext string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "resumes/" + hex.EncodeToString(b) + ext, nil
+}
@@ -0,0 +1,15 @@
+package candidate_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *CandidateUseCase) GetByID(id uint) (*schemas.CandidateProfile, *internal_error.InternalError) {
+	profile, err := uc.repo.FindByID(id)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("candidate profile not found")
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,23 @@
+package candidate_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *CandidateUseCase) ResumeURL(id uint) (*schemas.ResumeURLResponse, *internal_error.InternalError) {
+	profile, err := uc.repo.FindByID(id)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("candidate profile not found")
+	}
+
+	url, err := uc.blobs.SignedURL(profile.ResumeKey, uc.limits.URLExpiry)
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error signing resume url")
+	}
+
+	return &schemas.ResumeURLResponse{
+		URL:       url,
+		ExpiresAt: uc.now().Add(uc.limits.URLExpiry),
+	}, nil
+}
@@ -0,0 +1,39 @@
+package digest_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// Confirm activates the subscription. Its first digest covers the openings
+// created from now until the next scheduled send.
+func (uc *DigestUseCase) Confirm(token string) (*schemas.DigestSubscription, *internal_error.InternalError) {
+	if token == "" {
+		return nil, errParamIsRequired("token", "string")
+	}
+
+	subscription, err := uc.repo.FindByConfirmToken(token)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("digest subscription not found")
+	}
+
+	if subscription.UnsubscribedAt != nil {
+		return nil, internal_error.NewBadRequestError("digest subscription was unsubscribed")
+	}
+
+	if subscription.ConfirmedAt != nil {
+		return subscription, nil
+	}
+
+	now := uc.now().UTC()
+	next := uc.schedule.Next(subscription.Cadence, now).UTC()
+	subscription.ConfirmedAt = &now
+	subscription.LastPeriodEnd = &now
+	subscription.NextRunAt = &next
+
+	if err := uc.repo.Update(*subscription); err != nil {
+		return nil, internal_error.NewInternalServerError("error confirming digest subscription")
+	}
+
+	return subscription, nil
+}
@@ -0,0 +1,212 @@
+package digest_usecase
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+// maxDigestAttempts is how many times a period is retried before the
+// scheduler moves on. Its openings are then carried into the next digest.
+const maxDigestAttempts = 5
+
+//go:embed templates
+var templates embed.FS
+
+var (
+	textDigest = texttemplate.Must(texttemplate.ParseFS(templates, "templates/digest.txt"))
+	htmlDigest = htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/digest.html"))
+)
+
+type digestData struct {
+	Cadence        string
+	Openings       []schemas.Opening
+	UnsubscribeURL string
+}
+
+// Scheduler sends the digests that are due. Every period of a subscription
+// is recorded as a DigestDelivery before anything is sent, and the
+// subscription only moves on in the same transaction that marks the period
+// done, so a restart resumes exactly where it stopped. The email of a
+// period keeps the same Message-ID across retries for mail clients to
+// collapse a resend.
+type Scheduler struct {
+	repo        repositories.DigestRepository
+	openingRepo repositories.OpeningRepository
+	mailer      mailer.Mailer
+	baseURL     string
+	schedule    schemas.DigestSchedule
+	logger      *config.Logger
+}
+
+func NewScheduler(repo repositories.DigestRepository, openingRepo repositories.OpeningRepository, m mailer.Mailer, baseURL string, schedule schemas.DigestSchedule) *Scheduler {
+	return &Scheduler{
+		repo:        repo,
+		openingRepo: openingRepo,
+		mailer:      m,
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		schedule:    schedule,
+		logger:      config.GetLogger("digests"),
+	}
+}
+
+// Run checks for due digests every CheckInterval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	interval := s.schedule.CheckInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RunDue(time.Now()); err != nil {
+			s.logger.Errorf("digest scheduler error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue delivers every digest scheduled at or before now.
+func (s *Scheduler) RunDue(now time.Time) error {
+	subscriptions, err := s.repo.FindDue(now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		if err := s.deliver(subscription, now); err != nil {
+			errs = append(errs, fmt.Errorf("digest subscription %d: %w", subscription.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Scheduler) deliver(subscription schemas.DigestSubscription, now time.Time) error {
+	periodStart := *subscription.ConfirmedAt
+	if subscription.LastPeriodEnd != nil {
+		periodStart = *subscription.LastPeriodEnd
+	}
+	periodEnd := subscription.NextRunAt.UTC()
+
+	delivery, err := s.repo.StartDelivery(schemas.DigestDelivery{
+		SubscriptionID: subscription.ID,
+		PeriodStart:    periodStart,
+		PeriodEnd:      periodEnd,
+		Status:         schemas.DigestDeliveryPending,
+	})
+	if err != nil {
+		return err
+	}
+
+	// A late run still sends one digest; the openings since periodEnd wait
+	// for the next one.
+	next := s.schedule.Next(subscription.Cadence, latest(periodEnd, now)).UTC()
+	subscription.NextRunAt = &next
+
+	if delivery.Status != schemas.DigestDeliveryPending {
+		if delivery.Status != schemas.DigestDeliveryFailed {
+			subscription.LastPeriodEnd = &periodEnd
+		}
+		return s.repo.CompleteDelivery(*delivery, subscription)
+	}
+
+	openings, err := s.openingRepo.FindCreatedBetween(periodStart, periodEnd)
+	if err != nil {
+		return err
+	}
+
+	if len(openings) == 0 {
+		delivery.Status = schemas.DigestDeliveryEmpty
+		subscription.LastPeriodEnd = &periodEnd
+		return s.repo.CompleteDelivery(*delivery, subscription)
+	}
+
+	msg, err := s.message(&subscription, delivery, openings)
+	if err != nil {
+		return err
+	}
+
+	delivery.Attempts++
+	if errSend := s.mailer.Send(msg); errSend != nil {
+		delivery.LastError = errSend.Error()
+		if delivery.Attempts < maxDigestAttempts {
+			if err := s.repo.UpdateDelivery(*delivery); err != nil {
+				return err
+			}
+			return errSend
+		}
+
+		// Give up on this period but keep LastPeriodEnd, so that the next
+		// digest still includes these openings.
+		delivery.Status = schemas.DigestDeliveryFailed
+		if err := s.repo.CompleteDelivery(*delivery, subscription); err != nil {
+			return err
+		}
+		return errSend
+	}
+
+	sentAt := now
+	delivery.Status = schemas.DigestDeliverySent
+	delivery.Openings = len(openings)
+	delivery.LastError = ""
+	delivery.SentAt = &sentAt
+	subscription.LastPeriodEnd = &periodEnd
+	return s.repo.CompleteDelivery(*delivery, subscription)
+}
+
+func (s *Scheduler) message(subscription *schemas.DigestSubscription, delivery *schemas.DigestDelivery, openings []schemas.Opening) (mailer.Message, error) {
+	unsubscribeURL := fmt.Sprintf("%s/digests/unsubscribe?token=%s", s.baseURL, subscription.UnsubscribeToken)
+	data := digestData{Cadence: subscription.Cadence, Openings: openings, UnsubscribeURL: unsubscribeURL}
+
+	var text, html bytes.Buffer
+	if err := textDigest.Execute(&text, data); err != nil {
+		return mailer.Message{}, err
+	}
+	if err := htmlDigest.Execute(&html, data); err != nil {
+		return mailer.Message{}, err
+	}
+
+	subject := fmt.Sprintf("Your %s digest: %d new openings", subscription.Cadence, len(openings))
+	if len(openings) == 1 {
+		subject = fmt.Sprintf("Your %s digest: 1 new opening", subscription.Cadence)
+	}
+
+	return mailer.Message{
+		To:      []string{subscription.Email},
+		Subject: subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+		Headers: map[string]string{
+			"Message-ID":            fmt.Sprintf("<digest-%d-%d@go-opportunities>", subscription.ID, delivery.ID),
+			"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}, nil
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,35 @@
+package digest_usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+type DigestUsecase interface {
+	Subscribe(cd schemas.CreateDigestSubscriptionRequest) *internal_error.InternalError
+	Confirm(token string) (*schemas.DigestSubscription, *internal_error.InternalError)
+	Unsubscribe(token string) *internal_error.InternalError
+}
+
+type DigestUseCase struct {
+	repo     repositories.DigestRepository
+	mailer   mailer.Mailer
+	baseURL  string
+	schedule schemas.DigestSchedule
+	now      func() time.Time
+}
+
+func NewDigestUseCase(repo repositories.DigestRepository, m mailer.Mailer, baseURL string, schedule schemas.DigestSchedule) *DigestUseCase {
+	return &DigestUseCase{
+		repo:     repo,
+		mailer:   m,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		schedule: schedule,
+		now:      time.Now,
+	}
+}
@@ -0,0 +1,76 @@
+package digest_usecase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+)
+
+func errParamIsRequired(name, typ string) *internal_error.InternalError {
+	message := fmt.Sprintf("param: %s (type: %s) is required", name, typ)
+	return internal_error.NewBadRequestError(message)
+}
+
+func (uc *DigestUseCase) Subscribe(cd schemas.CreateDigestSubscriptionRequest) *internal_error.InternalError {
+	err := validate(&cd)
+	if err != nil {
+		return err
+	}
+
+	subscription := schemas.DigestSubscription{
+		Email:            strings.TrimSpace(cd.Email),
+		Cadence:          cd.Cadence,
+		ConfirmToken:     newToken(),
+		UnsubscribeToken: newToken(),
+	}
+
+	if errRepo := uc.repo.Create(subscription); errRepo != nil {
+		return internal_error.NewInternalServerError("error creating digest subscription")
+	}
+
+	errMail := uc.mailer.Send(mailer.Message{
+		To:      []string{subscription.Email},
+		Subject: fmt.Sprintf("Confirm your %s digest of new openings", subscription.Cadence),
+		Text: fmt.Sprintf("Hi,\n\nPlease confirm your %s digest of new openings by opening the link below:\n\n%s/digests/confirm?token=%s\n\nIf you did not ask for it, just ignore this email.\n",
+			subscription.Cadence, uc.baseURL, subscription.ConfirmToken),
+	})
+	if errMail != nil {
+		return internal_error.NewInternalServerError("error sending confirmation email")
+	}
+
+	return nil
+}
+
+func validate(cd *schemas.CreateDigestSubscriptionRequest) *internal_error.InternalError {
+	if strings.TrimSpace(cd.Email) == "" {
+		return errParamIsRequired("email", "string")
+	}
+
+	if _, err := mail.ParseAddress(strings.TrimSpace(cd.Email)); err != nil {
+		return internal_error.NewBadRequestError("email must be a valid address")
+	}
+
+	if cd.Cadence == "" {
+		return errParamIsRequired("cadence", "string")
+	}
+
+	if cd.Cadence != schemas.DigestDaily && cd.Cadence != schemas.DigestWeekly {
+		return internal_error.NewBadRequestError("cadence must be one of: daily, weekly")
+	}
+
+	return nil
+}
+
+func newToken() string {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(token)
+}
@@ -0,0 +1,28 @@
+package digest_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *DigestUseCase) Unsubscribe(token string) *internal_error.InternalError {
+	if token == "" {
+		return errParamIsRequired("token", "string")
+	}
+
+	subscription, err := uc.repo.FindByUnsubscribeToken(token)
+	if err != nil {
+		return internal_error.NewNotFoundError("digest subscription not found")
+	}
+
+	if subscription.UnsubscribedAt != nil {
+		return nil
+	}
+
+	now := uc.now()
+	subscription.UnsubscribedAt = &now
+	if err := uc.repo.Update(*subscription); err != nil {
+		return internal_error.NewInternalServerError("error unsubscribing digest")
+	}
+
+	return nil
+}
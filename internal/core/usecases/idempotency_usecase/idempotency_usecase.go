@@ -0,0 +1,169 @@
+package idempotency_usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+const (
+	// maxKeyLength caps the Idempotency-Key header.
+	maxKeyLength = 255
+	// defaultPendingTimeout is used when the policy sets no PendingTimeout.
+	defaultPendingTimeout = time.Minute
+	// purgeInterval is how often expired keys are deleted.
+	purgeInterval = time.Hour
+)
+
+type IdempotencyUsecase interface {
+	Begin(key, fingerprint string) (*schemas.IdempotencyRecord, *internal_error.InternalError)
+	Hold(key string) (release func())
+	Finish(key string, statusCode int, header map[string]string, body []byte) *internal_error.InternalError
+}
+
+type IdempotencyUseCase struct {
+	repo   repositories.IdempotencyRepository
+	policy schemas.IdempotencyPolicy
+	logger *config.Logger
+	now    func() time.Time
+}
+
+func NewIdempotencyUseCase(repo repositories.IdempotencyRepository, policy schemas.IdempotencyPolicy) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		repo:   repo,
+		policy: policy,
+		logger: config.GetLogger("idempotency"),
+		now:    time.Now,
+	}
+}
+
+// Begin claims key for a request with the given fingerprint. It returns
+// nil when the request should run, or the stored record whose response
+// must be replayed when the same request was already answered.
+func (uc *IdempotencyUseCase) Begin(key, fingerprint string) (*schemas.IdempotencyRecord, *internal_error.InternalError) {
+	if len(key) > maxKeyLength {
+		return nil, internal_error.NewBadRequestError(fmt.Sprintf("Idempotency-Key must be at most %d characters", maxKeyLength))
+	}
+
+	// A second attempt runs after an expired or abandoned key is dropped.
+	for attempt := 0; attempt < 2; attempt++ {
+		now := uc.now()
+		reserved, err := uc.repo.Reserve(&schemas.IdempotencyRecord{
+			Key:         key,
+			Fingerprint: fingerprint,
+			RenewedAt:   now,
+			ExpiresAt:   now.Add(uc.policy.TTL),
+		})
+		if err != nil {
+			return nil, internal_error.NewInternalServerError("error storing idempotency key")
+		}
+		if reserved {
+			return nil, nil
+		}
+
+		record, err := uc.repo.FindByKey(key)
+		if err != nil {
+			continue
+		}
+
+		abandoned := !record.Completed() && now.Sub(record.RenewedAt) > uc.pendingTimeout()
+		if now.After(record.ExpiresAt) || abandoned {
+			if err := uc.repo.Delete(record.ID); err != nil {
+				return nil, internal_error.NewInternalServerError("error storing idempotency key")
+			}
+			continue
+		}
+
+		if record.Fingerprint != fingerprint {
+			return nil, internal_error.NewUnprocessableEntityError("Idempotency-Key was already used for a different request")
+		}
+		if !record.Completed() {
+			return nil, internal_error.NewConflictError("a request with this Idempotency-Key is still being processed")
+		}
+		return record, nil
+	}
+
+	return nil, internal_error.NewConflictError("a request with this Idempotency-Key is still being processed")
+}
+
+// Hold keeps renewing the claim on key, a few times per PendingTimeout,
+// until release is called, so that a request running for longer than that
+// is not taken as abandoned.
+func (uc *IdempotencyUseCase) Hold(key string) (release func()) {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(uc.pendingTimeout() / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := uc.repo.Renew(key, uc.now()); err != nil {
+					uc.logger.Errorf("idempotency key renewal error: %v", err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+func (uc *IdempotencyUseCase) pendingTimeout() time.Duration {
+	if uc.policy.PendingTimeout > 0 {
+		return uc.policy.PendingTimeout
+	}
+	return defaultPendingTimeout
+}
+
+// Finish stores the response to the request that claimed key. Server
+// errors are not kept, so the key can be retried.
+func (uc *IdempotencyUseCase) Finish(key string, statusCode int, header map[string]string, body []byte) *internal_error.InternalError {
+	record, err := uc.repo.FindByKey(key)
+	if err != nil {
+		return internal_error.NewNotFoundError("idempotency key not found")
+	}
+
+	if statusCode >= http.StatusInternalServerError {
+		err = uc.repo.Delete(record.ID)
+	} else {
+		record.StatusCode = statusCode
+		record.Header = header
+		record.Body = body
+		err = uc.repo.Update(*record)
+	}
+	if err != nil {
+		return internal_error.NewInternalServerError("error storing idempotent response")
+	}
+	return nil
+}
+
+// Run deletes expired keys until ctx is done.
+func (uc *IdempotencyUseCase) Run(ctx context.Context) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := uc.repo.DeleteExpiredBefore(uc.now()); err != nil {
+			uc.logger.Errorf("idempotency purge error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
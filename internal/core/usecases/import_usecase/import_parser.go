@@ -0,0 +1,196 @@
+package import_usecase
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// maxRows caps the number of openings in one import file.
+const maxRows = 10000
+
+// importFields are the opening fields a CSV file must provide, in the
+// order they are looked up in its header.
+var importFields = []string{"role", "company", "location", "remote", "link", "salary"}
+
+func parseRows(format string, r io.Reader, mapping map[string]string) ([]schemas.ImportRow, *internal_error.InternalError) {
+	switch format {
+	case schemas.ImportFormatCSV:
+		return parseCSV(r, mapping)
+	case schemas.ImportFormatNDJSON:
+		return parseNDJSON(r)
+	}
+	return nil, internal_error.NewBadRequestError("format must be one of: csv, ndjson")
+}
+
+// parseCSV reads openings from a CSV file whose first record is a header.
+// Columns are matched to fields by name, ignoring case; mapping renames
+// the column used for a field, e.g. role=Job Title.
+func parseCSV(r io.Reader, mapping map[string]string) ([]schemas.ImportRow, *internal_error.InternalError) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, internal_error.NewBadRequestError("the file is empty")
+	}
+	if err != nil {
+		return nil, internal_error.NewBadRequestError("invalid CSV: " + err.Error())
+	}
+
+	columns, errCase := csvColumns(header, mapping)
+	if errCase != nil {
+		return nil, errCase
+	}
+
+	var rows []schemas.ImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, internal_error.NewBadRequestError("invalid CSV: " + err.Error())
+		}
+		if len(rows) == maxRows {
+			return nil, tooManyRows()
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, csvRow(line, record, columns))
+	}
+	return rows, nil
+}
+
+func csvColumns(header []string, mapping map[string]string) (map[string]int, *internal_error.InternalError) {
+	for field := range mapping {
+		if !isImportField(field) {
+			return nil, internal_error.NewBadRequestError(fmt.Sprintf("unknown field %q in map, must be one of: %s", field, strings.Join(importFields, ", ")))
+		}
+	}
+
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	columns := make(map[string]int, len(importFields))
+	for _, field := range importFields {
+		name := field
+		if mapped, ok := mapping[field]; ok {
+			name = mapped
+		}
+
+		index := -1
+		for i, column := range header {
+			if strings.EqualFold(strings.TrimSpace(column), strings.TrimSpace(name)) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, internal_error.NewBadRequestError(fmt.Sprintf("column %q for field %s not found in the header", name, field))
+		}
+		columns[field] = index
+	}
+	return columns, nil
+}
+
+func csvRow(line int, record []string, columns map[string]int) schemas.ImportRow {
+	row := schemas.ImportRow{Line: line}
+	value := func(field string) string {
+		if i := columns[field]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	row.Opening = schemas.CreateOpeningRequest{
+		Role:     value("role"),
+		Company:  value("company"),
+		Location: value("location"),
+		Link:     value("link"),
+	}
+
+	remote, err := parseRemote(value("remote"))
+	if err != nil {
+		row.Error = err.Error()
+		return row
+	}
+	row.Opening.Remote = remote
+
+	if salary := value("salary"); salary != "" {
+		n, err := strconv.ParseInt(salary, 10, 64)
+		if err != nil {
+			row.Error = fmt.Sprintf("salary must be a whole number, got %q", salary)
+			return row
+		}
+		row.Opening.Salary = n
+	}
+	return row
+}
+
+// parseRemote accepts the usual spreadsheet spellings of a yes/no cell.
+// An empty cell leaves remote unset, which validation then reports.
+func parseRemote(value string) (*bool, error) {
+	var remote bool
+	switch strings.ToLower(value) {
+	case "":
+		return nil, nil
+	case "true", "1", "yes", "y", "sim", "s":
+		remote = true
+	case "false", "0", "no", "n", "não", "nao":
+		remote = false
+	default:
+		return nil, fmt.Errorf("remote must be true or false, got %q", value)
+	}
+	return &remote, nil
+}
+
+// parseNDJSON reads one JSON opening per line. Blank lines are skipped.
+func parseNDJSON(r io.Reader) ([]schemas.ImportRow, *internal_error.InternalError) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+
+	var rows []schemas.ImportRow
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if len(rows) == maxRows {
+			return nil, tooManyRows()
+		}
+
+		row := schemas.ImportRow{Line: line}
+		if err := json.Unmarshal([]byte(text), &row.Opening); err != nil {
+			row.Error = "invalid JSON: " + err.Error()
+		}
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, internal_error.NewBadRequestError("invalid NDJSON: " + err.Error())
+	}
+	return rows, nil
+}
+
+func isImportField(field string) bool {
+	for _, f := range importFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func tooManyRows() *internal_error.InternalError {
+	return internal_error.NewBadRequestError(fmt.Sprintf("at most %d rows are allowed per import", maxRows))
+}
@@ -0,0 +1,217 @@
+package import_usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type ImportUsecase interface {
+	// Import reads the openings in r and imports them. Small files are
+	// imported right away and the report is returned; large ones, or any
+	// file when opts.Async is set, are queued as a job instead.
+	Import(r io.Reader, opts schemas.ImportOptions) (*schemas.ImportReport, *schemas.ImportJobResponse, *internal_error.InternalError)
+	GetJob(id uint) (*schemas.ImportJobResponse, *internal_error.InternalError)
+}
+
+type ImportUseCase struct {
+	openings  opening_usecase.OpeningUsecase
+	jobs      repositories.ImportJobRepository
+	asyncRows int
+	logger    *config.Logger
+	now       func() time.Time
+	running   sync.WaitGroup
+}
+
+// NewImportUseCase returns an ImportUsecase that runs files with more than
+// asyncRows openings as background jobs.
+func NewImportUseCase(openings opening_usecase.OpeningUsecase, jobs repositories.ImportJobRepository, asyncRows int) *ImportUseCase {
+	return &ImportUseCase{
+		openings:  openings,
+		jobs:      jobs,
+		asyncRows: asyncRows,
+		logger:    config.GetLogger("import"),
+		now:       time.Now,
+	}
+}
+
+func (uc *ImportUseCase) Import(r io.Reader, opts schemas.ImportOptions) (*schemas.ImportReport, *schemas.ImportJobResponse, *internal_error.InternalError) {
+	rows, errCase := parseRows(opts.Format, r, opts.Mapping)
+	if errCase != nil {
+		return nil, nil, errCase
+	}
+	if len(rows) == 0 {
+		return nil, nil, internal_error.NewBadRequestError("the file has no openings")
+	}
+
+	if !opts.Async && len(rows) <= uc.asyncRows {
+		report, errCase := uc.run(rows, opts.DryRun)
+		return report, nil, errCase
+	}
+
+	job := &schemas.ImportJob{
+		Status: schemas.ImportJobPending,
+		Format: opts.Format,
+		DryRun: opts.DryRun,
+		Rows:   len(rows),
+	}
+	if err := uc.jobs.Create(job); err != nil {
+		return nil, nil, internal_error.NewInternalServerError("error creating import job")
+	}
+
+	uc.running.Add(1)
+	go func(job schemas.ImportJob) {
+		defer uc.running.Done()
+		uc.runJob(job, rows)
+	}(*job)
+
+	return nil, toJobResponse(job), nil
+}
+
+func (uc *ImportUseCase) GetJob(id uint) (*schemas.ImportJobResponse, *internal_error.InternalError) {
+	job, err := uc.jobs.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, internal_error.NewNotFoundError("import job not found")
+		}
+		return nil, internal_error.NewInternalServerError("error finding import job")
+	}
+	return toJobResponse(job), nil
+}
+
+// FailInterrupted marks the jobs left pending or running by a previous
+// process as failed. Their rows only lived in that process's memory, so
+// they can never finish; it must run before this process starts any job.
+func (uc *ImportUseCase) FailInterrupted() error {
+	failed, err := uc.jobs.FailUnfinished(schemas.ImportJobInterrupted, uc.now())
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		uc.logger.Warnf("marked %d interrupted import jobs as failed", failed)
+	}
+	return nil
+}
+
+// Wait blocks until the import jobs started so far have finished.
+func (uc *ImportUseCase) Wait() {
+	uc.running.Wait()
+}
+
+func (uc *ImportUseCase) runJob(job schemas.ImportJob, rows []schemas.ImportRow) {
+	job.Status = schemas.ImportJobRunning
+	if err := uc.jobs.Update(job); err != nil {
+		uc.logger.Errorf("import job %d: error marking it running: %v", job.ID, err)
+	}
+
+	report, errCase := uc.run(rows, job.DryRun)
+	if errCase != nil {
+		job.Status = schemas.ImportJobFailed
+		job.Error = errCase.Message
+	} else {
+		body, err := json.Marshal(report)
+		if err != nil {
+			job.Status = schemas.ImportJobFailed
+			job.Error = "error encoding import report"
+		} else {
+			job.Status = schemas.ImportJobCompleted
+			job.Report = string(body)
+		}
+	}
+
+	completedAt := uc.now()
+	job.CompletedAt = &completedAt
+	if err := uc.jobs.Update(job); err != nil {
+		uc.logger.Errorf("import job %d: error storing the result: %v", job.ID, err)
+	}
+}
+
+// run imports the rows in one transaction. Nothing is stored when a row
+// cannot be read or fails validation, so the file can be fixed and sent
+// again as a whole.
+func (uc *ImportUseCase) run(rows []schemas.ImportRow, dryRun bool) (*schemas.ImportReport, *internal_error.InternalError) {
+	readFailed := false
+	var openings []schemas.CreateOpeningRequest
+	var index []int
+	for i, row := range rows {
+		if row.Error != "" {
+			readFailed = true
+			continue
+		}
+		openings = append(openings, row.Opening)
+		index = append(index, i)
+	}
+
+	var results []opening_usecase.BulkResult
+	if len(openings) > 0 {
+		var errCase *internal_error.InternalError
+		results, errCase = uc.openings.Import(openings, dryRun || readFailed)
+		if errCase != nil {
+			return nil, errCase
+		}
+	}
+
+	report := &schemas.ImportReport{
+		DryRun: dryRun,
+		Total:  len(rows),
+		Rows:   make([]schemas.ImportRowResult, len(rows)),
+	}
+	for i, row := range rows {
+		report.Rows[i] = schemas.ImportRowResult{Line: row.Line, Error: row.Error}
+	}
+	for j, result := range results {
+		item := &report.Rows[index[j]]
+		switch {
+		case result.Err != nil:
+			item.Error = result.Err.Message
+			for _, cause := range result.Err.Causes {
+				item.Causes = append(item.Causes, schemas.ImportRowCause{Field: cause.Field, Message: cause.Message})
+			}
+		case !dryRun && !result.RolledBack:
+			item.ID = result.ID
+		}
+	}
+
+	for _, item := range report.Rows {
+		if item.Error != "" {
+			report.Invalid++
+		}
+	}
+	switch {
+	case report.Invalid > 0:
+		report.Result = schemas.ImportRejected
+	case dryRun:
+		report.Result = schemas.ImportValidated
+	default:
+		report.Result = schemas.ImportImported
+	}
+	return report, nil
+}
+
+func toJobResponse(job *schemas.ImportJob) *schemas.ImportJobResponse {
+	response := &schemas.ImportJobResponse{
+		ID:          job.ID,
+		CreatedAt:   job.CreatedAt,
+		Status:      job.Status,
+		DryRun:      job.DryRun,
+		Rows:        job.Rows,
+		Error:       job.Error,
+		CompletedAt: job.CompletedAt,
+	}
+	if job.Report != "" {
+		var report schemas.ImportReport
+		if err := json.Unmarshal([]byte(job.Report), &report); err == nil {
+			response.Report = &report
+		}
+	}
+	return response
+}
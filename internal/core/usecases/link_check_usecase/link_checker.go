@@ -0,0 +1,219 @@
+package link_check_usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/internal/safehttp"
+)
+
+const (
+	// batchSize caps how many links are checked per poll.
+	batchSize = 100
+	// maxHosts caps how many hosts are checked at the same time.
+	maxHosts = 8
+	// maxBodySize caps how much of a GET response is read before the
+	// connection is reused.
+	maxBodySize = 64 << 10
+)
+
+// Checker requests the links of open openings and records whether they
+// still answer. Links on the same host are checked one at a time, at
+// least HostDelay apart, so a board listing many openings is not flooded.
+type Checker struct {
+	repo     repositories.OpeningRepository
+	openings opening_usecase.OpeningUsecase
+	policy   schemas.LinkCheckPolicy
+	client   *http.Client
+	logger   *config.Logger
+
+	mu   sync.Mutex
+	next map[string]time.Time
+}
+
+func NewChecker(repo repositories.OpeningRepository, openings opening_usecase.OpeningUsecase, policy schemas.LinkCheckPolicy) *Checker {
+	return &Checker{
+		repo:     repo,
+		openings: openings,
+		policy:   policy,
+		client: safehttp.NewClient(safehttp.Options{
+			Timeout:         policy.Timeout,
+			FollowRedirects: true,
+			AllowPrivate:    policy.AllowPrivateHosts,
+		}),
+		logger: config.GetLogger("links"),
+		next:   map[string]time.Time{},
+	}
+}
+
+// Run checks the links that are due every PollInterval until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	if !c.policy.Enabled {
+		return
+	}
+
+	interval := c.policy.PollInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.CheckDue(ctx, time.Now()); err != nil {
+			c.logger.Errorf("link checker error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckDue checks the links of the open openings not checked within
+// Interval of now, carrying on past the ones that cannot be recorded.
+func (c *Checker) CheckDue(ctx context.Context, now time.Time) error {
+	openings, err := c.repo.FindLinksDue(now.Add(-c.policy.Interval), batchSize)
+	if err != nil {
+		return err
+	}
+
+	byHost := map[string][]schemas.Opening{}
+	for _, opening := range openings {
+		host := ""
+		if u, err := url.Parse(opening.Link); err == nil {
+			host = u.Host
+		}
+		byHost[host] = append(byHost[host], opening)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		sem  = make(chan struct{}, maxHosts)
+	)
+	for host, openings := range byHost {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(host string, openings []schemas.Opening) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			for _, opening := range openings {
+				if err := c.wait(ctx, host); err != nil {
+					return
+				}
+				if err := c.record(opening, c.Check(ctx, opening.Link)); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}(host, openings)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// Check requests link with HEAD, and with GET when HEAD fails, as some
+// servers reject or mishandle it. A link that leads to an internal address
+// is only recorded as not allowed, without saying where it led.
+func (c *Checker) Check(ctx context.Context, link string) schemas.LinkCheck {
+	check := schemas.LinkCheck{URL: link, CheckedAt: time.Now()}
+
+	resp, err := c.request(ctx, http.MethodHead, link)
+	if err != nil || resp.StatusCode >= 400 {
+		resp, err = c.request(ctx, http.MethodGet, link)
+	}
+	if errors.Is(err, safehttp.ErrBlockedAddress) {
+		check.Error = safehttp.ErrBlockedAddress.Error()
+		return check
+	}
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+
+	check.Status = resp.StatusCode
+	if final := resp.Request.URL.String(); final != link {
+		check.Redirect = final
+	}
+	if !check.Healthy() {
+		check.Error = resp.Status
+	}
+	return check
+}
+
+func (c *Checker) request(ctx context.Context, method, link string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "go-opportunities-link-checker")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodySize))
+	resp.Body.Close()
+	return resp, nil
+}
+
+func (c *Checker) record(opening schemas.Opening, check schemas.LinkCheck) error {
+	saved, errCase := c.openings.RecordLinkCheck(opening.ID, check, c.policy.MaxFailures)
+	if errCase != nil {
+		return fmt.Errorf("opening %d: %w", opening.ID, errCase)
+	}
+	switch {
+	case !opening.LinkPaused && saved.LinkPaused:
+		c.logger.Infof("opening %d paused after %d failed checks of %s", saved.ID, saved.LinkFailures, saved.Link)
+	case opening.LinkPaused && !saved.LinkPaused:
+		c.logger.Infof("opening %d resumed after a healthy check of %s", saved.ID, saved.Link)
+	}
+	return nil
+}
+
+// wait blocks until a request to host is allowed, and books the next slot
+// HostDelay later.
+func (c *Checker) wait(ctx context.Context, host string) error {
+	now := time.Now()
+
+	c.mu.Lock()
+	at := c.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	c.next[host] = at.Add(c.policy.HostDelay)
+	for h, next := range c.next {
+		if next.Before(now) {
+			delete(c.next, h)
+		}
+	}
+	c.mu.Unlock()
+
+	timer := time.NewTimer(at.Sub(now))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package opening_usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+// maxBulkOperations caps the size of a bulk request.
+const maxBulkOperations = 500
+
+var errBulkRolledBack = errors.New("bulk operations rolled back")
+
+// BulkResult is the outcome of one bulk operation. RolledBack marks an
+// operation that succeeded but was undone because another one failed.
+type BulkResult struct {
+	ID         uint
+	Err        *internal_error.InternalError
+	RolledBack bool
+}
+
+// Bulk runs a batch of create, update and delete operations through the
+// same validation as the single-opening calls. Every operation is run even
+// after one fails, so all the errors of a batch are reported at once.
+func (uc *OpeningUseCase) Bulk(req schemas.BulkOpeningRequest) ([]BulkResult, *internal_error.InternalError) {
+	if req.Mode == "" {
+		req.Mode = schemas.BulkModeTransactional
+	}
+	if req.Mode != schemas.BulkModeTransactional && req.Mode != schemas.BulkModeBestEffort {
+		return nil, internal_error.NewBadRequestError("mode must be one of: transactional, best_effort")
+	}
+	if len(req.Operations) == 0 {
+		return nil, errParamIsRequired("operations", "array")
+	}
+	if len(req.Operations) > maxBulkOperations {
+		return nil, internal_error.NewBadRequestError(fmt.Sprintf("at most %d operations are allowed per request", maxBulkOperations))
+	}
+
+	var results []BulkResult
+	if req.Mode == schemas.BulkModeBestEffort {
+		results = uc.bulkWorker(uc.repo).runBulk(req.Operations)
+	} else {
+		var errCase *internal_error.InternalError
+		if results, errCase = uc.runBulkTransaction(req.Operations, false); errCase != nil {
+			return nil, errCase
+		}
+	}
+
+	for _, result := range results {
+		if result.Err == nil && !result.RolledBack {
+			uc.notify()
+			break
+		}
+	}
+	return results, nil
+}
+
+// runBulkTransaction runs the operations in one transaction, which is
+// rolled back if any of them fails or, for a dry run, in any case.
+func (uc *OpeningUseCase) runBulkTransaction(operations []schemas.BulkOpeningOperation, dryRun bool) ([]BulkResult, *internal_error.InternalError) {
+	var results []BulkResult
+	err := uc.repo.Transaction(func(repo repositories.OpeningRepository) error {
+		results = uc.bulkWorker(repo).runBulk(operations)
+		for _, result := range results {
+			if result.Err != nil {
+				return errBulkRolledBack
+			}
+		}
+		if dryRun {
+			return errBulkRolledBack
+		}
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, errBulkRolledBack):
+		for i := range results {
+			results[i].RolledBack = results[i].Err == nil
+		}
+	case err != nil:
+		return nil, internal_error.NewInternalServerError("error running bulk operations")
+	}
+	return results, nil
+}
+
+// bulkWorker runs operations against repo and leaves notifying to Bulk, so
+// nothing is announced before the batch is committed.
+func (uc *OpeningUseCase) bulkWorker(repo repositories.OpeningRepository) *OpeningUseCase {
+	return &OpeningUseCase{repo: repo, stages: uc.stages, duplicates: uc.duplicates, links: uc.links}
+}
+
+func (uc *OpeningUseCase) runBulk(operations []schemas.BulkOpeningOperation) []BulkResult {
+	results := make([]BulkResult, len(operations))
+	for i, op := range operations {
+		results[i] = uc.runBulkOperation(op)
+	}
+	return results
+}
+
+func (uc *OpeningUseCase) runBulkOperation(op schemas.BulkOpeningOperation) BulkResult {
+	var precondition *schemas.Precondition
+	if op.Version != 0 {
+		precondition = &schemas.Precondition{Versions: []uint{op.Version}}
+	}
+
+	switch op.Op {
+	case schemas.BulkOpCreate:
+		opening, errCase := uc.Create(schemas.CreateOpeningRequest{
+			Role:     op.Opening.Role,
+			Company:  op.Opening.Company,
+			Location: op.Opening.Location,
+			Remote:   op.Opening.Remote,
+			Link:     op.Opening.Link,
+			Salary:   op.Opening.Salary,
+		})
+		if errCase != nil {
+			return BulkResult{Err: errCase}
+		}
+		return BulkResult{ID: opening.ID}
+	case schemas.BulkOpUpdate:
+		if op.ID == 0 {
+			return BulkResult{Err: errParamIsRequired("id", "uint")}
+		}
+		return BulkResult{ID: op.ID, Err: uc.Update(op.ID, op.Opening, precondition)}
+	case schemas.BulkOpDelete:
+		if op.ID == 0 {
+			return BulkResult{Err: errParamIsRequired("id", "uint")}
+		}
+		return BulkResult{ID: op.ID, Err: uc.DeleteByID(op.ID, precondition)}
+	}
+	return BulkResult{ID: op.ID, Err: internal_error.NewBadRequestError("op must be one of: create, update, delete")}
+}
@@ -12,10 +12,12 @@ func errParamIsRequired(name, typ string) *internal_error.InternalError {
 	return internal_error.NewBadRequestError(message)
 }
 
-func (uc *OpeningUseCase) Create(co schemas.CreateOpeningRequest) *internal_error.InternalError {
-	err := validate(&co)
+// Create stores a new opening. When it is rejected as a duplicate, the
+// opening it duplicates is returned along with the conflict error.
+func (uc *OpeningUseCase) Create(co schemas.CreateOpeningRequest) (*schemas.Opening, *internal_error.InternalError) {
+	err := uc.validate(&co)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	opening := schemas.Opening{
@@ -27,36 +29,15 @@ func (uc *OpeningUseCase) Create(co schemas.CreateOpeningRequest) *internal_erro
 		Salary:   co.Salary,
 	}
 
-	errRepo := uc.repo.Create(opening)
-	if errRepo != nil {
-		return internal_error.NewInternalServerError("error creating opening")
-	}
-
-	return nil
-}
-
-func validate(co *schemas.CreateOpeningRequest) *internal_error.InternalError {
-	requiredFields := map[string]interface{}{
-		"role":     co.Role,
-		"company":  co.Company,
-		"location": co.Location,
-		"link":     co.Link,
+	if duplicate, err := uc.checkDuplicate(&opening); err != nil {
+		return duplicate, err
 	}
 
-	for field, value := range requiredFields {
-		if value == "" {
-			return errParamIsRequired(field, "string")
-		}
-	}
-
-	if co.Remote == nil {
-		return errParamIsRequired("remote", "bool")
-	}
-
-	if co.Salary < 3000 {
-		message := "salary must be at least 3k"
-		return internal_error.NewBadRequestError(message)
+	errRepo := uc.repo.Create(&opening)
+	if errRepo != nil {
+		return nil, internal_error.NewInternalServerError("error creating opening")
 	}
 
-	return nil
+	uc.notify()
+	return &opening, nil
 }
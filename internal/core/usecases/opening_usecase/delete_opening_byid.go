@@ -1,19 +1,25 @@
 package opening_usecase
 
 import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
 	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
 )
 
-func (uc *OpeningUseCase) DeleteByID(id uint) *internal_error.InternalError {
-	_, err := uc.repo.FindByID(id)
+func (uc *OpeningUseCase) DeleteByID(id uint, precondition *schemas.Precondition) *internal_error.InternalError {
+	opening, err := uc.repo.FindByID(id)
 	if err != nil {
 		return internal_error.NewNotFoundError("opening not found")
 	}
 
-	errRepo := uc.repo.Delete(id)
+	if err := checkPrecondition(opening, precondition); err != nil {
+		return err
+	}
+
+	errRepo := uc.repo.Delete(id, opening.Version)
 	if errRepo != nil {
-		return internal_error.NewInternalServerError("error deleting opening")
+		return writeError(errRepo, precondition, "error deleting opening")
 	}
 
+	uc.notify()
 	return nil
 }
@@ -0,0 +1,68 @@
+package opening_usecase
+
+import (
+	"fmt"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/dedup"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// checkDuplicate compares a new opening with the open ones that share its
+// link or the start of its company name. Under DuplicateModeReject a match is a conflict and is
+// returned; under DuplicateModeFlag the opening is only marked with it.
+func (uc *OpeningUseCase) checkDuplicate(opening *schemas.Opening) (*schemas.Opening, *internal_error.InternalError) {
+	if uc.duplicates.Mode != schemas.DuplicateModeFlag && uc.duplicates.Mode != schemas.DuplicateModeReject {
+		return nil, nil
+	}
+
+	candidates, err := uc.repo.FindDuplicateCandidates(dedup.LinkKey(opening.Link), dedup.CompanyBlock(opening.Company))
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error checking for duplicate openings")
+	}
+
+	var duplicate *schemas.Opening
+	var best float64
+	for i := range candidates {
+		score := dedup.Score(*opening, candidates[i])
+		if score >= uc.duplicateThreshold() && score > best {
+			duplicate, best = &candidates[i], score
+		}
+	}
+	if duplicate == nil {
+		return nil, nil
+	}
+
+	if uc.duplicates.Mode == schemas.DuplicateModeReject {
+		message := fmt.Sprintf("opening looks like a duplicate of opening %d", duplicate.ID)
+		return duplicate, internal_error.NewConflictError(message)
+	}
+	opening.DuplicateOf = &duplicate.ID
+	return nil, nil
+}
+
+// DuplicateClusters groups the open openings that look like the same job,
+// for an admin to merge.
+func (uc *OpeningUseCase) DuplicateClusters() ([]schemas.DuplicateCluster, *internal_error.InternalError) {
+	var openings []schemas.Opening
+	errCase := uc.Export(schemas.OpeningFilter{Status: schemas.OpeningStatusOpen}, func(batch []schemas.Opening) error {
+		openings = append(openings, batch...)
+		return nil
+	})
+	if errCase != nil {
+		return nil, errCase
+	}
+
+	clusters := []schemas.DuplicateCluster{}
+	for _, group := range dedup.Clusters(openings, uc.duplicateThreshold()) {
+		clusters = append(clusters, schemas.DuplicateCluster{Openings: group})
+	}
+	return clusters, nil
+}
+
+func (uc *OpeningUseCase) duplicateThreshold() float64 {
+	if uc.duplicates.Threshold > 0 {
+		return uc.duplicates.Threshold
+	}
+	return schemas.DefaultDuplicateThreshold
+}
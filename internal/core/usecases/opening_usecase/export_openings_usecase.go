@@ -0,0 +1,38 @@
+package opening_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// exportBatchSize is how many openings are read from the repository at a
+// time while exporting.
+const exportBatchSize = 500
+
+// Export calls write with every opening matching filter, in ID order and
+// in batches, so the whole result never has to be held in memory. It stops
+// at the first error returned by write.
+func (uc *OpeningUseCase) Export(filter schemas.OpeningFilter, write func(openings []schemas.Opening) error) *internal_error.InternalError {
+	if err := filter.Validate(); err != nil {
+		return internal_error.NewBadRequestError(err.Error())
+	}
+
+	var afterID uint
+	for {
+		openings, err := uc.repo.FindAfter(filter, afterID, exportBatchSize)
+		if err != nil {
+			return internal_error.NewInternalServerError("error finding openings")
+		}
+		if len(openings) == 0 {
+			return nil
+		}
+
+		if err := write(openings); err != nil {
+			return internal_error.NewInternalServerError("error writing openings")
+		}
+		if len(openings) < exportBatchSize {
+			return nil
+		}
+		afterID = openings[len(openings)-1].ID
+	}
+}
@@ -11,5 +11,24 @@ func (uc *OpeningUseCase) GetByID(id uint) (*schemas.Opening, *internal_error.In
 		return nil, internal_error.NewNotFoundError("opening not found")
 	}
 
+	if errCase := uc.countStages(opening); errCase != nil {
+		return nil, errCase
+	}
+
 	return opening, nil
 }
+
+// countStages fills in how many applications of the opening sit in each
+// stage, when the usecase has a stage counter.
+func (uc *OpeningUseCase) countStages(opening *schemas.Opening) *internal_error.InternalError {
+	if uc.stages == nil {
+		return nil
+	}
+
+	counts, err := uc.stages.StageCounts(opening.ID)
+	if err != nil {
+		return internal_error.NewInternalServerError("error counting applications")
+	}
+	opening.StageCounts = counts
+	return nil
+}
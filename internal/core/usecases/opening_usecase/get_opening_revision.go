@@ -0,0 +1,35 @@
+package opening_usecase
+
+import (
+	"encoding/json"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *OpeningUseCase) GetRevision(id, revision uint) (*schemas.OpeningRevisionResponse, *internal_error.InternalError) {
+	rev, err := uc.repo.FindRevision(id, revision)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("revision not found")
+	}
+
+	opening, errCase := decodeSnapshot(rev)
+	if errCase != nil {
+		return nil, errCase
+	}
+
+	return &schemas.OpeningRevisionResponse{
+		OpeningID: rev.OpeningID,
+		Revision:  rev.Revision,
+		CreatedAt: rev.CreatedAt,
+		Opening:   *opening,
+	}, nil
+}
+
+func decodeSnapshot(rev *schemas.OpeningRevision) (*schemas.Opening, *internal_error.InternalError) {
+	var opening schemas.Opening
+	if err := json.Unmarshal([]byte(rev.Snapshot), &opening); err != nil {
+		return nil, internal_error.NewInternalServerError("error reading revision snapshot")
+	}
+	return &opening, nil
+}
@@ -0,0 +1,36 @@
+package opening_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// Import creates the openings in a single transaction: either all of them
+// are stored or, if any fails validation, none is. A dry run validates
+// them the same way and then rolls everything back.
+func (uc *OpeningUseCase) Import(openings []schemas.CreateOpeningRequest, dryRun bool) ([]BulkResult, *internal_error.InternalError) {
+	operations := make([]schemas.BulkOpeningOperation, len(openings))
+	for i, co := range openings {
+		operations[i] = schemas.BulkOpeningOperation{
+			Op: schemas.BulkOpCreate,
+			Opening: schemas.UpdateOpeningRequest{
+				Role:     co.Role,
+				Company:  co.Company,
+				Location: co.Location,
+				Remote:   co.Remote,
+				Link:     co.Link,
+				Salary:   co.Salary,
+			},
+		}
+	}
+
+	results, errCase := uc.runBulkTransaction(operations, dryRun)
+	if errCase != nil {
+		return nil, errCase
+	}
+
+	if len(results) > 0 && results[0].Err == nil && !results[0].RolledBack {
+		uc.notify()
+	}
+	return results, nil
+}
@@ -0,0 +1,20 @@
+package opening_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// Latest returns up to limit openings matching filter, newest first. Unlike
+// ListOpenings, finding none is not an error.
+func (uc *OpeningUseCase) Latest(filter schemas.OpeningFilter, limit int) ([]schemas.Opening, *internal_error.InternalError) {
+	if err := filter.Validate(); err != nil {
+		return nil, internal_error.NewBadRequestError(err.Error())
+	}
+
+	openings, err := uc.repo.FindLatest(filter, limit)
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error finding openings")
+	}
+	return openings, nil
+}
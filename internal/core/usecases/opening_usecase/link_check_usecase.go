@@ -0,0 +1,55 @@
+package opening_usecase
+
+import (
+	"errors"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+// RecordLinkCheck saves the outcome of checking the link of an opening. The
+// link is paused once it has failed maxFailures checks in a row, which
+// takes the opening off the open listings without closing it, and resumed
+// by the next healthy check; 0 never pauses it. A check of a link the
+// opening no longer has is dropped.
+func (uc *OpeningUseCase) RecordLinkCheck(id uint, check schemas.LinkCheck, maxFailures int) (*schemas.Opening, *internal_error.InternalError) {
+	opening, err := uc.repo.FindByID(id)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("opening not found")
+	}
+
+	if opening.Link != check.URL {
+		return opening, nil
+	}
+
+	paused := opening.LinkPaused
+	checkedAt := check.CheckedAt
+	opening.LinkStatus = check.Status
+	opening.LinkRedirect = check.Redirect
+	opening.LinkError = check.Error
+	opening.LinkCheckedAt = &checkedAt
+	switch {
+	case check.Healthy():
+		opening.LinkFailures = 0
+	case !check.Throttled():
+		opening.LinkFailures++
+	}
+	opening.LinkPaused = maxFailures > 0 && opening.LinkFailures >= maxFailures
+
+	err = uc.repo.RecordLinkCheck(opening)
+	if errors.Is(err, repositories.ErrLinkChanged) {
+		if opening, err = uc.repo.FindByID(id); err != nil {
+			return nil, internal_error.NewNotFoundError("opening not found")
+		}
+		return opening, nil
+	}
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error recording link check")
+	}
+
+	if opening.LinkPaused != paused {
+		uc.notify()
+	}
+	return opening, nil
+}
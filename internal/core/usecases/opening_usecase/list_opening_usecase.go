@@ -5,14 +5,18 @@ import (
 	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
 )
 
-func (uc *OpeningUseCase) ListOpenings(page int) ([]schemas.Opening, *internal_error.InternalError) {
+func (uc *OpeningUseCase) ListOpenings(filter schemas.OpeningFilter, page int) ([]schemas.Opening, *internal_error.InternalError) {
+	if err := filter.Validate(); err != nil {
+		return nil, internal_error.NewBadRequestError(err.Error())
+	}
+
 	if page <= 0 {
 		page = 1
 	}
 	limit := 10
 	offset := (page - 1) * limit
 
-	openings, err := uc.repo.FindAll(limit, offset)
+	openings, err := uc.repo.FindAll(filter, limit, offset)
 	if err != nil || len(openings) == 0 {
 		return nil, internal_error.NewNotFoundError("opening record not found")
 	}
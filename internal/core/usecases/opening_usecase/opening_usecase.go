@@ -7,17 +7,83 @@ import (
 )
 
 type OpeningUsecase interface {
-	Create(co schemas.CreateOpeningRequest) *internal_error.InternalError
+	Create(co schemas.CreateOpeningRequest) (*schemas.Opening, *internal_error.InternalError)
 	GetByID(id uint) (*schemas.Opening, *internal_error.InternalError)
-	Update(id uint, upo schemas.UpdateOpeningRequest) *internal_error.InternalError
-	DeleteByID(id uint) *internal_error.InternalError
-	ListOpenings(page int) ([]schemas.Opening, *internal_error.InternalError)
+	Update(id uint, upo schemas.UpdateOpeningRequest, precondition *schemas.Precondition) *internal_error.InternalError
+	Patch(id uint, mediaType string, patch []byte, precondition *schemas.Precondition) (*schemas.Opening, *internal_error.InternalError)
+	DeleteByID(id uint, precondition *schemas.Precondition) *internal_error.InternalError
+	Bulk(req schemas.BulkOpeningRequest) ([]BulkResult, *internal_error.InternalError)
+	Import(openings []schemas.CreateOpeningRequest, dryRun bool) ([]BulkResult, *internal_error.InternalError)
+	ListOpenings(filter schemas.OpeningFilter, page int) ([]schemas.Opening, *internal_error.InternalError)
+	Latest(filter schemas.OpeningFilter, limit int) ([]schemas.Opening, *internal_error.InternalError)
+	Export(filter schemas.OpeningFilter, write func(openings []schemas.Opening) error) *internal_error.InternalError
+	Sync(source string, openings []schemas.Opening) (*schemas.ATSSyncReport, *internal_error.InternalError)
+	DuplicateClusters() ([]schemas.DuplicateCluster, *internal_error.InternalError)
+	RecordLinkCheck(id uint, check schemas.LinkCheck, maxFailures int) (*schemas.Opening, *internal_error.InternalError)
+	GetRevision(id, revision uint) (*schemas.OpeningRevisionResponse, *internal_error.InternalError)
+	RestoreRevision(id, revision uint) *internal_error.InternalError
+}
+
+// StageCounter reports how many applications of an opening are in each
+// pipeline stage.
+type StageCounter interface {
+	StageCounts(openingID uint) (map[string]int64, error)
+}
+
+// Notifier is told that an opening change was committed. Its event is
+// already in the outbox; this only saves the relay from waiting for its
+// next poll.
+type Notifier interface {
+	Notify()
 }
 
 type OpeningUseCase struct {
-	repo repositories.OpeningRepository
+	repo       repositories.OpeningRepository
+	stages     StageCounter
+	notifiers  []Notifier
+	duplicates schemas.DuplicatePolicy
+	links      schemas.LinkPolicy
+}
+
+type Option func(uc *OpeningUseCase)
+
+func WithStageCounter(stages StageCounter) Option {
+	return func(uc *OpeningUseCase) {
+		uc.stages = stages
+	}
+}
+
+func WithNotifier(notifier Notifier) Option {
+	return func(uc *OpeningUseCase) {
+		uc.notifiers = append(uc.notifiers, notifier)
+	}
+}
+
+// WithDuplicatePolicy turns on duplicate detection in Create. Without it
+// openings are created without being compared.
+func WithDuplicatePolicy(policy schemas.DuplicatePolicy) Option {
+	return func(uc *OpeningUseCase) {
+		uc.duplicates = policy
+	}
+}
+
+// WithLinkPolicy rejects openings that link to a denied host.
+func WithLinkPolicy(policy schemas.LinkPolicy) Option {
+	return func(uc *OpeningUseCase) {
+		uc.links = policy
+	}
+}
+
+func NewOpeningUseCase(repo repositories.OpeningRepository, opts ...Option) *OpeningUseCase {
+	uc := &OpeningUseCase{repo: repo}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
 }
 
-func NewOpeningUseCase(repo repositories.OpeningRepository) *OpeningUseCase {
-	return &OpeningUseCase{repo: repo}
+func (uc *OpeningUseCase) notify() {
+	for _, notifier := range uc.notifiers {
+		notifier.Notify()
+	}
 }
@@ -0,0 +1,28 @@
+package opening_usecase
+
+import (
+	"errors"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+func checkPrecondition(opening *schemas.Opening, precondition *schemas.Precondition) *internal_error.InternalError {
+	if !precondition.Allows(opening.Version) {
+		return internal_error.NewPreconditionFailedError("opening version does not match")
+	}
+	return nil
+}
+
+// writeError maps a failed repository write. Losing the version race is a
+// failed precondition when the caller sent one, and a conflict otherwise.
+func writeError(err error, precondition *schemas.Precondition, message string) *internal_error.InternalError {
+	if errors.Is(err, repositories.ErrVersionConflict) {
+		if precondition != nil {
+			return internal_error.NewPreconditionFailedError("opening version does not match")
+		}
+		return internal_error.NewConflictError("opening was changed by another request, reload it and try again")
+	}
+	return internal_error.NewInternalServerError(message)
+}
@@ -0,0 +1,133 @@
+package opening_usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// Patch applies a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902),
+// depending on mediaType, to the document of an opening. Unlike Update, a
+// patch can clear a field; the result must then pass the same validation
+// as a new opening. Like Update, it is guarded by the precondition and by
+// the version the opening was read at.
+func (uc *OpeningUseCase) Patch(id uint, mediaType string, patch []byte, precondition *schemas.Precondition) (*schemas.Opening, *internal_error.InternalError) {
+	opening, errRepo := uc.repo.FindByID(id)
+	if errRepo != nil {
+		return nil, internal_error.NewNotFoundError("opening not found")
+	}
+
+	if errCase := checkPrecondition(opening, precondition); errCase != nil {
+		return nil, errCase
+	}
+
+	original := openingDocument(opening)
+	doc, err := json.Marshal(original)
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error encoding opening")
+	}
+
+	switch mediaType {
+	case schemas.MergePatchMediaType:
+		doc, err = jsonpatch.MergePatch(doc, patch)
+	case schemas.JSONPatchMediaType:
+		var ops jsonpatch.Patch
+		ops, err = jsonpatch.DecodePatch(patch)
+		if err == nil {
+			doc, err = ops.Apply(doc)
+		}
+	default:
+		return nil, internal_error.NewBadRequestError(fmt.Sprintf("unsupported patch media type %q", mediaType))
+	}
+	if err != nil {
+		return nil, internal_error.NewBadRequestError(fmt.Sprintf("invalid patch: %v", err))
+	}
+
+	var patched schemas.OpeningDocument
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&patched); err != nil {
+		return nil, internal_error.NewBadRequestError(strings.TrimPrefix(err.Error(), "json: "))
+	}
+
+	co := schemas.CreateOpeningRequest{
+		Role:     stringValue(patched.Role),
+		Company:  stringValue(patched.Company),
+		Location: stringValue(patched.Location),
+		Remote:   patched.Remote,
+		Link:     stringValue(patched.Link),
+	}
+	if patched.Salary != nil {
+		co.Salary = *patched.Salary
+	}
+	status := stringValue(patched.Status)
+
+	var v validation
+	uc.checkOpening(&v, &co)
+	checkStatus(&v, status)
+	if errCase := v.err(); errCase != nil {
+		return nil, errCase
+	}
+	patched.Link = &co.Link
+
+	if status == "" {
+		status = schemas.OpeningStatusOpen
+	}
+	patched.Status = &status
+
+	// An unchanged opening gets no new revision or event.
+	if reflect.DeepEqual(openingDocument(opening), patched) {
+		if errCase := uc.countStages(opening); errCase != nil {
+			return nil, errCase
+		}
+		return opening, nil
+	}
+
+	opening.Role = co.Role
+	opening.Company = co.Company
+	opening.Location = co.Location
+	opening.Remote = *co.Remote
+	opening.Link = co.Link
+	opening.Salary = co.Salary
+	opening.Status = status
+
+	if err := uc.repo.Update(opening); err != nil {
+		return nil, writeError(err, precondition, "error updating opening")
+	}
+
+	uc.notify()
+	if errCase := uc.countStages(opening); errCase != nil {
+		return nil, errCase
+	}
+	return opening, nil
+}
+
+func openingDocument(o *schemas.Opening) schemas.OpeningDocument {
+	status := o.Status
+	if status == "" {
+		status = schemas.OpeningStatusOpen
+	}
+
+	return schemas.OpeningDocument{
+		Role:     &o.Role,
+		Company:  &o.Company,
+		Location: &o.Location,
+		Remote:   &o.Remote,
+		Link:     &o.Link,
+		Salary:   &o.Salary,
+		Status:   &status,
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
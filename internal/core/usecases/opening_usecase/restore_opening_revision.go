@@ -0,0 +1,34 @@
+package opening_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// RestoreRevision writes the snapshot stored in the given revision back to
+// the opening. The repository records the result as a new revision, so the
+// history is never rewritten.
+func (uc *OpeningUseCase) RestoreRevision(id, revision uint) *internal_error.InternalError {
+	opening, err := uc.repo.FindByID(id)
+	if err != nil {
+		return internal_error.NewNotFoundError("opening not found")
+	}
+
+	rev, err := uc.repo.FindRevision(id, revision)
+	if err != nil {
+		return internal_error.NewNotFoundError("revision not found")
+	}
+
+	restored, errCase := decodeSnapshot(rev)
+	if errCase != nil {
+		return errCase
+	}
+	restored.Model = opening.Model
+	restored.Version = opening.Version
+
+	if err := uc.repo.Update(restored); err != nil {
+		return writeError(err, nil, "error restoring opening")
+	}
+
+	uc.notify()
+	return nil
+}
@@ -0,0 +1,137 @@
+package opening_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+// Sync makes the openings of an ATS source match the jobs it currently
+// lists, in one transaction. Jobs are matched to openings on ExternalID:
+// new ones are created, changed ones updated, and open openings whose job
+// is gone are closed. An opening closed that way is reopened when its job
+// is listed again, but one closed by anybody else stays closed. ATS feeds
+// rarely state a salary, so a salary of 0 is kept as unknown instead of
+// being rejected.
+func (uc *OpeningUseCase) Sync(source string, openings []schemas.Opening) (*schemas.ATSSyncReport, *internal_error.InternalError) {
+	if source == "" {
+		return nil, errParamIsRequired("source", "string")
+	}
+
+	var report schemas.ATSSyncReport
+	err := uc.repo.Transaction(func(repo repositories.OpeningRepository) error {
+		report = schemas.ATSSyncReport{Source: source, Fetched: len(openings)}
+
+		current, err := repo.FindBySource(source)
+		if err != nil {
+			return err
+		}
+		byExternalID := make(map[string]*schemas.Opening, len(current))
+		for i := range current {
+			byExternalID[current[i].ExternalID] = &current[i]
+		}
+
+		seen := make(map[string]bool, len(openings))
+		for _, job := range openings {
+			if job.ExternalID == "" || seen[job.ExternalID] || uc.validateSynced(&job) != nil {
+				report.Skipped++
+				seen[job.ExternalID] = true
+				continue
+			}
+			seen[job.ExternalID] = true
+
+			existing, ok := byExternalID[job.ExternalID]
+			switch {
+			case !ok:
+				opening := syncedFields(schemas.Opening{Source: source, ExternalID: job.ExternalID}, job)
+				if err := repo.Create(&opening); err != nil {
+					return err
+				}
+				report.Created++
+			case existing.DeletedAt.Valid:
+				report.Skipped++
+			case sameListing(*existing, job):
+				report.Unchanged++
+			default:
+				opening := syncedFields(*existing, job)
+				if err := repo.Update(&opening); err != nil {
+					return err
+				}
+				report.Updated++
+			}
+		}
+
+		for _, opening := range current {
+			if seen[opening.ExternalID] || opening.DeletedAt.Valid || !opening.HasOpenStatus() {
+				continue
+			}
+			opening.Status = schemas.OpeningStatusClosed
+			opening.SyncClosedVersion = opening.Version + 1
+			if err := repo.Update(&opening); err != nil {
+				return err
+			}
+			report.Closed++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, writeError(err, nil, "error syncing openings")
+	}
+
+	if report.Created+report.Updated+report.Closed > 0 {
+		uc.notify()
+	}
+	return &report, nil
+}
+
+// syncedFields returns opening with the fields an ATS feed owns taken from
+// job, reopening it if a sync closed it.
+func syncedFields(opening, job schemas.Opening) schemas.Opening {
+	opening.Role = job.Role
+	opening.Company = job.Company
+	opening.Location = job.Location
+	opening.Remote = job.Remote
+	opening.Link = job.Link
+	opening.Salary = job.Salary
+	if opening.ClosedBySync() || opening.Status == "" {
+		opening.Status = schemas.OpeningStatusOpen
+	}
+	return opening
+}
+
+func sameListing(opening, job schemas.Opening) bool {
+	return !opening.ClosedBySync() &&
+		opening.Role == job.Role &&
+		opening.Company == job.Company &&
+		opening.Location == job.Location &&
+		opening.Remote == job.Remote &&
+		opening.Link == job.Link &&
+		opening.Salary == job.Salary
+}
+
+// validateSynced checks a job the way Create does, normalizing its link,
+// except that a salary of 0 stands for an unknown one.
+func (uc *OpeningUseCase) validateSynced(job *schemas.Opening) *internal_error.InternalError {
+	var v validation
+	requiredFields := []struct{ name, value string }{
+		{"role", job.Role},
+		{"company", job.Company},
+		{"location", job.Location},
+	}
+	for _, field := range requiredFields {
+		if field.value == "" {
+			v.required(field.name, "string")
+		}
+	}
+
+	if job.Link == "" {
+		v.required("link", "string")
+	} else {
+		uc.checkLink(&v, &job.Link)
+	}
+
+	if job.Salary != 0 {
+		checkSalary(&v, job.Salary)
+	}
+	return v.err()
+}
@@ -5,16 +5,24 @@ import (
 
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
 	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
-	"gorm.io/gorm"
 )
 
-func (uc *OpeningUseCase) Update(id uint, upo schemas.UpdateOpeningRequest) *internal_error.InternalError {
+// Update changes the given fields of an opening. The write fails if the
+// opening is not at a version allowed by the precondition, or if it changes
+// between being read and saved.
+func (uc *OpeningUseCase) Update(id uint, upo schemas.UpdateOpeningRequest, precondition *schemas.Precondition) *internal_error.InternalError {
 	err := validateUpdateOpeningRequest(&upo)
 	if err != nil {
 		return err
 	}
 
-	if err := validateSalary(upo.Salary); err != nil {
+	var v validation
+	checkSalary(&v, upo.Salary)
+	checkStatus(&v, upo.Status)
+	if upo.Link != "" {
+		uc.checkLink(&v, &upo.Link)
+	}
+	if err := v.err(); err != nil {
 		return err
 	}
 
@@ -23,20 +31,31 @@ func (uc *OpeningUseCase) Update(id uint, upo schemas.UpdateOpeningRequest) *int
 		return internal_error.NewNotFoundError("opening not found")
 	}
 
+	if err := checkPrecondition(opening, precondition); err != nil {
+		return err
+	}
+
 	upOpening := schemas.Opening{
-		Model:    gorm.Model{ID: id},
-		Role:     getFieldValue(upo.Role, opening.Role),
-		Company:  getFieldValue(upo.Company, opening.Company),
-		Location: getFieldValue(upo.Location, opening.Location),
-		Link:     getFieldValue(upo.Link, opening.Link),
-		Remote:   getRemoteValue(upo.Remote, opening.Remote),
-		Salary:   upo.Salary,
+		Model:       opening.Model,
+		Role:        getFieldValue(upo.Role, opening.Role),
+		Company:     getFieldValue(upo.Company, opening.Company),
+		Location:    getFieldValue(upo.Location, opening.Location),
+		Link:        getFieldValue(upo.Link, opening.Link),
+		Remote:      getRemoteValue(upo.Remote, opening.Remote),
+		Salary:      upo.Salary,
+		Status:      getFieldValue(upo.Status, opening.Status),
+		Source:      opening.Source,
+		ExternalID:  opening.ExternalID,
+		DuplicateOf: opening.DuplicateOf,
+		Version:     opening.Version,
 	}
 
-	errRepo = uc.repo.Update(upOpening)
+	errRepo = uc.repo.Update(&upOpening)
 	if errRepo != nil {
-		return internal_error.NewInternalServerError("error updating opening")
+		return writeError(errRepo, precondition, "error updating opening")
 	}
+
+	uc.notify()
 	return nil
 }
 
@@ -73,10 +92,3 @@ func getRemoteValue(updated *bool, original bool) bool {
 	}
 	return original
 }
-
-func validateSalary(salary int64) *internal_error.InternalError {
-	if salary < 3000 {
-		return internal_error.NewBadRequestError("salary must be at least 3k")
-	}
-	return nil
-}
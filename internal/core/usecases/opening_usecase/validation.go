@@ -0,0 +1,101 @@
+package opening_usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/links"
+)
+
+var errDeniedHost = errors.New("link points to a host that is not allowed")
+
+// validation collects every field of a request that fails, so that they
+// are all reported in one error.
+type validation struct {
+	causes []internal_error.Cause
+}
+
+func (v *validation) add(field, message string) {
+	v.causes = append(v.causes, internal_error.Cause{Field: field, Message: message})
+}
+
+func (v *validation) required(field, typ string) {
+	v.add(field, fmt.Sprintf("param: %s (type: %s) is required", field, typ))
+}
+
+// err returns nil when no field failed, and otherwise a bad request error
+// with one cause per failure, whose messages make up its own.
+func (v *validation) err() *internal_error.InternalError {
+	if len(v.causes) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(v.causes))
+	for i, cause := range v.causes {
+		messages[i] = cause.Message
+	}
+	return internal_error.NewBadRequestError(strings.Join(messages, "; "), v.causes...)
+}
+
+// validate checks every field of co, normalizing its link.
+func (uc *OpeningUseCase) validate(co *schemas.CreateOpeningRequest) *internal_error.InternalError {
+	var v validation
+	uc.checkOpening(&v, co)
+	return v.err()
+}
+
+func (uc *OpeningUseCase) checkOpening(v *validation, co *schemas.CreateOpeningRequest) {
+	requiredFields := []struct{ name, value string }{
+		{"role", co.Role},
+		{"company", co.Company},
+		{"location", co.Location},
+	}
+	for _, field := range requiredFields {
+		if field.value == "" {
+			v.required(field.name, "string")
+		}
+	}
+
+	if co.Link == "" {
+		v.required("link", "string")
+	} else {
+		uc.checkLink(v, &co.Link)
+	}
+
+	if co.Remote == nil {
+		v.required("remote", "bool")
+	}
+
+	checkSalary(v, co.Salary)
+}
+
+// checkLink replaces *link with its normalized form, or records why it is
+// rejected: it is not an absolute http(s) URL or its host is denied.
+func (uc *OpeningUseCase) checkLink(v *validation, link *string) {
+	normalized, err := links.Normalize(*link)
+	if err == nil && links.Denied(normalized, uc.links.DeniedHosts) {
+		err = errDeniedHost
+	}
+	if err != nil {
+		v.add("link", err.Error())
+		return
+	}
+	*link = normalized
+}
+
+func checkSalary(v *validation, salary int64) {
+	if salary < 3000 {
+		v.add("salary", "salary must be at least 3k")
+	}
+}
+
+func checkStatus(v *validation, status string) {
+	switch status {
+	case "", schemas.OpeningStatusOpen, schemas.OpeningStatusClosed:
+		return
+	}
+	v.add("status", "status must be one of: open, closed")
+}
@@ -0,0 +1,151 @@
+package outbox_usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+// relayBatchSize caps how many events a single RunDue publishes.
+const relayBatchSize = 100
+
+// Relay publishes the outbox events to every publisher, oldest first. An
+// event is only marked published once all of them accepted it, and a
+// failure holds back the events after it, so delivery is at-least-once and
+// in order. An event still failing after MaxAttempts is dead-lettered so
+// that the events after it can go out. While an event is being retried, the publishers that already
+// took it are skipped; after a restart they get it again and rely on the
+// event ID to drop it.
+type Relay struct {
+	repo       repositories.OutboxRepository
+	policy     schemas.OutboxPolicy
+	publishers []events.Publisher
+	wake       chan struct{}
+	logger     *config.Logger
+
+	retryingID string
+	delivered  map[int]bool
+}
+
+func NewRelay(repo repositories.OutboxRepository, policy schemas.OutboxPolicy, publishers ...events.Publisher) *Relay {
+	return &Relay{
+		repo:       repo,
+		policy:     policy,
+		publishers: publishers,
+		wake:       make(chan struct{}, 1),
+		logger:     config.GetLogger("outbox"),
+	}
+}
+
+// Notify wakes the relay up without waiting for its next poll.
+func (r *Relay) Notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run relays pending events every PollInterval, or when notified, until ctx
+// is done.
+func (r *Relay) Run(ctx context.Context) {
+	interval := r.policy.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.RunDue(time.Now()); err != nil {
+			r.logger.Errorf("outbox relay error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// RunDue publishes the pending events until one fails or is still backing
+// off, then drops the events published longer than Retention ago. A
+// dead-lettered event does not stop the run.
+func (r *Relay) RunDue(now time.Time) error {
+	pending, err := r.repo.FindPending(relayBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range pending {
+		if event.NextAttemptAt.After(now) {
+			break
+		}
+		if err := r.relay(event, now); err != nil {
+			return err
+		}
+	}
+
+	if r.policy.Retention > 0 {
+		return r.repo.DeletePublishedBefore(now.Add(-r.policy.Retention))
+	}
+	return nil
+}
+
+func (r *Relay) relay(event schemas.OutboxEvent, now time.Time) error {
+	if r.retryingID != event.EventID {
+		r.retryingID = event.EventID
+		r.delivered = make(map[int]bool)
+	}
+
+	errPublish := r.publish(event)
+	if errPublish != nil {
+		event.Attempts++
+		event.LastError = errPublish.Error()
+		event.NextAttemptAt = now.Add(r.policy.Backoff(event.Attempts)).UTC()
+		dead := r.policy.MaxAttempts > 0 && event.Attempts >= r.policy.MaxAttempts
+		if dead {
+			deadAt := now.UTC()
+			event.DeadAt = &deadAt
+		}
+		if err := r.repo.Update(event); err != nil {
+			return err
+		}
+		if dead {
+			r.logger.Errorf("outbox event %s dead-lettered after %d attempts: %v", event.EventID, event.Attempts, errPublish)
+			return nil
+		}
+		return fmt.Errorf("outbox event %s: %w", event.EventID, errPublish)
+	}
+
+	publishedAt := now.UTC()
+	event.PublishedAt = &publishedAt
+	event.LastError = ""
+	return r.repo.Update(event)
+}
+
+func (r *Relay) publish(event schemas.OutboxEvent) error {
+	var openingEvent schemas.OpeningEvent
+	if err := json.Unmarshal([]byte(event.Payload), &openingEvent); err != nil {
+		return err
+	}
+
+	for i, publisher := range r.publishers {
+		if r.delivered[i] {
+			continue
+		}
+		if err := publisher.Publish(openingEvent); err != nil {
+			return err
+		}
+		r.delivered[i] = true
+	}
+	return nil
+}
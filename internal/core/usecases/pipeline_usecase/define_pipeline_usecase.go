@@ -0,0 +1,80 @@
+package pipeline_usecase
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+var stageNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+func (uc *PipelineUseCase) DefineForOpening(openingID uint, dp schemas.DefinePipelineRequest) *internal_error.InternalError {
+	if _, err := uc.openingRepo.FindByID(openingID); err != nil {
+		return internal_error.NewNotFoundError("opening not found")
+	}
+
+	return uc.define(schemas.Pipeline{OpeningID: openingID}, dp)
+}
+
+func (uc *PipelineUseCase) DefineForCompany(company string, dp schemas.DefinePipelineRequest) *internal_error.InternalError {
+	company = strings.TrimSpace(company)
+	if company == "" {
+		return internal_error.NewBadRequestError("param: company (type: string) is required")
+	}
+
+	return uc.define(schemas.Pipeline{Company: company}, dp)
+}
+
+func (uc *PipelineUseCase) define(pipeline schemas.Pipeline, dp schemas.DefinePipelineRequest) *internal_error.InternalError {
+	if err := validate(&dp); err != nil {
+		return err
+	}
+
+	inUse, err := uc.applicationRepo.FindStagesInUse(pipeline.OpeningID, pipeline.Company)
+	if err != nil {
+		return internal_error.NewInternalServerError("error saving pipeline")
+	}
+
+	pipeline.Stages = strings.Join(dp.Stages, ",")
+	for _, stage := range inUse {
+		if !pipeline.HasStage(stage) {
+			message := fmt.Sprintf("stage %s is in use by applications and cannot be removed", stage)
+			return internal_error.NewBadRequestError(message)
+		}
+	}
+
+	if err := uc.repo.Save(pipeline); err != nil {
+		return internal_error.NewInternalServerError("error saving pipeline")
+	}
+
+	return nil
+}
+
+func validate(dp *schemas.DefinePipelineRequest) *internal_error.InternalError {
+	if len(dp.Stages) < 2 {
+		return internal_error.NewBadRequestError("a pipeline must have at least 2 stages")
+	}
+
+	seen := make(map[string]bool, len(dp.Stages))
+	for _, stage := range dp.Stages {
+		if !stageNamePattern.MatchString(stage) {
+			message := fmt.Sprintf("invalid stage name: %q", stage)
+			return internal_error.NewBadRequestError(message)
+		}
+
+		if seen[stage] {
+			message := fmt.Sprintf("duplicated stage: %s", stage)
+			return internal_error.NewBadRequestError(message)
+		}
+		seen[stage] = true
+	}
+
+	if schemas.IsTerminalStage(dp.Stages[0]) {
+		return internal_error.NewBadRequestError("a pipeline cannot start in a terminal stage")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package pipeline_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *PipelineUseCase) GetForOpening(openingID uint) (*schemas.PipelineResponse, *internal_error.InternalError) {
+	opening, err := uc.openingRepo.FindByID(openingID)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("opening not found")
+	}
+
+	pipeline, err := ResolvePipeline(uc.repo, opening)
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error reading pipeline")
+	}
+
+	return pipeline, nil
+}
+
+// StageCounts reports how many applications of the opening are in each
+// stage of its pipeline, including empty stages.
+func (uc *PipelineUseCase) StageCounts(openingID uint) (map[string]int64, error) {
+	opening, err := uc.openingRepo.FindByID(openingID)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := ResolvePipeline(uc.repo, opening)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.applicationRepo.CountByStage(openingID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stage := range pipeline.Stages {
+		if _, ok := counts[stage]; !ok {
+			counts[stage] = 0
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,28 @@
+package pipeline_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+type PipelineUsecase interface {
+	GetForOpening(openingID uint) (*schemas.PipelineResponse, *internal_error.InternalError)
+	DefineForOpening(openingID uint, dp schemas.DefinePipelineRequest) *internal_error.InternalError
+	DefineForCompany(company string, dp schemas.DefinePipelineRequest) *internal_error.InternalError
+	StageCounts(openingID uint) (map[string]int64, error)
+}
+
+type PipelineUseCase struct {
+	repo            repositories.PipelineRepository
+	openingRepo     repositories.OpeningRepository
+	applicationRepo repositories.ApplicationRepository
+}
+
+func NewPipelineUseCase(repo repositories.PipelineRepository, openingRepo repositories.OpeningRepository, applicationRepo repositories.ApplicationRepository) *PipelineUseCase {
+	return &PipelineUseCase{
+		repo:            repo,
+		openingRepo:     openingRepo,
+		applicationRepo: applicationRepo,
+	}
+}
@@ -0,0 +1,35 @@
+package pipeline_usecase
+
+import (
+	"errors"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// ResolvePipeline returns the pipeline applications to the opening move
+// through: the opening's own pipeline, else its company's, else the default.
+func ResolvePipeline(repo repositories.PipelineRepository, opening *schemas.Opening) (*schemas.PipelineResponse, error) {
+	pipeline, err := repo.FindByOpeningID(opening.ID)
+	if err == nil {
+		return &schemas.PipelineResponse{OpeningID: opening.ID, Source: schemas.PipelineSourceOpening, Stages: pipeline.StageList()}, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	pipeline, err = repo.FindByCompany(opening.Company)
+	if err == nil {
+		return &schemas.PipelineResponse{OpeningID: opening.ID, Company: opening.Company, Source: schemas.PipelineSourceCompany, Stages: pipeline.StageList()}, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return &schemas.PipelineResponse{
+		OpeningID: opening.ID,
+		Source:    schemas.PipelineSourceDefault,
+		Stages:    append([]string(nil), schemas.DefaultPipelineStages...),
+	}, nil
+}
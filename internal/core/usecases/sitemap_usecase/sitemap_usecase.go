@@ -0,0 +1,255 @@
+package sitemap_usecase
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+const (
+	// MaxURLs is the most URLs the sitemaps protocol allows in one file.
+	MaxURLs = 50000
+	// maxAge bounds how stale the cache gets when openings are changed by
+	// another instance, whose writes this one is not notified of.
+	maxAge = time.Hour
+	// readBatchSize is how many openings are read at a time while building.
+	readBatchSize = 1000
+
+	sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+// File is a generated sitemap document.
+type File struct {
+	Body         []byte
+	ETag         string
+	LastModified time.Time
+}
+
+type SitemapUsecase interface {
+	// Index returns the sitemap index, which lists every page.
+	Index() (*File, *internal_error.InternalError)
+	// Page returns the numbered page, starting at 1.
+	Page(n int) (*File, *internal_error.InternalError)
+	Robots() []byte
+}
+
+// SitemapUseCase builds the sitemaps of the openings and keeps them until
+// an opening changes. It is an opening_usecase.Notifier, so wiring it to
+// the opening usecase is what invalidates the cache.
+type SitemapUseCase struct {
+	repo     repositories.OpeningRepository
+	baseURL  string
+	origin   string
+	pageSize int
+	now      func() time.Time
+
+	mu      sync.Mutex
+	stale   bool
+	builtAt time.Time
+	index   *File
+	pages   []*File
+}
+
+// NewSitemapUseCase returns a SitemapUseCase listing the openings under
+// baseURL, the API base URL, in pages of up to pageSize URLs. The sitemaps
+// themselves are served from the root of its host.
+func NewSitemapUseCase(repo repositories.OpeningRepository, baseURL string, pageSize int) *SitemapUseCase {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	origin := baseURL
+	if u, err := url.Parse(baseURL); err == nil {
+		origin = u.Scheme + "://" + u.Host
+	}
+	if pageSize <= 0 || pageSize > MaxURLs {
+		pageSize = MaxURLs
+	}
+
+	return &SitemapUseCase{
+		repo:     repo,
+		baseURL:  baseURL,
+		origin:   origin,
+		pageSize: pageSize,
+		now:      time.Now,
+		stale:    true,
+	}
+}
+
+// Notify marks the sitemaps stale; the next request rebuilds them.
+func (uc *SitemapUseCase) Notify() {
+	uc.mu.Lock()
+	uc.stale = true
+	uc.mu.Unlock()
+}
+
+func (uc *SitemapUseCase) Index() (*File, *internal_error.InternalError) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if errCase := uc.refresh(); errCase != nil {
+		return nil, errCase
+	}
+	return uc.index, nil
+}
+
+func (uc *SitemapUseCase) Page(n int) (*File, *internal_error.InternalError) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if errCase := uc.refresh(); errCase != nil {
+		return nil, errCase
+	}
+	if n < 1 || n > len(uc.pages) {
+		return nil, internal_error.NewNotFoundError("sitemap not found")
+	}
+	return uc.pages[n-1], nil
+}
+
+// Robots returns a robots.txt that points crawlers to the sitemap index
+// and keeps them away from candidate data.
+func (uc *SitemapUseCase) Robots() []byte {
+	apiPath := strings.TrimPrefix(uc.baseURL, uc.origin)
+
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	for _, private := range []string{"/applications/", "/candidates/", "/files/"} {
+		fmt.Fprintf(&b, "Disallow: %s%s\n", apiPath, private)
+	}
+	fmt.Fprintf(&b, "\nSitemap: %s/sitemap.xml\n", uc.origin)
+	return []byte(b.String())
+}
+
+// PageURL is where page n is served.
+func (uc *SitemapUseCase) PageURL(n int) string {
+	return fmt.Sprintf("%s/sitemaps/openings-%d.xml", uc.origin, n)
+}
+
+func (uc *SitemapUseCase) refresh() *internal_error.InternalError {
+	if !uc.stale && uc.now().Sub(uc.builtAt) < maxAge {
+		return nil
+	}
+
+	builtAt := uc.now()
+	pages, errCase := uc.buildPages()
+	if errCase != nil {
+		return errCase
+	}
+	index, err := uc.buildIndex(pages)
+	if err != nil {
+		return internal_error.NewInternalServerError("error building sitemap")
+	}
+
+	uc.pages, uc.index = pages, index
+	uc.stale, uc.builtAt = false, builtAt
+	return nil
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Sitemaps []sitemapURL `xml:"sitemap"`
+}
+
+// buildPages walks every opening in ID order and cuts the URLs into pages.
+// There is always at least one page, so the index is never empty.
+func (uc *SitemapUseCase) buildPages() ([]*File, *internal_error.InternalError) {
+	var pages []*File
+	page := urlSet{Xmlns: sitemapNamespace}
+	var lastModified time.Time
+
+	flush := func() error {
+		file, err := newFile(page, lastModified)
+		if err != nil {
+			return err
+		}
+		pages = append(pages, file)
+		page.URLs, lastModified = nil, time.Time{}
+		return nil
+	}
+
+	var afterID uint
+	for {
+		openings, err := uc.repo.FindAfter(schemas.OpeningFilter{}, afterID, readBatchSize)
+		if err != nil {
+			return nil, internal_error.NewInternalServerError("error finding openings")
+		}
+
+		for _, o := range openings {
+			page.URLs = append(page.URLs, sitemapURL{
+				Loc:     fmt.Sprintf("%s/openings/%d", uc.baseURL, o.ID),
+				LastMod: o.UpdatedAt.UTC().Format(time.RFC3339),
+			})
+			if o.UpdatedAt.After(lastModified) {
+				lastModified = o.UpdatedAt
+			}
+			if len(page.URLs) == uc.pageSize {
+				if err := flush(); err != nil {
+					return nil, internal_error.NewInternalServerError("error building sitemap")
+				}
+			}
+		}
+
+		if len(openings) < readBatchSize {
+			break
+		}
+		afterID = openings[len(openings)-1].ID
+	}
+
+	if len(page.URLs) > 0 || len(pages) == 0 {
+		if err := flush(); err != nil {
+			return nil, internal_error.NewInternalServerError("error building sitemap")
+		}
+	}
+	return pages, nil
+}
+
+func (uc *SitemapUseCase) buildIndex(pages []*File) (*File, error) {
+	index := sitemapIndex{Xmlns: sitemapNamespace}
+	var lastModified time.Time
+	for i, page := range pages {
+		entry := sitemapURL{Loc: uc.PageURL(i + 1)}
+		if !page.LastModified.IsZero() {
+			entry.LastMod = page.LastModified.UTC().Format(time.RFC3339)
+		}
+		index.Sitemaps = append(index.Sitemaps, entry)
+		if page.LastModified.After(lastModified) {
+			lastModified = page.LastModified
+		}
+	}
+	return newFile(index, lastModified)
+}
+
+func newFile(doc interface{}, lastModified time.Time) (*File, error) {
+	var body bytes.Buffer
+	body.WriteString(xml.Header)
+	if err := xml.NewEncoder(&body).Encode(doc); err != nil {
+		return nil, err
+	}
+	body.WriteString("\n")
+
+	sum := sha256.Sum256(body.Bytes())
+	return &File{
+		Body:         body.Bytes(),
+		ETag:         `"` + hex.EncodeToString(sum[:16]) + `"`,
+		LastModified: lastModified,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package webhook_usecase
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func errParamIsRequired(name, typ string) *internal_error.InternalError {
+	message := fmt.Sprintf("param: %s (type: %s) is required", name, typ)
+	return internal_error.NewBadRequestError(message)
+}
+
+func (uc *WebhookUseCase) Create(cw schemas.CreateWebhookRequest) (*schemas.Webhook, *internal_error.InternalError) {
+	err := validate(&cw)
+	if err != nil {
+		return nil, err
+	}
+
+	events := schemas.WebhookAllEvents
+	if len(cw.Events) > 0 {
+		events = strings.Join(cw.Events, ",")
+	}
+
+	webhook := schemas.Webhook{
+		URL:    strings.TrimSpace(cw.URL),
+		Secret: cw.Secret,
+		Events: events,
+		Active: true,
+	}
+
+	if errRepo := uc.repo.Create(&webhook); errRepo != nil {
+		return nil, internal_error.NewInternalServerError("error creating webhook")
+	}
+
+	return &webhook, nil
+}
+
+func validate(cw *schemas.CreateWebhookRequest) *internal_error.InternalError {
+	if strings.TrimSpace(cw.URL) == "" {
+		return errParamIsRequired("url", "string")
+	}
+
+	u, err := url.Parse(strings.TrimSpace(cw.URL))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return internal_error.NewBadRequestError("url must be an absolute http or https URL")
+	}
+
+	if cw.Secret == "" {
+		return errParamIsRequired("secret", "string")
+	}
+
+	for _, event := range cw.Events {
+		if !isKnownEvent(event) {
+			return internal_error.NewBadRequestError(fmt.Sprintf(
+				"unknown event %q, must be one of: %s or %s",
+				event, strings.Join(schemas.OpeningEventTypes, ", "), schemas.WebhookAllEvents,
+			))
+		}
+	}
+
+	return nil
+}
+
+func isKnownEvent(event string) bool {
+	if event == schemas.WebhookAllEvents {
+		return true
+	}
+	for _, known := range schemas.OpeningEventTypes {
+		if event == known {
+			return true
+		}
+	}
+	return false
+}
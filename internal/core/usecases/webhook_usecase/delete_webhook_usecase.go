@@ -0,0 +1,15 @@
+package webhook_usecase
+
+import "github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+
+func (uc *WebhookUseCase) Delete(id uint) *internal_error.InternalError {
+	if _, err := uc.repo.FindByID(id); err != nil {
+		return internal_error.NewNotFoundError("webhook not found")
+	}
+
+	if err := uc.repo.Delete(id); err != nil {
+		return internal_error.NewInternalServerError("error deleting webhook")
+	}
+
+	return nil
+}
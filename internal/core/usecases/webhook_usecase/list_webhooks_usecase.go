@@ -0,0 +1,14 @@
+package webhook_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *WebhookUseCase) List() ([]schemas.Webhook, *internal_error.InternalError) {
+	webhooks, err := uc.repo.FindAll()
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error listing webhooks")
+	}
+	return webhooks, nil
+}
@@ -0,0 +1,43 @@
+package webhook_usecase
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func (uc *WebhookUseCase) Deliveries(webhookID uint) ([]schemas.WebhookDelivery, *internal_error.InternalError) {
+	if _, err := uc.repo.FindByID(webhookID); err != nil {
+		return nil, internal_error.NewNotFoundError("webhook not found")
+	}
+
+	deliveries, err := uc.repo.FindDeliveries(webhookID, deliveriesLimit)
+	if err != nil {
+		return nil, internal_error.NewInternalServerError("error listing webhook deliveries")
+	}
+	return deliveries, nil
+}
+
+// Redeliver queues the delivery again with a fresh set of attempts. The
+// attempts already made stay in its log.
+func (uc *WebhookUseCase) Redeliver(webhookID, deliveryID uint) (*schemas.WebhookDelivery, *internal_error.InternalError) {
+	if _, err := uc.repo.FindByID(webhookID); err != nil {
+		return nil, internal_error.NewNotFoundError("webhook not found")
+	}
+
+	delivery, err := uc.repo.FindDelivery(webhookID, deliveryID)
+	if err != nil {
+		return nil, internal_error.NewNotFoundError("webhook delivery not found")
+	}
+
+	now := uc.now().UTC()
+	delivery.Status = schemas.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+	delivery.LastError = ""
+
+	if err := uc.repo.UpdateDelivery(*delivery); err != nil {
+		return nil, internal_error.NewInternalServerError("error redelivering webhook")
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,222 @@
+package webhook_usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/config"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/internal/safehttp"
+)
+
+const (
+	// dueBatchSize caps how many deliveries a single RunDue sends.
+	dueBatchSize = 100
+	// responseBodyLimit is how much of the receiver's answer is read, so
+	// that the connection can be reused.
+	responseBodyLimit = 1024
+)
+
+// Dispatcher turns opening events into webhook deliveries and sends them.
+// Deliveries are stored before anything is sent, so pending ones survive a
+// restart, and every attempt is recorded with the receiver's status. Only
+// public addresses are called and redirects are not followed, since anyone
+// can register a webhook.
+type Dispatcher struct {
+	repo   repositories.WebhookRepository
+	policy schemas.WebhookPolicy
+	client *http.Client
+	now    func() time.Time
+	wake   chan struct{}
+	logger *config.Logger
+}
+
+func NewDispatcher(repo repositories.WebhookRepository, policy schemas.WebhookPolicy) *Dispatcher {
+	return &Dispatcher{
+		repo:   repo,
+		policy: policy,
+		client: safehttp.NewClient(safehttp.Options{
+			Timeout:      policy.Timeout,
+			AllowPrivate: policy.AllowPrivateHosts,
+		}),
+		now:    time.Now,
+		wake:   make(chan struct{}, 1),
+		logger: config.GetLogger("webhooks"),
+	}
+}
+
+// Publish queues the event for every active webhook subscribed to it. An
+// event that was already queued is not queued again.
+func (d *Dispatcher) Publish(event schemas.OpeningEvent) error {
+	if err := d.enqueue(event); err != nil {
+		return err
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (d *Dispatcher) enqueue(event schemas.OpeningEvent) error {
+	webhooks, err := d.repo.FindActive()
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := d.now().UTC()
+	var deliveries []schemas.WebhookDelivery
+	for _, webhook := range webhooks {
+		if !webhook.Accepts(event.Type) {
+			continue
+		}
+		deliveries = append(deliveries, schemas.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventID:       event.ID,
+			EventType:     event.Type,
+			Payload:       string(payload),
+			Status:        schemas.WebhookDeliveryPending,
+			NextAttemptAt: &now,
+		})
+	}
+
+	return d.repo.CreateDeliveries(deliveries)
+}
+
+// Run sends due deliveries every PollInterval, or as soon as an event is
+// published, until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	interval := d.policy.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.RunDue(d.now()); err != nil {
+			d.logger.Errorf("webhook dispatcher error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// RunDue attempts every delivery scheduled at or before now. Failed
+// attempts are not errors; only storage failures are returned.
+func (d *Dispatcher) RunDue(now time.Time) error {
+	deliveries, err := d.repo.FindDueDeliveries(now, dueBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		if err := d.attempt(delivery); err != nil {
+			errs = append(errs, fmt.Errorf("webhook delivery %d: %w", delivery.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) attempt(delivery schemas.WebhookDelivery) error {
+	webhook, err := d.repo.FindByID(delivery.WebhookID)
+	if err != nil {
+		delivery.Status = schemas.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = "webhook was deleted"
+		return d.repo.UpdateDelivery(delivery)
+	}
+
+	started := d.now()
+	attempt := d.send(webhook, &delivery)
+	attempt.Duration = d.now().Sub(started)
+
+	delivery.Attempts++
+	if attempt.Error == "" {
+		deliveredAt := d.now().UTC()
+		delivery.Status = schemas.WebhookDeliverySucceeded
+		delivery.NextAttemptAt = nil
+		delivery.LastError = ""
+		delivery.DeliveredAt = &deliveredAt
+		return d.repo.RecordAttempt(delivery, attempt)
+	}
+
+	delivery.LastError = attempt.Error
+	if delivery.Attempts >= d.policy.MaxAttempts {
+		delivery.Status = schemas.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+	} else {
+		next := d.now().Add(d.policy.Backoff(delivery.Attempts)).UTC()
+		delivery.NextAttemptAt = &next
+	}
+	return d.repo.RecordAttempt(delivery, attempt)
+}
+
+func (d *Dispatcher) send(webhook *schemas.Webhook, delivery *schemas.WebhookDelivery) schemas.WebhookAttempt {
+	var attempt schemas.WebhookAttempt
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-opportunities-webhooks")
+	req.Header.Set("X-Webhook-Event", delivery.EventType)
+	req.Header.Set("X-Webhook-ID", delivery.EventID)
+	req.Header.Set("X-Webhook-Delivery", strconv.FormatUint(uint64(delivery.ID), 10))
+	req.Header.Set("X-Webhook-Timestamp", timestamp)
+	req.Header.Set("X-Webhook-Signature", Sign(webhook.Secret, timestamp, []byte(delivery.Payload)))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, responseBodyLimit))
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		attempt.Error = "unexpected status " + resp.Status
+	}
+	return attempt
+}
+
+// Sign returns the X-Webhook-Signature of a payload: the hex HMAC-SHA256,
+// keyed by the webhook secret, of the timestamp, a dot and the body.
+// Covering the timestamp lets receivers reject replayed requests.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
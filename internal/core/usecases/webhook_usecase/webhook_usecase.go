@@ -0,0 +1,29 @@
+package webhook_usecase
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+// deliveriesLimit caps how many of the latest deliveries are listed.
+const deliveriesLimit = 50
+
+type WebhookUsecase interface {
+	Create(cw schemas.CreateWebhookRequest) (*schemas.Webhook, *internal_error.InternalError)
+	List() ([]schemas.Webhook, *internal_error.InternalError)
+	Delete(id uint) *internal_error.InternalError
+	Deliveries(webhookID uint) ([]schemas.WebhookDelivery, *internal_error.InternalError)
+	Redeliver(webhookID, deliveryID uint) (*schemas.WebhookDelivery, *internal_error.InternalError)
+}
+
+type WebhookUseCase struct {
+	repo repositories.WebhookRepository
+	now  func() time.Time
+}
+
+func NewWebhookUseCase(repo repositories.WebhookRepository) *WebhookUseCase {
+	return &WebhookUseCase{repo: repo, now: time.Now}
+}
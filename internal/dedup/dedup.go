@@ -0,0 +1,246 @@
+// Package dedup finds openings that are likely to be the same job posted
+// more than once.
+package dedup
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/links"
+)
+
+// Weights of each field in the similarity of two openings.
+const (
+	roleWeight     = 0.5
+	companyWeight  = 0.3
+	locationWeight = 0.2
+)
+
+// companyBlockLength is how many leading characters of their company keys
+// two openings must share to be compared at all.
+const companyBlockLength = 3
+
+// companySuffixes are legal forms that are left out of a company name
+// when comparing it.
+var companySuffixes = map[string]bool{
+	"co": true, "corp": true, "corporation": true, "eireli": true, "gmbh": true,
+	"inc": true, "incorporated": true, "limited": true, "llc": true, "ltd": true,
+	"ltda": true, "me": true, "sa": true,
+}
+
+// abbreviations are expanded before roles are compared.
+var abbreviations = map[string]string{
+	"dev": "developer", "eng": "engineer", "jr": "junior", "pl": "pleno",
+	"sr": "senior", "sre": "site reliability engineer",
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// LinkKey normalizes a link so that the addresses of the same page are
+// equal: the scheme, a "www." prefix, the fragment, a trailing slash,
+// tracking parameters and the order of the query are ignored. A link that
+// cannot be parsed is only trimmed and lowercased.
+func LinkKey(link string) string {
+	link = strings.TrimSpace(link)
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" {
+		return strings.ToLower(link)
+	}
+
+	query := u.Query()
+	for param := range query {
+		if links.IsTrackingParam(param) {
+			query.Del(param)
+		}
+	}
+
+	key := strings.TrimPrefix(strings.ToLower(u.Host), "www.") + strings.TrimRight(u.EscapedPath(), "/")
+	if encoded := query.Encode(); encoded != "" {
+		key += "?" + encoded
+	}
+	return key
+}
+
+// CompanyKey normalizes a company name, leaving out case, accents,
+// punctuation and legal forms such as "Ltda." or "Inc.".
+func CompanyKey(company string) string {
+	var kept []string
+	for _, word := range words(company) {
+		if !companySuffixes[word] {
+			kept = append(kept, word)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+// CompanyBlock is the start of the CompanyKey of company. Only openings in
+// the same block are compared, which keeps the comparisons few while a typo
+// or a different spelling further into the name still reaches Score.
+func CompanyBlock(company string) string {
+	key := []rune(CompanyKey(company))
+	if len(key) > companyBlockLength {
+		key = key[:companyBlockLength]
+	}
+	return string(key)
+}
+
+// Score is how alike two openings are, from 0 to 1. Openings with the same
+// link are the same job. Otherwise roles are compared by the words they
+// share, in any order, and company and location by their character pairs,
+// which tolerates typos and punctuation.
+func Score(a, b schemas.Opening) float64 {
+	if key := LinkKey(a.Link); key != "" && key == LinkKey(b.Link) {
+		return 1
+	}
+	return roleWeight*dice(roleWords(a.Role), roleWords(b.Role)) +
+		companyWeight*similarity(CompanyKey(a.Company), CompanyKey(b.Company)) +
+		locationWeight*similarity(normalize(a.Location), normalize(b.Location))
+}
+
+// Clusters groups openings that are duplicates of each other, directly or
+// through another opening, as well as the ones flagged with DuplicateOf.
+// Only groups of two or more are returned, each in ID order.
+func Clusters(openings []schemas.Opening, threshold float64) [][]schemas.Opening {
+	parent := make([]int, len(openings))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	union := func(i, j int) {
+		parent[find(i)] = find(j)
+	}
+
+	byID := make(map[uint]int, len(openings))
+	byLink := map[string]int{}
+	byCompany := map[string][]int{}
+	for i, opening := range openings {
+		byID[opening.ID] = i
+		if key := LinkKey(opening.Link); key != "" {
+			if j, ok := byLink[key]; ok {
+				union(i, j)
+			} else {
+				byLink[key] = i
+			}
+		}
+		block := CompanyBlock(opening.Company)
+		byCompany[block] = append(byCompany[block], i)
+	}
+
+	for _, block := range byCompany {
+		for x := 0; x < len(block); x++ {
+			for y := x + 1; y < len(block); y++ {
+				if Score(openings[block[x]], openings[block[y]]) >= threshold {
+					union(block[x], block[y])
+				}
+			}
+		}
+	}
+
+	for i, opening := range openings {
+		if opening.DuplicateOf == nil {
+			continue
+		}
+		if j, ok := byID[*opening.DuplicateOf]; ok {
+			union(i, j)
+		}
+	}
+
+	groups := map[int][]schemas.Opening{}
+	for i, opening := range openings {
+		root := find(i)
+		groups[root] = append(groups[root], opening)
+	}
+
+	var clusters [][]schemas.Opening
+	for _, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(x, y int) bool { return group[x].ID < group[y].ID })
+		clusters = append(clusters, group)
+	}
+	sort.Slice(clusters, func(x, y int) bool { return clusters[x][0].ID < clusters[y][0].ID })
+	return clusters
+}
+
+func normalize(s string) string {
+	return strings.Join(words(s), " ")
+}
+
+// words splits s into lowercase words without accents or punctuation.
+// Dots are dropped rather than split on, so "S.A." is read as "sa".
+func words(s string) []string {
+	s = strings.ReplaceAll(accents.Replace(strings.ToLower(s)), ".", "")
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func roleWords(role string) []string {
+	var expanded []string
+	for _, word := range words(role) {
+		if full, ok := abbreviations[word]; ok {
+			expanded = append(expanded, strings.Fields(full)...)
+		} else {
+			expanded = append(expanded, word)
+		}
+	}
+	return expanded
+}
+
+// similarity compares the character pairs of a and b: 1 for equal
+// strings and 0 when they share no pair.
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	return dice(bigrams(a), bigrams(b))
+}
+
+// dice is the Sørensen–Dice coefficient of two lists of terms.
+func dice(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, term := range a {
+		counts[term]++
+	}
+	shared := 0
+	for _, term := range b {
+		if counts[term] > 0 {
+			counts[term]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(a)+len(b))
+}
+
+func bigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return nil
+	}
+	pairs := make([]string, 0, len(runes)-1)
+	for i := 0; i < len(runes)-1; i++ {
+		pairs = append(pairs, string(runes[i:i+2]))
+	}
+	return pairs
+}
@@ -0,0 +1,116 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// subscriberBuffer is how many events a subscriber can fall behind before
+// it is dropped.
+const subscriberBuffer = 64
+
+// Broker fans opening events out to live subscribers and keeps the most
+// recent ones in a bounded buffer for subscribers resuming after a
+// disconnect.
+type Broker struct {
+	mu          sync.Mutex
+	recent      []schemas.OpeningEvent
+	next        int
+	full        bool
+	subscribers map[*Subscription]struct{}
+}
+
+// Subscription receives the events published after it was created. Events
+// is closed when the subscriber falls too far behind or is cancelled.
+type Subscription struct {
+	Events <-chan schemas.OpeningEvent
+	events chan schemas.OpeningEvent
+}
+
+func NewBroker(replaySize int) *Broker {
+	if replaySize <= 0 {
+		replaySize = 1
+	}
+
+	return &Broker{
+		recent:      make([]schemas.OpeningEvent, replaySize),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish never fails. An event the relay hands over twice is only sent
+// once.
+func (b *Broker) Publish(event schemas.OpeningEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, e := range b.buffered() {
+		if e.ID == event.ID {
+			return nil
+		}
+	}
+
+	b.recent[b.next] = event
+	b.next = (b.next + 1) % len(b.recent)
+	if b.next == 0 {
+		b.full = true
+	}
+
+	for sub := range b.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			b.remove(sub)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe starts a subscription and returns the buffered events published
+// after lastEventID. ok is false when lastEventID is no longer buffered, in
+// which case the caller may have missed events.
+func (b *Broker) Subscribe(lastEventID string) (sub *Subscription, replay []schemas.OpeningEvent, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := make(chan schemas.OpeningEvent, subscriberBuffer)
+	sub = &Subscription{Events: events, events: events}
+	b.subscribers[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return sub, nil, true
+	}
+
+	buffered := b.buffered()
+	for i, e := range buffered {
+		if e.ID == lastEventID {
+			return sub, buffered[i+1:], true
+		}
+	}
+
+	return sub, nil, false
+}
+
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.remove(sub)
+}
+
+func (b *Broker) remove(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// buffered returns the recent events from oldest to newest.
+func (b *Broker) buffered() []schemas.OpeningEvent {
+	if !b.full {
+		return append([]schemas.OpeningEvent(nil), b.recent[:b.next]...)
+	}
+	return append(append([]schemas.OpeningEvent(nil), b.recent[b.next:]...), b.recent[:b.next]...)
+}
@@ -0,0 +1,27 @@
+package events
+
+import (
+	"encoding/json"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// LogPublisher only logs events, which is handy to follow them in local
+// development.
+type LogPublisher struct {
+	logf func(format string, v ...interface{})
+}
+
+func NewLogPublisher(logf func(format string, v ...interface{})) *LogPublisher {
+	return &LogPublisher{logf: logf}
+}
+
+func (p *LogPublisher) Publish(event schemas.OpeningEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.logf("event %s %s: %s", event.Type, event.ID, payload)
+	return nil
+}
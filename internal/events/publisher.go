@@ -0,0 +1,9 @@
+package events
+
+import "github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+
+// Publisher hands an opening event to a consumer. Events can be published
+// more than once, so consumers should drop the IDs they have already seen.
+type Publisher interface {
+	Publish(event schemas.OpeningEvent) error
+}
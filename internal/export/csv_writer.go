@@ -0,0 +1,54 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// formulaPrefixes are the first characters that make a spreadsheet read a
+// cell as a formula.
+const formulaPrefixes = "=+-@\t\r"
+
+type csvWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+func newCSVWriter(w io.Writer, columns []string) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w), record: make([]string, len(columns))}
+	if err := cw.w.Write(columns); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (cw *csvWriter) WriteRow(values []interface{}) error {
+	for i, value := range values {
+		if text, ok := value.(string); ok {
+			cw.record[i] = escapeFormula(text)
+		} else {
+			cw.record[i] = formatValue(value)
+		}
+	}
+	return cw.w.Write(cw.record[:len(values)])
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *csvWriter) Close() error {
+	return cw.Flush()
+}
+
+// escapeFormula prefixes text that a spreadsheet would run as a formula
+// with a quote, so that it is shown as typed. Text comes from API clients
+// and job feeds and must never be trusted as a formula.
+func escapeFormula(text string) string {
+	if text != "" && strings.ContainsRune(formulaPrefixes, rune(text[0])) {
+		return "'" + text
+	}
+	return text
+}
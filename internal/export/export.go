@@ -0,0 +1,71 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+	FormatXLSX   = "xlsx"
+)
+
+// Formats lists the supported formats, as accepted by NewWriter.
+var Formats = []string{FormatCSV, FormatNDJSON, FormatXLSX}
+
+// Writer writes a table row by row. Values may be strings, integers, bools
+// or times; anything else is written with fmt's default format.
+type Writer interface {
+	WriteRow(values []interface{}) error
+	// Flush sends the rows written so far to the underlying writer.
+	Flush() error
+	// Close finishes the file. The underlying writer is not closed.
+	Close() error
+}
+
+// NewWriter returns a Writer for format whose rows have the given columns.
+// CSV and XLSX files start with a header row; NDJSON uses the columns as
+// the keys of each object.
+func NewWriter(format string, w io.Writer, columns []string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, columns)
+	case FormatNDJSON:
+		return newNDJSONWriter(w, columns), nil
+	case FormatXLSX:
+		return newXLSXWriter(w, columns)
+	}
+	return nil, fmt.Errorf("unknown export format %q", format)
+}
+
+// ContentType is the media type of files in format.
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/octet-stream"
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,58 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// ndjsonWriter writes each row as an object with the keys in column order,
+// which encoding/json would not keep for a map.
+type ndjsonWriter struct {
+	w     *bufio.Writer
+	keys  [][]byte
+	value bytes.Buffer
+	enc   *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer, columns []string) *ndjsonWriter {
+	keys := make([][]byte, len(columns))
+	for i, column := range columns {
+		keys[i], _ = json.Marshal(column)
+	}
+	nw := &ndjsonWriter{w: bufio.NewWriter(w), keys: keys}
+	nw.enc = json.NewEncoder(&nw.value)
+	nw.enc.SetEscapeHTML(false)
+	return nw
+}
+
+func (nw *ndjsonWriter) WriteRow(values []interface{}) error {
+	nw.w.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			nw.w.WriteByte(',')
+		}
+		if t, ok := value.(time.Time); ok {
+			value = t.UTC()
+		}
+		nw.value.Reset()
+		if err := nw.enc.Encode(value); err != nil {
+			return err
+		}
+		nw.w.Write(nw.keys[i])
+		nw.w.WriteByte(':')
+		nw.w.Write(bytes.TrimSuffix(nw.value.Bytes(), []byte("\n")))
+	}
+	_, err := nw.w.WriteString("}\n")
+	return err
+}
+
+func (nw *ndjsonWriter) Flush() error {
+	return nw.w.Flush()
+}
+
+func (nw *ndjsonWriter) Close() error {
+	return nw.Flush()
+}
@@ -0,0 +1,59 @@
+package feed
+
+import (
+	"io"
+	"time"
+)
+
+const (
+	FormatRSS  = "rss"
+	FormatAtom = "atom"
+	FormatJSON = "json"
+)
+
+// Feed is a format independent feed, written with WriteRSS, WriteAtom or
+// WriteJSON.
+type Feed struct {
+	Title       string
+	Description string
+	// Link is the page the feed is about; FeedURL is where the feed itself
+	// is served.
+	Link    string
+	FeedURL string
+	Author  string
+	Updated time.Time
+	Items   []Item
+}
+
+// Item is an entry of a feed. ID must never change for the same entry, so
+// readers do not show it again as new.
+type Item struct {
+	ID        string
+	Title     string
+	Link      string
+	Content   string
+	Published time.Time
+	Updated   time.Time
+}
+
+// Write writes f in format.
+func Write(w io.Writer, format string, f Feed) error {
+	switch format {
+	case FormatAtom:
+		return WriteAtom(w, f)
+	case FormatJSON:
+		return WriteJSON(w, f)
+	}
+	return WriteRSS(w, f)
+}
+
+// ContentType is the media type of feeds in format.
+func ContentType(format string) string {
+	switch format {
+	case FormatAtom:
+		return "application/atom+xml; charset=utf-8"
+	case FormatJSON:
+		return "application/feed+json; charset=utf-8"
+	}
+	return "application/rss+xml; charset=utf-8"
+}
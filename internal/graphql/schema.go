@@ -0,0 +1,334 @@
+package graphql
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// company is the source of the Company type. Companies are not stored on
+// their own, so a company is only its name and the openings listed under it.
+type company struct {
+	name string
+}
+
+// resolverError carries the kind of an internal_error to the client in the
+// "code" extension, e.g. NOT_FOUND or BAD_REQUEST.
+type resolverError struct {
+	err *internal_error.InternalError
+}
+
+func (e resolverError) Error() string {
+	return e.err.Message
+}
+
+func (e resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": strings.ToUpper(e.err.Err)}
+}
+
+type resolver struct {
+	useCase opening_usecase.OpeningUsecase
+}
+
+// NewSchema builds the GraphQL schema of openings. Every field is resolved
+// through the opening usecase, so GraphQL gets the same validation and
+// events as the REST API.
+func NewSchema(useCase opening_usecase.OpeningUsecase) (gql.Schema, error) {
+	r := &resolver{useCase: useCase}
+
+	stageCountType := gql.NewObject(gql.ObjectConfig{
+		Name: "StageCount",
+		Fields: gql.Fields{
+			"stage": &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"count": &gql.Field{Type: gql.NewNonNull(gql.Int)},
+		},
+	})
+
+	filterInput := gql.NewInputObject(gql.InputObjectConfig{
+		Name: "OpeningFilter",
+		Fields: gql.InputObjectConfigFieldMap{
+			"q":         &gql.InputObjectFieldConfig{Type: gql.String, Description: "Text to match in the role, company or location"},
+			"company":   &gql.InputObjectFieldConfig{Type: gql.String},
+			"location":  &gql.InputObjectFieldConfig{Type: gql.String},
+			"remote":    &gql.InputObjectFieldConfig{Type: gql.Boolean},
+			"minSalary": &gql.InputObjectFieldConfig{Type: gql.Int},
+			"status":    &gql.InputObjectFieldConfig{Type: gql.String, Description: "open or closed"},
+		},
+	})
+
+	var companyType *gql.Object
+	openingType := gql.NewObject(gql.ObjectConfig{
+		Name: "Opening",
+		Fields: gql.FieldsThunk(func() gql.Fields {
+			stageCountsField := openingField(gql.NewList(gql.NewNonNull(stageCountType)), stageCounts)
+			stageCountsField.Description = "Applications per pipeline stage. Only filled in on the opening query"
+
+			return gql.Fields{
+				"id":        openingField(gql.NewNonNull(gql.ID), func(o *schemas.Opening) interface{} { return o.ID }),
+				"createdAt": openingField(gql.NewNonNull(gql.DateTime), func(o *schemas.Opening) interface{} { return o.CreatedAt }),
+				"updatedAt": openingField(gql.NewNonNull(gql.DateTime), func(o *schemas.Opening) interface{} { return o.UpdatedAt }),
+				"role":      openingField(gql.NewNonNull(gql.String), func(o *schemas.Opening) interface{} { return o.Role }),
+				"company":   openingField(gql.NewNonNull(companyType), func(o *schemas.Opening) interface{} { return company{name: o.Company} }),
+				"location":  openingField(gql.NewNonNull(gql.String), func(o *schemas.Opening) interface{} { return o.Location }),
+				"remote":    openingField(gql.NewNonNull(gql.Boolean), func(o *schemas.Opening) interface{} { return o.Remote }),
+				"link":      openingField(gql.NewNonNull(gql.String), func(o *schemas.Opening) interface{} { return o.Link }),
+				"salary":    openingField(gql.NewNonNull(gql.Int), func(o *schemas.Opening) interface{} { return o.Salary }),
+				"status": openingField(gql.NewNonNull(gql.String), func(o *schemas.Opening) interface{} {
+					if o.Status == "" {
+						return schemas.OpeningStatusOpen
+					}
+					return o.Status
+				}),
+				"stageCounts": stageCountsField,
+			}
+		}),
+	})
+
+	companyType = gql.NewObject(gql.ObjectConfig{
+		Name: "Company",
+		Fields: gql.Fields{
+			"name": &gql.Field{
+				Type: gql.NewNonNull(gql.String),
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					return p.Source.(company).name, nil
+				},
+			},
+			"openings": &gql.Field{
+				Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(openingType))),
+				Args: gql.FieldConfigArgument{
+					"page": &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+				},
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					filter := schemas.OpeningFilter{Company: p.Source.(company).name}
+					return r.listOpenings(filter, p.Args["page"].(int))
+				},
+			},
+		},
+	})
+
+	createInput := gql.NewInputObject(gql.InputObjectConfig{
+		Name: "CreateOpeningInput",
+		Fields: gql.InputObjectConfigFieldMap{
+			"role":     &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.String)},
+			"company":  &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.String)},
+			"location": &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.String)},
+			"remote":   &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.Boolean)},
+			"link":     &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.String)},
+			"salary":   &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.Int)},
+		},
+	})
+
+	updateInput := gql.NewInputObject(gql.InputObjectConfig{
+		Name: "UpdateOpeningInput",
+		Fields: gql.InputObjectConfigFieldMap{
+			"role":     &gql.InputObjectFieldConfig{Type: gql.String},
+			"company":  &gql.InputObjectFieldConfig{Type: gql.String},
+			"location": &gql.InputObjectFieldConfig{Type: gql.String},
+			"remote":   &gql.InputObjectFieldConfig{Type: gql.Boolean},
+			"link":     &gql.InputObjectFieldConfig{Type: gql.String},
+			"salary":   &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.Int)},
+			"status":   &gql.InputObjectFieldConfig{Type: gql.String},
+		},
+	})
+
+	query := gql.NewObject(gql.ObjectConfig{
+		Name: "Query",
+		Fields: gql.Fields{
+			"opening": &gql.Field{
+				Type: openingType,
+				Args: gql.FieldConfigArgument{
+					"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+				},
+				Resolve: r.opening,
+			},
+			"openings": &gql.Field{
+				Type:        gql.NewNonNull(gql.NewList(gql.NewNonNull(openingType))),
+				Description: "Openings matching the filter, 10 per page",
+				Args: gql.FieldConfigArgument{
+					"filter": &gql.ArgumentConfig{Type: filterInput},
+					"page":   &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+				},
+				Resolve: r.openings,
+			},
+		},
+	})
+
+	mutation := gql.NewObject(gql.ObjectConfig{
+		Name: "Mutation",
+		Fields: gql.Fields{
+			"createOpening": &gql.Field{
+				Type: gql.NewNonNull(openingType),
+				Args: gql.FieldConfigArgument{
+					"input": &gql.ArgumentConfig{Type: gql.NewNonNull(createInput)},
+				},
+				Resolve: r.createOpening,
+			},
+			"updateOpening": &gql.Field{
+				Type: gql.NewNonNull(openingType),
+				Args: gql.FieldConfigArgument{
+					"id":    &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+					"input": &gql.ArgumentConfig{Type: gql.NewNonNull(updateInput)},
+				},
+				Resolve: r.updateOpening,
+			},
+			"deleteOpening": &gql.Field{
+				Type: gql.NewNonNull(gql.Boolean),
+				Args: gql.FieldConfigArgument{
+					"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+				},
+				Resolve: r.deleteOpening,
+			},
+		},
+	})
+
+	return gql.NewSchema(gql.SchemaConfig{Query: query, Mutation: mutation})
+}
+
+func (r *resolver) opening(p gql.ResolveParams) (interface{}, error) {
+	id, err := idArg(p)
+	if err != nil {
+		return nil, err
+	}
+
+	opening, errCase := r.useCase.GetByID(id)
+	if errCase != nil {
+		return nil, resolverError{errCase}
+	}
+	return opening, nil
+}
+
+func (r *resolver) openings(p gql.ResolveParams) (interface{}, error) {
+	var filter schemas.OpeningFilter
+	if args, ok := p.Args["filter"].(map[string]interface{}); ok {
+		filter.Query, _ = args["q"].(string)
+		filter.Company, _ = args["company"].(string)
+		filter.Location, _ = args["location"].(string)
+		filter.Status, _ = args["status"].(string)
+		if remote, ok := args["remote"].(bool); ok {
+			filter.Remote = &remote
+		}
+		if minSalary, ok := args["minSalary"].(int); ok {
+			filter.MinSalary = int64(minSalary)
+		}
+	}
+
+	return r.listOpenings(filter, p.Args["page"].(int))
+}
+
+// listOpenings returns an empty list where the REST listing answers 404.
+func (r *resolver) listOpenings(filter schemas.OpeningFilter, page int) ([]schemas.Opening, error) {
+	openings, errCase := r.useCase.ListOpenings(filter, page)
+	if errCase != nil {
+		if errCase.Err == "not_found" {
+			return []schemas.Opening{}, nil
+		}
+		return nil, resolverError{errCase}
+	}
+	return openings, nil
+}
+
+func (r *resolver) createOpening(p gql.ResolveParams) (interface{}, error) {
+	input := p.Args["input"].(map[string]interface{})
+	remote := input["remote"].(bool)
+
+	opening, errCase := r.useCase.Create(schemas.CreateOpeningRequest{
+		Role:     input["role"].(string),
+		Company:  input["company"].(string),
+		Location: input["location"].(string),
+		Remote:   &remote,
+		Link:     input["link"].(string),
+		Salary:   int64(input["salary"].(int)),
+	})
+	if errCase != nil {
+		return nil, resolverError{errCase}
+	}
+	return opening, nil
+}
+
+func (r *resolver) updateOpening(p gql.ResolveParams) (interface{}, error) {
+	id, err := idArg(p)
+	if err != nil {
+		return nil, err
+	}
+
+	input := p.Args["input"].(map[string]interface{})
+	req := schemas.UpdateOpeningRequest{Salary: int64(input["salary"].(int))}
+	req.Role, _ = input["role"].(string)
+	req.Company, _ = input["company"].(string)
+	req.Location, _ = input["location"].(string)
+	req.Link, _ = input["link"].(string)
+	req.Status, _ = input["status"].(string)
+	if remote, ok := input["remote"].(bool); ok {
+		req.Remote = &remote
+	}
+
+	if errCase := r.useCase.Update(id, req, nil); errCase != nil {
+		return nil, resolverError{errCase}
+	}
+
+	opening, errCase := r.useCase.GetByID(id)
+	if errCase != nil {
+		return nil, resolverError{errCase}
+	}
+	return opening, nil
+}
+
+func (r *resolver) deleteOpening(p gql.ResolveParams) (interface{}, error) {
+	id, err := idArg(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if errCase := r.useCase.DeleteByID(id, nil); errCase != nil {
+		return nil, resolverError{errCase}
+	}
+	return true, nil
+}
+
+func idArg(p gql.ResolveParams) (uint, error) {
+	id, err := strconv.ParseUint(p.Args["id"].(string), 10, 64)
+	if err != nil || id == 0 {
+		return 0, resolverError{internal_error.NewBadRequestError("invalid ID")}
+	}
+	return uint(id), nil
+}
+
+// openingField resolves a field of an Opening, which the default resolver
+// cannot reach through the embedded gorm.Model.
+func openingField(typ gql.Output, value func(o *schemas.Opening) interface{}) *gql.Field {
+	return &gql.Field{
+		Type: typ,
+		Resolve: func(p gql.ResolveParams) (interface{}, error) {
+			switch o := p.Source.(type) {
+			case *schemas.Opening:
+				return value(o), nil
+			case schemas.Opening:
+				return value(&o), nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func stageCounts(o *schemas.Opening) interface{} {
+	if o.StageCounts == nil {
+		return nil
+	}
+
+	stages := make([]string, 0, len(o.StageCounts))
+	for stage := range o.StageCounts {
+		stages = append(stages, stage)
+	}
+	sort.Strings(stages)
+
+	counts := make([]map[string]interface{}, 0, len(stages))
+	for _, stage := range stages {
+		counts = append(counts, map[string]interface{}{"stage": stage, "count": o.StageCounts[stage]})
+	}
+	return counts
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// @BasePath /api/v1
+
+// @Summary Bulk write openings
+// @Description Create, update and delete many job openings in one request. In the transactional mode (default) either every operation is applied or none is; in the best_effort mode each one is applied on its own. Each result carries the status and message the single-opening endpoint would have answered; rolled back operations get 424
+// @Tags Openings
+// @Accept json
+// @Produce json
+// @Param request body schemas.BulkOpeningRequest true "Operations"
+// @Success 200 {object} BulkOpeningResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/bulk [post]
+func (h *OpeningHandler) Bulk(c *gin.Context) {
+	var req schemas.BulkOpeningRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	results, errCase := h.useCase.Bulk(req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	data := BulkOpeningData{Results: make([]BulkOpeningResult, len(results))}
+	for i, result := range results {
+		item := BulkOpeningResult{
+			Index:  i,
+			Op:     req.Operations[i].Op,
+			ID:     result.ID,
+			Status: http.StatusOK,
+		}
+		switch {
+		case result.Err != nil:
+			restErr := rest_err.ConvertError(result.Err)
+			item.Status, item.Message, item.Causes = restErr.Code, restErr.Message, restErr.Causes
+		case result.RolledBack:
+			item.Status, item.Message = http.StatusFailedDependency, "not applied because another operation failed"
+		case item.Op == schemas.BulkOpCreate:
+			item.Status = http.StatusCreated
+		}
+
+		if item.Message == "" {
+			data.Applied++
+		} else {
+			data.Failed++
+		}
+		data.Results[i] = item
+	}
+
+	sendSuccess(c, "bulk-openings", data)
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary Confirm job alert
+// @Description Confirm a job alert with the token emailed on subscription
+// @Tags Alerts
+// @Produce json
+// @Param token query string true "Confirmation token"
+// @Success 200 {object} ConfirmJobAlertResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /alerts/confirm [get]
+func (h *AlertHandler) Confirm(c *gin.Context) {
+	alert, errCase := h.useCase.Confirm(c.Query("token"))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "confirm-alert", alert)
+}
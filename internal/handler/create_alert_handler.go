@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/alert_usecase"
+)
+
+type AlertHandler struct {
+	useCase alert_usecase.AlertUsecase
+}
+
+func NewAlertHandler(useCase alert_usecase.AlertUsecase) *AlertHandler {
+	return &AlertHandler{useCase: useCase}
+}
+
+// @BasePath /api/v1
+
+// @Summary Subscribe to job alerts
+// @Description Save a job alert. A confirmation link is emailed and nothing is sent until it is opened
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Param request body schemas.CreateJobAlertRequest true "Alert criteria"
+// @Success 201 {object} CreateJobAlertResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /alerts [post]
+func (h *AlertHandler) Subscribe(c *gin.Context) {
+	var req schemas.CreateJobAlertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	errCase := h.useCase.Subscribe(req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "alert created successfully, check your inbox to confirm it",
+	})
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/application_usecase"
+)
+
+type ApplicationHandler struct {
+	useCase application_usecase.ApplicationUsecase
+}
+
+func NewApplicationHandler(useCase application_usecase.ApplicationUsecase) *ApplicationHandler {
+	return &ApplicationHandler{useCase: useCase}
+}
+
+// @BasePath /api/v1
+
+// @Summary Apply to opening
+// @Description Submit a candidate application to a job opening
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Param request body schemas.CreateApplicationRequest true "Request body"
+// @Success 201 {object} CreateApplicationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/{id}/applications [post]
+func (h *ApplicationHandler) Create(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	var req schemas.CreateApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	errCase := h.useCase.Create(uint(id), req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": fmt.Sprintf("application of %s created successfully", req.CandidateName),
+	})
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/candidate_usecase"
+)
+
+// multipartOverhead leaves room for the form fields and part headers that
+// travel with the résumé in the same request body.
+const multipartOverhead = 1 << 20
+
+type CandidateHandler struct {
+	useCase       candidate_usecase.CandidateUsecase
+	maxResumeSize int64
+}
+
+func NewCandidateHandler(useCase candidate_usecase.CandidateUsecase, maxResumeSize int64) *CandidateHandler {
+	return &CandidateHandler{useCase: useCase, maxResumeSize: maxResumeSize}
+}
+
+// @BasePath /api/v1
+
+// @Summary Create candidate profile
+// @Description Register interest in a job opening and upload a résumé (PDF or DOCX)
+// @Tags Candidates
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Param name formData string true "Candidate name"
+// @Param email formData string true "Candidate email"
+// @Param phone formData string false "Candidate phone"
+// @Param resume formData file true "Résumé file"
+// @Success 201 {object} CreateCandidateProfileResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/{id}/candidates [post]
+func (h *CandidateHandler) Create(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxResumeSize+multipartOverhead)
+
+	var req schemas.CreateCandidateProfileRequest
+	if err := c.ShouldBind(&req); err != nil {
+		sendUploadError(c, err)
+		return
+	}
+
+	var resume schemas.ResumeUpload
+	fileHeader, err := c.FormFile("resume")
+	if err != nil && !errors.Is(err, http.ErrMissingFile) {
+		sendUploadError(c, err)
+		return
+	}
+
+	if fileHeader != nil {
+		file, err := fileHeader.Open()
+		if err != nil {
+			sendError(c, rest_err.NewBadRequestError(err.Error()))
+			return
+		}
+		defer file.Close()
+
+		resume = schemas.ResumeUpload{
+			FileName: fileHeader.Filename,
+			Size:     fileHeader.Size,
+			Content:  file,
+		}
+	}
+
+	errCase := h.useCase.Create(uint(id), req, resume)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": fmt.Sprintf("candidate profile of %s created successfully", req.Name),
+	})
+}
+
+func sendUploadError(c *gin.Context, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		sendError(c, rest_err.NewRequestEntityTooLargeError("request body too large"))
+		return
+	}
+	sendError(c, rest_err.NewBadRequestError(err.Error()))
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/digest_usecase"
+)
+
+type DigestHandler struct {
+	useCase digest_usecase.DigestUsecase
+}
+
+func NewDigestHandler(useCase digest_usecase.DigestUsecase) *DigestHandler {
+	return &DigestHandler{useCase: useCase}
+}
+
+// @BasePath /api/v1
+
+// @Summary Subscribe to digest
+// @Description Subscribe to a daily or weekly email digest of new openings. A confirmation link is emailed first
+// @Tags Digests
+// @Accept json
+// @Produce json
+// @Param request body schemas.CreateDigestSubscriptionRequest true "Email and cadence"
+// @Success 201 {object} CreateDigestSubscriptionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /digests [post]
+func (h *DigestHandler) Subscribe(c *gin.Context) {
+	var req schemas.CreateDigestSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	errCase := h.useCase.Subscribe(req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "digest subscription created successfully, check your inbox to confirm it",
+	})
+}
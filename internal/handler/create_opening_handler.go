@@ -11,11 +11,35 @@ import (
 )
 
 type OpeningHandler struct {
-	useCase opening_usecase.OpeningUsecase
+	useCase         opening_usecase.OpeningUsecase
+	ifMatchRequired bool
+	jobPosting      schemas.JobPostingPolicy
 }
 
-func NewOpeningHandler(useCase opening_usecase.OpeningUsecase) *OpeningHandler {
-	return &OpeningHandler{useCase: useCase}
+type OpeningHandlerOption func(h *OpeningHandler)
+
+// WithIfMatchRequired makes PUT, PATCH and DELETE answer 428 unless they
+// send an If-Match header.
+func WithIfMatchRequired(required bool) OpeningHandlerOption {
+	return func(h *OpeningHandler) {
+		h.ifMatchRequired = required
+	}
+}
+
+// WithJobPostingPolicy sets how openings are described as schema.org
+// JobPostings. schemas.DefaultJobPostingPolicy is used otherwise.
+func WithJobPostingPolicy(policy schemas.JobPostingPolicy) OpeningHandlerOption {
+	return func(h *OpeningHandler) {
+		h.jobPosting = policy
+	}
+}
+
+func NewOpeningHandler(useCase opening_usecase.OpeningUsecase, opts ...OpeningHandlerOption) *OpeningHandler {
+	h := &OpeningHandler{useCase: useCase, jobPosting: schemas.DefaultJobPostingPolicy}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // @BasePath /api/v1
@@ -26,25 +50,41 @@ func NewOpeningHandler(useCase opening_usecase.OpeningUsecase) *OpeningHandler {
 // @Accept json
 // @Produce json
 // @Param request body schemas.CreateOpeningRequest true "Request body"
+// @Param Idempotency-Key header string false "Makes retries of this request return the first response"
 // @Success 200 {object} CreateOpeningResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} DuplicateOpeningErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /openings [post]
 func (h *OpeningHandler) Create(c *gin.Context) {
 	var req schemas.CreateOpeningRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, err.Error())
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
 		return
 	}
 
-	errCase := h.useCase.Create(req)
+	opening, errCase := h.useCase.Create(req)
 	if errCase != nil {
 		rest_err := rest_err.ConvertError(errCase)
-		sendError(c, rest_err.Code, rest_err.Message)
+		if opening != nil && rest_err.Code == http.StatusConflict {
+			c.JSON(http.StatusConflict, gin.H{
+				"message":     rest_err.Message,
+				"err":         rest_err.Err,
+				"errorCode":   rest_err.Code,
+				"duplicateOf": opening.ID,
+			})
+			return
+		}
+		sendError(c, rest_err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{
+	body := gin.H{
 		"message": fmt.Sprintf("opening %s created successfully", req.Role),
-	})
+	}
+	if opening != nil && opening.DuplicateOf != nil {
+		body["duplicateOf"] = *opening.DuplicateOf
+	}
+	c.JSON(http.StatusCreated, body)
 }
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/webhook_usecase"
+)
+
+type WebhookHandler struct {
+	useCase webhook_usecase.WebhookUsecase
+}
+
+func NewWebhookHandler(useCase webhook_usecase.WebhookUsecase) *WebhookHandler {
+	return &WebhookHandler{useCase: useCase}
+}
+
+// @BasePath /api/v1
+
+// @Summary Create webhook
+// @Description Subscribe a URL to opening events. Payloads are signed with the secret in the X-Webhook-Signature header
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param request body schemas.CreateWebhookRequest true "Webhook"
+// @Success 201 {object} CreateWebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks [post]
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var req schemas.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	webhook, errCase := h.useCase.Create(req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "webhook created successfully",
+		"data":    webhook,
+	})
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"fmt"
-	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -17,21 +16,30 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path string true "Opening ID"
+// @Param If-Match header string false "ETag the deletion is based on"
 // @Success 200 {object} DeleteOpeningResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 428 {object} ErrorResponse
 // @Router /openings/{id} [delete]
 func (h *OpeningHandler) Delete(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		sendError(c, http.StatusBadRequest, "invalid ID")
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
 		return
 	}
 
-	errCase := h.useCase.DeleteByID(uint(id))
+	precondition, ok := h.precondition(c)
+	if !ok {
+		return
+	}
+
+	errCase := h.useCase.DeleteByID(uint(id), precondition)
 	if errCase != nil {
 		rest_err := rest_err.ConvertError(errCase)
-		sendError(c, rest_err.Code, rest_err.Message)
+		sendError(c, rest_err)
 		return
 	}
 
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary Delete webhook
+// @Description Stop sending events to a webhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Identification"
+// @Success 200 {object} DeleteWebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	errCase := h.useCase.Delete(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, fmt.Sprintf("webhook with id: %d deleted", id), nil)
+}
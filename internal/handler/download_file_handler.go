@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
+)
+
+type FileHandler struct {
+	storage *storage.LocalStorage
+}
+
+func NewFileHandler(storage *storage.LocalStorage) *FileHandler {
+	return &FileHandler{storage: storage}
+}
+
+// @BasePath /api/v1
+
+// @Summary Download file
+// @Description Download a stored file through a signed URL issued by the local storage backend
+// @Tags Files
+// @Produce octet-stream
+// @Param key path string true "Object key"
+// @Param expires query int true "Expiration (unix seconds)"
+// @Param signature query string true "URL signature"
+// @Success 200 {file} file
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /files/{key} [get]
+func (h *FileHandler) Download(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil || !h.storage.Verify(key, expires, c.Query("signature")) {
+		sendError(c, rest_err.NewForbiddenError("invalid or expired signature"))
+		return
+	}
+
+	file, err := h.storage.Get(key)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		sendError(c, rest_err.NewNotFoundError("file not found"))
+		return
+	}
+	if err != nil {
+		sendError(c, rest_err.NewInternalServerError("error reading file"))
+		return
+	}
+	defer file.Close()
+
+	c.DataFromReader(http.StatusOK, -1, contentTypeOf(key), file, map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%q", path.Base(key)),
+	})
+}
+
+func contentTypeOf(key string) string {
+	switch ext := path.Ext(key); ext {
+	case ".pdf":
+		return schemas.ResumeContentTypePDF
+	case ".docx":
+		return schemas.ResumeContentTypeDOCX
+	default:
+		if t := mime.TypeByExtension(ext); t != "" {
+			return t
+		}
+		return "application/octet-stream"
+	}
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary List suspected duplicate openings
+// @Description Group the open openings that look like the same job, by link or by a similar role, company and location, so they can be merged
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} ListDuplicateClustersResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/openings/duplicates [get]
+func (h *OpeningHandler) ListDuplicates(c *gin.Context) {
+	clusters, errCase := h.useCase.DuplicateClusters()
+	if errCase != nil {
+		rest_err := rest_err.ConvertError(errCase)
+		sendError(c, rest_err)
+		return
+	}
+
+	sendSuccess(c, "list-duplicate-clusters", clusters)
+}
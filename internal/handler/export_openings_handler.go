@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/export"
+)
+
+// exportColumns are the opening fields that can be exported, in their
+// default order.
+var exportColumns = []string{"id", "createdAt", "updatedAt", "role", "company", "location", "remote", "link", "salary", "status", "version"}
+
+func exportValue(o *schemas.Opening, column string) interface{} {
+	switch column {
+	case "id":
+		return o.ID
+	case "createdAt":
+		return o.CreatedAt
+	case "updatedAt":
+		return o.UpdatedAt
+	case "role":
+		return o.Role
+	case "company":
+		return o.Company
+	case "location":
+		return o.Location
+	case "remote":
+		return o.Remote
+	case "link":
+		return o.Link
+	case "salary":
+		return o.Salary
+	case "status":
+		if o.Status == "" {
+			return schemas.OpeningStatusOpen
+		}
+		return o.Status
+	case "version":
+		return o.Version
+	}
+	return nil
+}
+
+// @BasePath /api/v1
+
+// @Summary Export openings
+// @Description Download the openings matching the listing filters as a CSV, NDJSON or XLSX file. Rows are streamed in ID order as they are read
+// @Tags Openings
+// @Produce text/csv,application/x-ndjson,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "csv (default), ndjson or xlsx"
+// @Param columns query string false "Comma separated columns to export: id, createdAt, updatedAt, role, company, location, remote, link, salary, status, version (default all)"
+// @Param q query string false "Text to match in the role, company or location"
+// @Param company query string false "Company name"
+// @Param location query string false "Part of the location"
+// @Param remote query bool false "Remote openings only (true) or on-site only (false)"
+// @Param minSalary query int false "Minimum salary"
+// @Param status query string false "open or closed"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/export [get]
+func (h *OpeningHandler) Export(c *gin.Context) {
+	format := c.DefaultQuery("format", export.FormatCSV)
+	if !containsString(export.Formats, format) {
+		sendError(c, rest_err.NewBadRequestError("format must be one of: "+strings.Join(export.Formats, ", ")))
+		return
+	}
+
+	columns, err := exportColumnsFromQuery(c.Query("columns"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	filter, err := openingFilterFromQuery(c)
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	// Nothing is written until the first batch is read, so a failing query
+	// can still be answered with an error status.
+	var writer export.Writer
+	start := func() error {
+		filename := fmt.Sprintf("openings-%s.%s", time.Now().UTC().Format("2006-01-02"), format)
+		c.Header("Content-Type", export.ContentType(format))
+		c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+		c.Status(http.StatusOK)
+
+		writer, err = export.NewWriter(format, c.Writer, columns)
+		return err
+	}
+
+	row := make([]interface{}, len(columns))
+	errCase := h.useCase.Export(filter, func(openings []schemas.Opening) error {
+		if writer == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		for i := range openings {
+			for j, column := range columns {
+				row[j] = exportValue(&openings[i], column)
+			}
+			if err := writer.WriteRow(row); err != nil {
+				return err
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if errCase != nil {
+		if writer == nil {
+			restErr := rest_err.ConvertError(errCase)
+			sendError(c, restErr)
+			return
+		}
+		// The status is already sent; leaving the file unfinished is the
+		// only way left to tell the client it is incomplete.
+		c.Error(errCase)
+		return
+	}
+
+	if writer == nil {
+		if err := start(); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+	if err := writer.Close(); err != nil {
+		c.Error(err)
+	}
+}
+
+func exportColumnsFromQuery(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return exportColumns, nil
+	}
+
+	var columns []string
+	for _, column := range strings.Split(value, ",") {
+		column = strings.TrimSpace(column)
+		if !containsString(exportColumns, column) {
+			return nil, fmt.Errorf("unknown column %q, must be one of: %s", column, strings.Join(exportColumns, ", "))
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
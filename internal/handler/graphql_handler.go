@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+type GraphQLHandler struct {
+	schema   gql.Schema
+	graphiQL bool
+	endpoint string
+}
+
+func NewGraphQLHandler(schema gql.Schema, graphiQL bool, endpoint string) *GraphQLHandler {
+	return &GraphQLHandler{schema: schema, graphiQL: graphiQL, endpoint: endpoint}
+}
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// @BasePath /api/v1
+
+// @Summary GraphQL query
+// @Description Run a GraphQL query or mutation on openings. Errors come back in the errors list with a code extension such as NOT_FOUND or BAD_REQUEST
+// @Tags GraphQL
+// @Accept json
+// @Produce json
+// @Param request body GraphQLRequest true "GraphQL request"
+// @Success 200 {object} GraphQLResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var req GraphQLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	h.execute(c, req, false)
+}
+
+// @Summary GraphQL query over GET
+// @Description Run a GraphQL query passed in the query string. Browsers get the GraphiQL playground instead when it is enabled
+// @Tags GraphQL
+// @Produce json
+// @Param query query string true "GraphQL query"
+// @Param operationName query string false "Operation to run"
+// @Param variables query string false "Variables as a JSON object"
+// @Success 200 {object} GraphQLResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /graphql [get]
+func (h *GraphQLHandler) Get(c *gin.Context) {
+	if h.graphiQL && c.Query("query") == "" && strings.Contains(c.GetHeader("Accept"), "text/html") {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(strings.ReplaceAll(graphiQLPage, "{{endpoint}}", h.endpoint)))
+		return
+	}
+
+	req := GraphQLRequest{Query: c.Query("query"), OperationName: c.Query("operationName")}
+	if variables := c.Query("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+			sendError(c, rest_err.NewBadRequestError("invalid variables"))
+			return
+		}
+	}
+
+	// GET must not change anything, so only queries are run.
+	h.execute(c, req, true)
+}
+
+func (h *GraphQLHandler) execute(c *gin.Context, req GraphQLRequest, readOnly bool) {
+	if req.Query == "" {
+		sendError(c, rest_err.NewBadRequestError("param: query (type: string) is required"))
+		return
+	}
+
+	if readOnly && isMutation(req) {
+		sendError(c, rest_err.NewMethodNotAllowedError("mutations must be sent with POST"))
+		return
+	}
+
+	result := gql.Do(gql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        c.Request.Context(),
+	})
+
+	c.JSON(http.StatusOK, result)
+}
+
+// isMutation reports whether the operation to run is a mutation. A query
+// that does not parse is left for gql.Do to report.
+func isMutation(req GraphQLRequest) bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return false
+	}
+
+	for _, definition := range doc.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if req.OperationName == "" || (operation.Name != nil && operation.Name.Value == req.OperationName) {
+			return operation.Operation == ast.OperationTypeMutation
+		}
+	}
+	return false
+}
+
+const graphiQLPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>go-opportunities GraphiQL</title>
+  <link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css" />
+</head>
+<body style="margin: 0">
+  <div id="graphiql" style="height: 100vh"></div>
+  <script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+  <script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+  <script>
+    const fetcher = GraphiQL.createFetcher({ url: "{{endpoint}}" });
+    ReactDOM.createRoot(document.getElementById("graphiql")).render(React.createElement(GraphiQL, { fetcher }));
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/idempotency_usecase"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// replayedHeaders are the response headers stored with the body and sent
+// again on a replay.
+var replayedHeaders = []string{"Content-Type", "ETag", "Location"}
+
+// Idempotency makes POST requests that send an Idempotency-Key safe to
+// retry: the first response is stored and replayed to later requests with
+// the same key and the same method, path and body. The body is buffered
+// to fingerprint it, so bodies over maxSize are answered with 413; maxSize
+// should be at least the largest limit of the handlers behind it.
+func Idempotency(useCase idempotency_usecase.IdempotencyUsecase, maxSize int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || c.Request.Method != http.MethodPost {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxSize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				sendError(c, rest_err.NewRequestEntityTooLargeError("request body too large"))
+			} else {
+				sendError(c, rest_err.NewBadRequestError("error reading the request body"))
+			}
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		replay, errCase := useCase.Begin(key, requestFingerprint(c.Request, body))
+		if errCase != nil {
+			restErr := rest_err.ConvertError(errCase)
+			sendError(c, restErr)
+			c.Abort()
+			return
+		}
+		if replay != nil {
+			for name, value := range replay.Header {
+				c.Header(name, value)
+			}
+			c.Header("Idempotent-Replayed", "true")
+			c.Data(replay.StatusCode, replay.Header["Content-Type"], replay.Body)
+			c.Abort()
+			return
+		}
+
+		release := useCase.Hold(key)
+		defer release()
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		// A response that cannot be stored only leaves the key locked until
+		// the usecase treats it as abandoned.
+		header := make(map[string]string)
+		for _, name := range replayedHeaders {
+			if value := recorder.Header().Get(name); value != "" {
+				header[name] = value
+			}
+		}
+		useCase.Finish(key, recorder.Status(), header, recorder.body.Bytes())
+	}
+}
+
+func requestFingerprint(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	io.WriteString(hash, req.Method+" "+req.URL.RequestURI()+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder keeps a copy of the body written to the client.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/import_usecase"
+)
+
+// DefaultMaxImportSize caps the size of an import file.
+const DefaultMaxImportSize = 10 << 20
+
+type ImportHandler struct {
+	useCase  import_usecase.ImportUsecase
+	maxSize  int64
+	basePath string
+}
+
+func NewImportHandler(useCase import_usecase.ImportUsecase, maxSize int64, basePath string) *ImportHandler {
+	return &ImportHandler{useCase: useCase, maxSize: maxSize, basePath: basePath}
+}
+
+// @BasePath /api/v1
+
+// @Summary Import openings
+// @Description Import job openings from a CSV file with a header row (text/csv) or one JSON opening per line (application/x-ndjson). CSV columns are matched to the fields role, company, location, remote, link and salary by name; use map[field]=Column to read a field from another column. The file is imported in a single transaction: if any row is invalid nothing is stored and the report lists the errors per line. Large files, or any file with async=true, are queued as a job and answered with 202
+// @Tags Openings
+// @Accept text/csv,application/x-ndjson
+// @Produce json
+// @Param dryRun query bool false "Validate the file without storing it"
+// @Param async query bool false "Run the import as a background job"
+// @Param map query object false "CSV column to read for a field, e.g. map[role]=Job Title"
+// @Success 200 {object} ImportOpeningsResponse
+// @Success 202 {object} ImportJobResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Failure 415 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/import [post]
+func (h *ImportHandler) Import(c *gin.Context) {
+	format, ok := importFormat(c.GetHeader("Content-Type"))
+	if !ok {
+		sendError(c, rest_err.NewUnsupportedMediaTypeError("Content-Type must be text/csv or application/x-ndjson"))
+		return
+	}
+
+	dryRun, err := queryBool(c, "dryRun")
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid dryRun value"))
+		return
+	}
+	async, err := queryBool(c, "async")
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid async value"))
+		return
+	}
+
+	// The whole file is read first so a body over the limit is answered
+	// with 413 rather than as a parse error of some row.
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, h.maxSize))
+	if err != nil {
+		sendUploadError(c, err)
+		return
+	}
+
+	report, job, errCase := h.useCase.Import(bytes.NewReader(body), schemas.ImportOptions{
+		Format:  format,
+		Mapping: c.QueryMap("map"),
+		DryRun:  dryRun,
+		Async:   async,
+	})
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	if job != nil {
+		c.Header("Location", fmt.Sprintf("%s/openings/import/jobs/%d", h.basePath, job.ID))
+		c.JSON(http.StatusAccepted, gin.H{
+			"message": "import-openings queued",
+			"data":    job,
+		})
+		return
+	}
+
+	sendSuccess(c, "import-openings", report)
+}
+
+// @BasePath /api/v1
+
+// @Summary Show import job
+// @Description Show the status of an import job and, once completed, its report
+// @Tags Openings
+// @Accept json
+// @Produce json
+// @Param id path int true "Import job Identification"
+// @Success 200 {object} ImportJobResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/import/jobs/{id} [get]
+func (h *ImportHandler) ShowJob(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	job, errCase := h.useCase.GetJob(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "show-import-job", job)
+}
+
+func importFormat(contentType string) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", false
+	}
+	switch mediaType {
+	case "text/csv":
+		return schemas.ImportFormatCSV, true
+	case "application/x-ndjson", "application/ndjson", "application/jsonl":
+		return schemas.ImportFormatNDJSON, true
+	}
+	return "", false
+}
+
+func queryBool(c *gin.Context, key string) (bool, error) {
+	value := c.Query(key)
+	if value == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// jobPostingETag is the entity tag of the JSON-LD representation of an
+// opening version. Pausing the link ends the posting without a new
+// version, so a paused opening gets a tag of its own.
+func jobPostingETag(op *schemas.Opening) string {
+	if op.LinkPaused {
+		return fmt.Sprintf(`"%d-ld-paused"`, op.Version)
+	}
+	return fmt.Sprintf(`"%d-ld"`, op.Version)
+}
+
+// sendJobPosting answers with the schema.org JobPosting of the opening,
+// or 422 when the opening lacks a property indexers require.
+func (h *OpeningHandler) sendJobPosting(c *gin.Context, op *schemas.Opening) {
+	posting := schemas.NewJobPosting(op, h.jobPosting)
+	if err := posting.Validate(); err != nil {
+		sendError(c, rest_err.NewUnprocessableEntityError(err.Error()))
+		return
+	}
+
+	body, err := json.Marshal(posting)
+	if err != nil {
+		sendError(c, rest_err.NewInternalServerError("error encoding job posting"))
+		return
+	}
+	c.Data(http.StatusOK, schemas.JobPostingMediaType+"; charset=utf-8", body)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary List applications
+// @Description Get the applications submitted to a job opening with pagination
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Param page query int false "Page number"
+// @Success 200 {object} ListApplicationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /openings/{id}/applications [get]
+func (h *ApplicationHandler) List(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "0"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid page number"))
+		return
+	}
+
+	applications, errCase := h.useCase.ListByOpening(uint(id), page)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "list-applications", applications)
+}
@@ -1,11 +1,12 @@
 package handler
 
 import (
-	"net/http"
+	"fmt"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
 )
 
 // @BasePath /api/v1
@@ -16,6 +17,12 @@ import (
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number"
+// @Param q query string false "Text to match in the role, company or location"
+// @Param company query string false "Company name"
+// @Param location query string false "Part of the location"
+// @Param remote query bool false "Remote openings only (true) or on-site only (false)"
+// @Param minSalary query int false "Minimum salary"
+// @Param status query string false "open or closed"
 // @Success 200 {object} ListOpeningsResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -23,16 +30,51 @@ import (
 func (h *OpeningHandler) List(c *gin.Context) {
 	page, err := strconv.Atoi(c.DefaultQuery("page", "0"))
 	if err != nil {
-		sendError(c, http.StatusBadRequest, "invalid page number")
+		sendError(c, rest_err.NewBadRequestError("invalid page number"))
 		return
 	}
 
-	openings, errCase := h.useCase.ListOpenings(page)
+	filter, err := openingFilterFromQuery(c)
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	openings, errCase := h.useCase.ListOpenings(filter, page)
 	if errCase != nil {
 		restErr := rest_err.ConvertError(errCase)
-		sendError(c, restErr.Code, errCase.Message)
+		sendError(c, restErr)
 		return
 	}
 
 	sendSuccess(c, "list-openings", openings)
 }
+
+// openingFilterFromQuery reads the listing filters shared by every endpoint
+// that lists openings.
+func openingFilterFromQuery(c *gin.Context) (schemas.OpeningFilter, error) {
+	filter := schemas.OpeningFilter{
+		Query:    c.Query("q"),
+		Company:  c.Query("company"),
+		Location: c.Query("location"),
+		Status:   c.Query("status"),
+	}
+
+	if value := c.Query("remote"); value != "" {
+		remote, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, fmt.Errorf("invalid remote value")
+		}
+		filter.Remote = &remote
+	}
+
+	if value := c.Query("minSalary"); value != "" {
+		minSalary, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return filter, fmt.Errorf("invalid minSalary value")
+		}
+		filter.MinSalary = minSalary
+	}
+
+	return filter, filter.Validate()
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary List webhooks
+// @Description List the registered webhooks
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Success 200 {object} ListWebhooksResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks [get]
+func (h *WebhookHandler) List(c *gin.Context) {
+	webhooks, errCase := h.useCase.List()
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "list-webhooks", webhooks)
+}
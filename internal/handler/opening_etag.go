@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// openingETag is the strong entity tag of an opening: its version followed
+// by a hash of the stage counts and the link check fields, which change
+// without a new version.
+func openingETag(op *schemas.Opening) string {
+	hash := fnv.New64a()
+	json.NewEncoder(hash).Encode([]interface{}{
+		op.StageCounts,
+		op.LinkStatus,
+		op.LinkRedirect,
+		op.LinkError,
+		op.LinkCheckedAt,
+		op.LinkFailures,
+		op.LinkPaused,
+	})
+	return fmt.Sprintf(`"%d-%x"`, op.Version, hash.Sum64())
+}
+
+// precondition reads the If-Match header of a write to an opening. Without
+// the header, or with "*", the write is unconditional. Weak tags never
+// match, since If-Match uses the strong comparison. Only the version of a
+// tag is compared, because writes do not touch the rest of what it hashes.
+// It returns false after answering 428 when the header is required but
+// missing.
+func (h *OpeningHandler) precondition(c *gin.Context) (*schemas.Precondition, bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		if h.ifMatchRequired {
+			sendError(c, rest_err.NewPreconditionRequiredError("If-Match header is required"))
+			return nil, false
+		}
+		return nil, true
+	}
+	if header == "*" {
+		return nil, true
+	}
+
+	precondition := &schemas.Precondition{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+			continue
+		}
+		version, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+		if version, err := strconv.ParseUint(version, 10, 64); err == nil {
+			precondition.Versions = append(precondition.Versions, uint(version))
+		}
+	}
+	return precondition, true
+}
+
+// notModified reports whether the If-None-Match header lists etag, using
+// the weak comparison.
+func notModified(c *gin.Context, etag string) bool {
+	for _, tag := range strings.Split(c.GetHeader("If-None-Match"), ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/feed"
+)
+
+// feedSize is how many of the newest openings a feed lists.
+const feedSize = 50
+
+// FeedHandler serves the openings as RSS, Atom and JSON feeds. The feeds
+// live outside the API base path, so they are left out of the Swagger docs.
+type FeedHandler struct {
+	useCase opening_usecase.OpeningUsecase
+	baseURL string
+	origin  string
+}
+
+// NewFeedHandler returns a FeedHandler whose item IDs are the API URLs of
+// the openings under baseURL. The feeds link to themselves on the scheme
+// and host of baseURL.
+func NewFeedHandler(useCase opening_usecase.OpeningUsecase, baseURL string) *FeedHandler {
+	h := &FeedHandler{useCase: useCase, baseURL: strings.TrimSuffix(baseURL, "/")}
+	if u, err := url.Parse(h.baseURL); err == nil {
+		h.origin = u.Scheme + "://" + u.Host
+	}
+	return h
+}
+
+// RSS serves GET /feeds/openings.rss.
+func (h *FeedHandler) RSS(c *gin.Context) {
+	h.serve(c, feed.FormatRSS)
+}
+
+// Atom serves GET /feeds/openings.atom.
+func (h *FeedHandler) Atom(c *gin.Context) {
+	h.serve(c, feed.FormatAtom)
+}
+
+// JSON serves GET /feeds/openings.json.
+func (h *FeedHandler) JSON(c *gin.Context) {
+	h.serve(c, feed.FormatJSON)
+}
+
+func (h *FeedHandler) serve(c *gin.Context, format string) {
+	filter, err := openingFilterFromQuery(c)
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	openings, errCase := h.useCase.Latest(filter, feedSize)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	f := h.openingsFeed(c, openings)
+	etag := feedETag(format, openings)
+	c.Header("ETag", etag)
+	if !f.Updated.IsZero() {
+		c.Header("Last-Modified", f.Updated.UTC().Format(http.TimeFormat))
+	}
+	if notModified(c, etag) || notModifiedSince(c, f.Updated) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	var body bytes.Buffer
+	if err := feed.Write(&body, format, f); err != nil {
+		sendError(c, rest_err.NewInternalServerError("error writing feed"))
+		return
+	}
+	c.Data(http.StatusOK, feed.ContentType(format), body.Bytes())
+}
+
+func (h *FeedHandler) openingsFeed(c *gin.Context, openings []schemas.Opening) feed.Feed {
+	f := feed.Feed{
+		Title:       "Job openings",
+		Description: "The newest job openings on go-opportunities",
+		Link:        h.baseURL + "/openings",
+		FeedURL:     h.origin + c.Request.URL.RequestURI(),
+		Author:      "go-opportunities",
+		Items:       make([]feed.Item, len(openings)),
+	}
+	for i, o := range openings {
+		if o.UpdatedAt.After(f.Updated) {
+			f.Updated = o.UpdatedAt
+		}
+		f.Items[i] = feed.Item{
+			ID:        fmt.Sprintf("%s/openings/%d", h.baseURL, o.ID),
+			Title:     fmt.Sprintf("%s at %s", o.Role, o.Company),
+			Link:      o.Link,
+			Content:   feedItemContent(o),
+			Published: o.CreatedAt,
+			Updated:   o.UpdatedAt,
+		}
+	}
+	return f
+}
+
+func feedItemContent(o schemas.Opening) string {
+	workplace := "on-site"
+	if o.Remote {
+		workplace = "remote"
+	}
+	status := o.Status
+	if status == "" {
+		status = schemas.OpeningStatusOpen
+	}
+	return fmt.Sprintf("%s is hiring a %s in %s (%s). Salary: %d. Status: %s.", o.Company, o.Role, o.Location, workplace, o.Salary, status)
+}
+
+// feedETag changes whenever an opening enters, leaves or changes within the
+// feed, which the newest UpdatedAt alone would miss for removals.
+func feedETag(format string, openings []schemas.Opening) string {
+	hash := sha256.New()
+	hash.Write([]byte(format))
+	for _, o := range openings {
+		fmt.Fprintf(hash, "|%d:%d:%d", o.ID, o.Version, o.UpdatedAt.UnixNano())
+	}
+	return `"` + hex.EncodeToString(hash.Sum(nil))[:32] + `"`
+}
+
+// notModifiedSince reports whether If-Modified-Since is at or after
+// lastModified. It is ignored when the request also sends If-None-Match.
+func notModifiedSince(c *gin.Context, lastModified time.Time) bool {
+	if c.GetHeader("If-None-Match") != "" || lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(c.GetHeader("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(since)
+}
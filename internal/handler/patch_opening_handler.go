@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// @BasePath /api/v1
+
+// @Summary Patch opening
+// @Description Change a job opening with a JSON Merge Patch (RFC 7396), where null clears a field, or a JSON Patch (RFC 6902). The patched opening must pass the same validation as a new one
+// @Tags Openings
+// @Accept application/merge-patch+json
+// @Accept application/json-patch+json
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Param patch body schemas.OpeningDocument true "Merge patch, or an array of JSON Patch operations"
+// @Param If-Match header string false "ETag the patch is based on"
+// @Success 200 {object} PatchOpeningResponse
+// @Header 200 {string} ETag "Version of the patched opening"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 415 {object} ErrorResponse
+// @Failure 428 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/{id} [patch]
+func (h *OpeningHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if mediaType != schemas.MergePatchMediaType && mediaType != schemas.JSONPatchMediaType {
+		c.Header("Accept-Patch", schemas.MergePatchMediaType+", "+schemas.JSONPatchMediaType)
+		sendError(c, rest_err.NewUnsupportedMediaTypeError(fmt.Sprintf("Content-Type must be %s or %s", schemas.MergePatchMediaType, schemas.JSONPatchMediaType)))
+		return
+	}
+
+	precondition, ok := h.precondition(c)
+	if !ok {
+		return
+	}
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("error reading the request body"))
+		return
+	}
+
+	opening, errCase := h.useCase.Patch(uint(id), mediaType, patch, precondition)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	c.Header("ETag", openingETag(opening))
+	sendSuccess(c, fmt.Sprintf("opening with id: %d updated", id), opening)
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/pipeline_usecase"
+)
+
+type PipelineHandler struct {
+	useCase pipeline_usecase.PipelineUsecase
+}
+
+func NewPipelineHandler(useCase pipeline_usecase.PipelineUsecase) *PipelineHandler {
+	return &PipelineHandler{useCase: useCase}
+}
+
+// @BasePath /api/v1
+
+// @Summary Show opening pipeline
+// @Description Show the hiring pipeline stages used by a job opening
+// @Tags Pipelines
+// @Accept json
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Success 200 {object} ShowPipelineResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /openings/{id}/pipeline [get]
+func (h *PipelineHandler) ShowOpeningPipeline(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	pipeline, errCase := h.useCase.GetForOpening(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "show-pipeline", pipeline)
+}
+
+// @BasePath /api/v1
+
+// @Summary Define opening pipeline
+// @Description Define the hiring pipeline stages of a job opening
+// @Tags Pipelines
+// @Accept json
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Param request body schemas.DefinePipelineRequest true "Ordered stages"
+// @Success 200 {object} DefinePipelineResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/{id}/pipeline [put]
+func (h *PipelineHandler) DefineOpeningPipeline(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	var req schemas.DefinePipelineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	errCase := h.useCase.DefineForOpening(uint(id), req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, fmt.Sprintf("pipeline of opening with id: %d defined", id), nil)
+}
+
+// @BasePath /api/v1
+
+// @Summary Define company pipeline
+// @Description Define the hiring pipeline stages used by every opening of a company without its own pipeline
+// @Tags Pipelines
+// @Accept json
+// @Produce json
+// @Param company path string true "Company name"
+// @Param request body schemas.DefinePipelineRequest true "Ordered stages"
+// @Success 200 {object} DefinePipelineResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /companies/{company}/pipeline [put]
+func (h *PipelineHandler) DefineCompanyPipeline(c *gin.Context) {
+	company := c.Param("company")
+
+	var req schemas.DefinePipelineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	errCase := h.useCase.DefineForCompany(company, req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, fmt.Sprintf("pipeline of company %s defined", company), nil)
+}
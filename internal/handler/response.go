@@ -3,17 +3,16 @@ package handler
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
 )
 
-func sendError(ctx *gin.Context, code int, msg string) {
+func sendError(ctx *gin.Context, err *rest_err.RestErr) {
 	ctx.Header("Content-type", "application/json")
-	ctx.JSON(code, gin.H{
-		"message":   msg,
-		"errorCode": code,
-	})
+	ctx.JSON(err.Code, err)
 }
 
 func sendSuccess(ctx *gin.Context, op string, data interface{}) {
@@ -25,12 +24,34 @@ func sendSuccess(ctx *gin.Context, op string, data interface{}) {
 }
 
 type ErrorResponse struct {
-	Message   string `json:"message"`
-	ErrorCode int    `json:"errorCode"`
+	Message   string            `json:"message"`
+	Err       string            `json:"err"`
+	ErrorCode int               `json:"errorCode"`
+	Causes    []rest_err.Causes `json:"causes,omitempty"`
 }
 
 type CreateOpeningResponse struct {
 	Message string `json:"message"`
+	// DuplicateOf is set when the opening was flagged as a likely duplicate.
+	DuplicateOf uint `json:"duplicateOf,omitempty"`
+}
+
+// DuplicateOpeningErrorResponse is sent when an opening is rejected as a
+// duplicate of the opening DuplicateOf.
+type DuplicateOpeningErrorResponse struct {
+	Message     string `json:"message"`
+	Err         string `json:"err"`
+	ErrorCode   int    `json:"errorCode"`
+	DuplicateOf uint   `json:"duplicateOf"`
+}
+
+type DuplicateClusterData struct {
+	Openings []schemas.OpeningResponse `json:"openings"`
+}
+
+type ListDuplicateClustersResponse struct {
+	Message string                 `json:"message"`
+	Data    []DuplicateClusterData `json:"data"`
 }
 
 type DeleteOpeningResponse struct {
@@ -50,3 +71,168 @@ type ListOpeningsResponse struct {
 type UpdateOpeningResponse struct {
 	Message string `json:"message"`
 }
+
+type PatchOpeningResponse struct {
+	Message string                  `json:"message"`
+	Data    schemas.OpeningResponse `json:"data"`
+}
+
+type OpeningRevisionData struct {
+	OpeningID uint                    `json:"openingId"`
+	Revision  uint                    `json:"revision"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Opening   schemas.OpeningResponse `json:"opening"`
+}
+
+type ShowOpeningRevisionResponse struct {
+	Message string              `json:"message"`
+	Data    OpeningRevisionData `json:"data"`
+}
+
+type RestoreOpeningRevisionResponse struct {
+	Message string `json:"message"`
+}
+
+type CreateApplicationResponse struct {
+	Message string `json:"message"`
+}
+
+type ShowApplicationResponse struct {
+	Message string                      `json:"message"`
+	Data    schemas.ApplicationResponse `json:"data"`
+}
+
+type ListApplicationsResponse struct {
+	Message string                        `json:"message"`
+	Data    []schemas.ApplicationResponse `json:"data"`
+}
+
+type CreateCandidateProfileResponse struct {
+	Message string `json:"message"`
+}
+
+type ShowCandidateProfileResponse struct {
+	Message string                           `json:"message"`
+	Data    schemas.CandidateProfileResponse `json:"data"`
+}
+
+type ResumeURLResponse struct {
+	Message string                    `json:"message"`
+	Data    schemas.ResumeURLResponse `json:"data"`
+}
+
+type ShowPipelineResponse struct {
+	Message string                   `json:"message"`
+	Data    schemas.PipelineResponse `json:"data"`
+}
+
+type DefinePipelineResponse struct {
+	Message string `json:"message"`
+}
+
+type TransitionApplicationResponse struct {
+	Message string `json:"message"`
+}
+
+type ApplicationHistoryResponse struct {
+	Message string                                   `json:"message"`
+	Data    []schemas.ApplicationStageChangeResponse `json:"data"`
+}
+
+type CreateJobAlertResponse struct {
+	Message string `json:"message"`
+}
+
+type ConfirmJobAlertResponse struct {
+	Message string                   `json:"message"`
+	Data    schemas.JobAlertResponse `json:"data"`
+}
+
+type UnsubscribeJobAlertResponse struct {
+	Message string `json:"message"`
+}
+
+type CreateDigestSubscriptionResponse struct {
+	Message string `json:"message"`
+}
+
+type ConfirmDigestSubscriptionResponse struct {
+	Message string                             `json:"message"`
+	Data    schemas.DigestSubscriptionResponse `json:"data"`
+}
+
+type UnsubscribeDigestResponse struct {
+	Message string `json:"message"`
+}
+
+type CreateWebhookResponse struct {
+	Message string                  `json:"message"`
+	Data    schemas.WebhookResponse `json:"data"`
+}
+
+type ListWebhooksResponse struct {
+	Message string                    `json:"message"`
+	Data    []schemas.WebhookResponse `json:"data"`
+}
+
+type DeleteWebhookResponse struct {
+	Message string `json:"message"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Message string                            `json:"message"`
+	Data    []schemas.WebhookDeliveryResponse `json:"data"`
+}
+
+type RedeliverWebhookResponse struct {
+	Message string                          `json:"message"`
+	Data    schemas.WebhookDeliveryResponse `json:"data"`
+}
+
+type OpeningStreamEvent struct {
+	ID         string                  `json:"id"`
+	Type       string                  `json:"type"`
+	OccurredAt time.Time               `json:"occurredAt"`
+	Data       schemas.OpeningResponse `json:"data"`
+}
+
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+type GraphQLResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []GraphQLError         `json:"errors,omitempty"`
+}
+
+type BulkOpeningResult struct {
+	Index   int               `json:"index"`
+	Op      string            `json:"op"`
+	ID      uint              `json:"id,omitempty"`
+	Status  int               `json:"status"`
+	Message string            `json:"message,omitempty"`
+	Causes  []rest_err.Causes `json:"causes,omitempty"`
+}
+
+type BulkOpeningData struct {
+	Applied int                 `json:"applied"`
+	Failed  int                 `json:"failed"`
+	Results []BulkOpeningResult `json:"results"`
+}
+
+type BulkOpeningResponse struct {
+	Message string          `json:"message"`
+	Data    BulkOpeningData `json:"data"`
+}
+
+type ImportOpeningsResponse struct {
+	Message string               `json:"message"`
+	Data    schemas.ImportReport `json:"data"`
+}
+
+type ImportJobResponse struct {
+	Message string                    `json:"message"`
+	Data    schemas.ImportJobResponse `json:"data"`
+}
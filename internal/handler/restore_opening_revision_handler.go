@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary Restore opening revision
+// @Description Restore a job opening to the given revision, recording the result as a new revision
+// @Tags Openings
+// @Accept json
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} RestoreOpeningRevisionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /openings/{id}/revisions/{rev}/restore [post]
+func (h *OpeningHandler) RestoreRevision(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	rev, err := strconv.Atoi(c.Param("rev"))
+	if err != nil || rev <= 0 {
+		sendError(c, rest_err.NewBadRequestError("invalid revision"))
+		return
+	}
+
+	errCase := h.useCase.RestoreRevision(uint(id), uint(rev))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, fmt.Sprintf("opening with id: %d restored to revision %d", id, rev), nil)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary Show application
+// @Description Show a candidate application
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application Identification"
+// @Success 200 {object} ShowApplicationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id} [get]
+func (h *ApplicationHandler) ShowApplication(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	application, errCase := h.useCase.GetByID(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "show-application", application)
+}
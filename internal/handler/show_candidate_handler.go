@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary Show candidate profile
+// @Description Show a candidate profile
+// @Tags Candidates
+// @Accept json
+// @Produce json
+// @Param id path int true "Candidate profile Identification"
+// @Success 200 {object} ShowCandidateProfileResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /candidates/{id} [get]
+func (h *CandidateHandler) ShowCandidate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	profile, errCase := h.useCase.GetByID(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "show-candidate", profile)
+}
+
+// @BasePath /api/v1
+
+// @Summary Résumé download URL
+// @Description Get a signed, expiring URL to download the candidate résumé
+// @Tags Candidates
+// @Accept json
+// @Produce json
+// @Param id path int true "Candidate profile Identification"
+// @Success 200 {object} ResumeURLResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id}/resume [get]
+func (h *CandidateHandler) ResumeURL(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	signed, errCase := h.useCase.ResumeURL(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "resume-url", signed)
+}
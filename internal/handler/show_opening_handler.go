@@ -6,33 +6,56 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
 )
 
 // @BasePath /api/v1
 
 // @Summary Show opening
-// @Description Show a job opening
+// @Description Show a job opening. With Accept: application/ld+json the opening is answered as a schema.org JobPosting for search engines
 // @Tags Openings
 // @Accept json
-// @Produce json
+// @Produce json,application/ld+json
 // @Param id path int true "Opening Identification"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} ShowOpeningResponse
+// @Header 200 {string} ETag "Version and stage counts of the opening"
+// @Success 304 "Not modified"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Router /openings/{id} [get]
 func (h *OpeningHandler) ShowOpening(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		sendError(c, http.StatusBadRequest, "invalid ID")
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
 		return
 	}
 
 	op, errCase := h.useCase.GetByID(uint(id))
 	if errCase != nil {
 		restErr := rest_err.ConvertError(errCase)
-		sendError(c, restErr.Code, restErr.Message)
+		sendError(c, restErr)
 		return
 	}
 
+	jsonLD := c.NegotiateFormat(gin.MIMEJSON, schemas.JobPostingMediaType) == schemas.JobPostingMediaType
+
+	// Each representation gets its own tag, so a cache holding one is not
+	// revalidated with the other.
+	etag := openingETag(op)
+	if jsonLD {
+		etag = jobPostingETag(op)
+	}
+	c.Header("ETag", etag)
+	c.Header("Vary", "Accept")
+	if notModified(c, etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	if jsonLD {
+		h.sendJobPosting(c, op)
+		return
+	}
 	sendSuccess(c, "show-opening", op)
 }
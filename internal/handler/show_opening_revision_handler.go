@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary Show opening revision
+// @Description Show a job opening as it was stored in the given revision
+// @Tags Openings
+// @Accept json
+// @Produce json
+// @Param id path int true "Opening Identification"
+// @Param rev path int true "Revision number"
+// @Success 200 {object} ShowOpeningRevisionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /openings/{id}/revisions/{rev} [get]
+func (h *OpeningHandler) ShowRevision(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	rev, err := strconv.Atoi(c.Param("rev"))
+	if err != nil || rev <= 0 {
+		sendError(c, rest_err.NewBadRequestError("invalid revision"))
+		return
+	}
+
+	revision, errCase := h.useCase.GetRevision(uint(id), uint(rev))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "show-opening-revision", revision)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/sitemap_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+// SitemapHandler serves /sitemap.xml, its pages and /robots.txt. Like the
+// feeds, they live outside the API base path.
+type SitemapHandler struct {
+	useCase sitemap_usecase.SitemapUsecase
+}
+
+func NewSitemapHandler(useCase sitemap_usecase.SitemapUsecase) *SitemapHandler {
+	return &SitemapHandler{useCase: useCase}
+}
+
+// Index serves GET /sitemap.xml.
+func (h *SitemapHandler) Index(c *gin.Context) {
+	file, errCase := h.useCase.Index()
+	sendSitemap(c, file, errCase)
+}
+
+// Page serves GET /sitemaps/openings-:n.xml.
+func (h *SitemapHandler) Page(c *gin.Context) {
+	name := c.Param("file")
+	if !strings.HasPrefix(name, "openings-") || !strings.HasSuffix(name, ".xml") {
+		sendError(c, rest_err.NewNotFoundError("sitemap not found"))
+		return
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "openings-"), ".xml"))
+	if err != nil {
+		sendError(c, rest_err.NewNotFoundError("sitemap not found"))
+		return
+	}
+
+	file, errCase := h.useCase.Page(n)
+	sendSitemap(c, file, errCase)
+}
+
+// Robots serves GET /robots.txt.
+func (h *SitemapHandler) Robots(c *gin.Context) {
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", h.useCase.Robots())
+}
+
+func sendSitemap(c *gin.Context, file *sitemap_usecase.File, errCase *internal_error.InternalError) {
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	c.Header("ETag", file.ETag)
+	if !file.LastModified.IsZero() {
+		c.Header("Last-Modified", file.LastModified.UTC().Format(http.TimeFormat))
+	}
+	if notModified(c, file.ETag) || notModifiedSince(c, file.LastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", file.Body)
+}
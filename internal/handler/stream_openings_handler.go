@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+)
+
+type OpeningStreamHandler struct {
+	broker    *events.Broker
+	heartbeat time.Duration
+}
+
+func NewOpeningStreamHandler(broker *events.Broker, heartbeat time.Duration) *OpeningStreamHandler {
+	return &OpeningStreamHandler{broker: broker, heartbeat: heartbeat}
+}
+
+// @BasePath /api/v1
+
+// @Summary Stream opening changes
+// @Description Server-Sent Events stream of opening.created, opening.updated and opening.deleted events matching the listing filters. Send Last-Event-ID to resume; a reset event means some events were missed and the listing should be reloaded
+// @Tags Openings
+// @Produce text/event-stream
+// @Param Last-Event-ID header string false "ID of the last event received"
+// @Param q query string false "Text to match in the role, company or location"
+// @Param company query string false "Company name"
+// @Param location query string false "Part of the location"
+// @Param remote query bool false "Remote openings only (true) or on-site only (false)"
+// @Param minSalary query int false "Minimum salary"
+// @Param status query string false "open or closed"
+// @Success 200 {object} OpeningStreamEvent
+// @Failure 400 {object} ErrorResponse
+// @Router /openings/stream [get]
+func (h *OpeningStreamHandler) Stream(c *gin.Context) {
+	filter, err := openingFilterFromQuery(c)
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	sub, replay, ok := h.broker.Subscribe(c.GetHeader("Last-Event-ID"))
+	defer h.broker.Unsubscribe(sub)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	w := c.Writer
+	fmt.Fprint(w, ": connected\n\n")
+	if !ok {
+		fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+	}
+	for _, event := range replay {
+		if err := writeOpeningEvent(w, filter, event); err != nil {
+			return
+		}
+	}
+	w.Flush()
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, open := <-sub.Events:
+			// A closed subscription fell behind; the client resumes from
+			// the last event it received.
+			if !open {
+				return
+			}
+			if err := writeOpeningEvent(w, filter, event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		w.Flush()
+	}
+}
+
+func writeOpeningEvent(w io.Writer, filter schemas.OpeningFilter, event schemas.OpeningEvent) error {
+	if !filter.Matches(&event.Opening) {
+		return nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+// @BasePath /api/v1
+
+// @Summary Move application
+// @Description Move an application to another stage of its opening pipeline
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application Identification"
+// @Param request body schemas.TransitionApplicationRequest true "Target stage"
+// @Success 200 {object} TransitionApplicationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /applications/{id}/transitions [post]
+func (h *ApplicationHandler) Transition(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	var req schemas.TransitionApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
+		return
+	}
+
+	errCase := h.useCase.Transition(uint(id), req)
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, fmt.Sprintf("application with id: %d moved to %s", id, req.Stage), nil)
+}
+
+// @BasePath /api/v1
+
+// @Summary Application history
+// @Description List every stage change of an application
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application Identification"
+// @Success 200 {object} ApplicationHistoryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/history [get]
+func (h *ApplicationHandler) History(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	history, errCase := h.useCase.History(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "application-history", history)
+}
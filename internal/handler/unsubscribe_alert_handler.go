@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary Unsubscribe from job alert
+// @Description One-click unsubscribe with the token sent in every alert email. POST is accepted for List-Unsubscribe-Post (RFC 8058)
+// @Tags Alerts
+// @Produce json
+// @Param token query string true "Unsubscribe token"
+// @Success 200 {object} UnsubscribeJobAlertResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /alerts/unsubscribe [get]
+// @Router /alerts/unsubscribe [post]
+func (h *AlertHandler) Unsubscribe(c *gin.Context) {
+	errCase := h.useCase.Unsubscribe(c.Query("token"))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "unsubscribe-alert", nil)
+}
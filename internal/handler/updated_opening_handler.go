@@ -2,7 +2,6 @@ package handler
 
 import (
 	"fmt"
-	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -19,27 +18,36 @@ import (
 // @Produce json
 // @Param id path int true "Opening Identification"
 // @Param opening body schemas.UpdateOpeningRequest true "Opening data to Update"
+// @Param If-Match header string false "ETag the update is based on"
 // @Success 200 {object} UpdateOpeningResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 428 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /openings/{id} [put]
 func (h *OpeningHandler) Update(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		sendError(c, http.StatusBadRequest, "invalid ID")
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	precondition, ok := h.precondition(c)
+	if !ok {
 		return
 	}
 
 	var req schemas.UpdateOpeningRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		sendError(c, http.StatusBadRequest, err.Error())
+		sendError(c, rest_err.NewBadRequestError(err.Error()))
 		return
 	}
-	errCase := h.useCase.Update(uint(id), req)
+	errCase := h.useCase.Update(uint(id), req, precondition)
 	if errCase != nil {
 		rest_err := rest_err.ConvertError(errCase)
-		sendError(c, rest_err.Code, rest_err.Message)
+		sendError(c, rest_err)
 		return
 	}
 
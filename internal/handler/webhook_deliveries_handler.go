@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+)
+
+// @BasePath /api/v1
+
+// @Summary List webhook deliveries
+// @Description Show the latest deliveries of a webhook with every attempt made
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Identification"
+// @Success 200 {object} ListWebhookDeliveriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) Deliveries(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	deliveries, errCase := h.useCase.Deliveries(uint(id))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "list-webhook-deliveries", deliveries)
+}
+
+// @BasePath /api/v1
+
+// @Summary Redeliver webhook delivery
+// @Description Queue a delivery to be sent again with a fresh set of attempts
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook Identification"
+// @Param deliveryId path int true "Delivery Identification"
+// @Success 200 {object} RedeliverWebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid ID"))
+		return
+	}
+
+	deliveryID, err := strconv.Atoi(c.Param("deliveryId"))
+	if err != nil {
+		sendError(c, rest_err.NewBadRequestError("invalid delivery ID"))
+		return
+	}
+
+	delivery, errCase := h.useCase.Redeliver(uint(id), uint(deliveryID))
+	if errCase != nil {
+		restErr := rest_err.ConvertError(errCase)
+		sendError(c, restErr)
+		return
+	}
+
+	sendSuccess(c, "redeliver-webhook", delivery)
+}
@@ -3,6 +3,13 @@ package internal_error
 type InternalError struct {
 	Message string
 	Err     string
+	Causes  []Cause
+}
+
+// Cause is the reason a single field of a request was rejected.
+type Cause struct {
+	Field   string
+	Message string
 }
 
 func (ie *InternalError) Error() string {
@@ -23,9 +30,31 @@ func NewInternalServerError(message string) *InternalError {
 	}
 }
 
-func NewBadRequestError(message string) *InternalError {
+func NewBadRequestError(message string, causes ...Cause) *InternalError {
 	return &InternalError{
 		Message: message,
 		Err:     "bad_request",
+		Causes:  causes,
+	}
+}
+
+func NewConflictError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "conflict",
+	}
+}
+
+func NewPreconditionFailedError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "precondition_failed",
+	}
+}
+
+func NewUnprocessableEntityError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "unprocessable_entity",
 	}
 }
@@ -0,0 +1,93 @@
+// Package links validates and normalizes the links of openings.
+package links
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+var (
+	ErrNotAbsolute = errors.New("link must be an absolute http or https URL")
+	ErrCredentials = errors.New("link must not contain credentials")
+)
+
+// clickParams are tracking parameters that never change the page a link
+// opens, so Normalize drops them along with "utm_*".
+var clickParams = map[string]bool{
+	"fbclid": true, "gclid": true, "mc_cid": true, "mc_eid": true,
+}
+
+// trackingParams are further query parameters that usually only say where
+// a link was shared. Some sites do read them, so they are kept in stored
+// links and only ignored when comparing them.
+var trackingParams = map[string]bool{
+	"gh_src": true, "lever-source": true, "ref": true, "source": true,
+	"src": true, "trk": true, "trackingid": true,
+}
+
+// IsTrackingParam reports whether the query parameter name is one of the
+// known tracking parameters, or starts with "utm_".
+func IsTrackingParam(name string) bool {
+	name = strings.ToLower(name)
+	return trackingParams[name] || isClickParam(name)
+}
+
+func isClickParam(name string) bool {
+	name = strings.ToLower(name)
+	return clickParams[name] || strings.HasPrefix(name, "utm_")
+}
+
+// Normalize checks that link is an absolute http or https URL and returns
+// it with the scheme and host lowercased and the fragment, "utm_*" and
+// click id parameters removed. The other parameters keep their order.
+func Normalize(link string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil || !u.IsAbs() || u.Opaque != "" || u.Hostname() == "" {
+		return "", ErrNotAbsolute
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", ErrNotAbsolute
+	}
+	if u.User != nil {
+		return "", ErrCredentials
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	var kept []string
+	for _, param := range strings.Split(u.RawQuery, "&") {
+		name, _, _ := strings.Cut(param, "=")
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if param != "" && !isClickParam(name) {
+			kept = append(kept, param)
+		}
+	}
+	u.RawQuery = strings.Join(kept, "&")
+	u.ForceQuery = false
+
+	return u.String(), nil
+}
+
+// Denied reports whether the host of link is one of hosts, or a subdomain
+// of one of them.
+func Denied(link string, hosts []string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	for _, denied := range hosts {
+		if host == denied || strings.HasSuffix(host, "."+denied) {
+			return true
+		}
+	}
+	return false
+}
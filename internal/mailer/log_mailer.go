@@ -0,0 +1,18 @@
+package mailer
+
+import "strings"
+
+// LogMailer only logs messages. It is used when no SMTP server is
+// configured so that local development does not need one.
+type LogMailer struct {
+	logf func(format string, v ...interface{})
+}
+
+func NewLogMailer(logf func(format string, v ...interface{})) *LogMailer {
+	return &LogMailer{logf: logf}
+}
+
+func (m *LogMailer) Send(msg Message) error {
+	m.logf("email to %s: %s\n%s", strings.Join(msg.To, ", "), msg.Subject, msg.Text)
+	return nil
+}
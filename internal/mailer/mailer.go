@@ -0,0 +1,17 @@
+package mailer
+
+// Message is an email ready to be delivered. When HTML is set the message
+// is sent as multipart/alternative with Text as the plain-text part.
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+	HTML    string
+	// Headers are added verbatim, e.g. List-Unsubscribe, and replace the
+	// default ones such as Message-ID.
+	Headers map[string]string
+}
+
+type Mailer interface {
+	Send(msg Message) error
+}
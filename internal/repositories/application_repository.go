@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type ApplicationRepository interface {
+	Create(application schemas.Application) error
+	FindByID(id uint) (*schemas.Application, error)
+	FindAllByOpeningID(openingID uint, limit, offset int) ([]schemas.Application, error)
+	UpdateStage(application schemas.Application, change schemas.ApplicationStageChange) error
+	FindHistory(applicationID uint) ([]schemas.ApplicationStageChange, error)
+	CountByStage(openingID uint) (map[string]int64, error)
+	FindStagesInUse(openingID uint, company string) ([]string, error)
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+type ApplicationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewApplicationRepository(db *gorm.DB) ApplicationRepository {
+	return &ApplicationRepositoryImpl{db: db}
+}
+
+func (r *ApplicationRepositoryImpl) Create(application schemas.Application) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&application).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&schemas.ApplicationStageChange{
+			ApplicationID: application.ID,
+			ToStage:       application.Status,
+			ChangedAt:     application.CreatedAt,
+		}).Error
+	})
+}
+
+func (r *ApplicationRepositoryImpl) FindByID(id uint) (*schemas.Application, error) {
+	var application schemas.Application
+	if err := r.db.First(&application, id).Error; err != nil {
+		return nil, err
+	}
+	return &application, nil
+}
+
+func (r *ApplicationRepositoryImpl) FindAllByOpeningID(openingID uint, limit, offset int) ([]schemas.Application, error) {
+	var applications []schemas.Application
+
+	err := r.db.Where("opening_id = ?", openingID).
+		Order("id").
+		Limit(limit).
+		Offset(offset).
+		Find(&applications).Error
+	if err != nil {
+		return nil, err
+	}
+	return applications, nil
+}
+
+// UpdateStage moves the application to change.ToStage and records the change
+// in the stage history within the same transaction.
+func (r *ApplicationRepositoryImpl) UpdateStage(application schemas.Application, change schemas.ApplicationStageChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&schemas.Application{}).
+			Where("id = ?", application.ID).
+			Updates(map[string]interface{}{
+				"status":           change.ToStage,
+				"stage_changed_at": change.ChangedAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		change.ApplicationID = application.ID
+		return tx.Create(&change).Error
+	})
+}
+
+func (r *ApplicationRepositoryImpl) FindHistory(applicationID uint) ([]schemas.ApplicationStageChange, error) {
+	var history []schemas.ApplicationStageChange
+
+	err := r.db.Where("application_id = ?", applicationID).
+		Order("changed_at, id").
+		Find(&history).Error
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (r *ApplicationRepositoryImpl) CountByStage(openingID uint) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Total  int64
+	}
+
+	err := r.db.Model(&schemas.Application{}).
+		Select("status, COUNT(*) AS total").
+		Where("opening_id = ?", openingID).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Total
+	}
+	return counts, nil
+}
+
+// FindStagesInUse lists the stages currently held by applications of the
+// opening or, when openingID is zero, of every opening of the company.
+func (r *ApplicationRepositoryImpl) FindStagesInUse(openingID uint, company string) ([]string, error) {
+	query := r.db.Model(&schemas.Application{}).Distinct("applications.status")
+	if openingID != 0 {
+		query = query.Where("applications.opening_id = ?", openingID)
+	} else {
+		query = query.
+			Joins("JOIN openings ON openings.id = applications.opening_id AND openings.deleted_at IS NULL").
+			Where("openings.company = ?", company)
+	}
+
+	var stages []string
+	if err := query.Pluck("applications.status", &stages).Error; err != nil {
+		return nil, err
+	}
+	return stages, nil
+}
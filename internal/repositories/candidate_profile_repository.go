@@ -0,0 +1,10 @@
+package repositories
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type CandidateProfileRepository interface {
+	Create(profile schemas.CandidateProfile) error
+	FindByID(id uint) (*schemas.CandidateProfile, error)
+}
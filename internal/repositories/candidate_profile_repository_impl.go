@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+type CandidateProfileRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCandidateProfileRepository(db *gorm.DB) CandidateProfileRepository {
+	return &CandidateProfileRepositoryImpl{db: db}
+}
+
+func (r *CandidateProfileRepositoryImpl) Create(profile schemas.CandidateProfile) error {
+	return r.db.Create(&profile).Error
+}
+
+func (r *CandidateProfileRepositoryImpl) FindByID(id uint) (*schemas.CandidateProfile, error) {
+	var profile schemas.CandidateProfile
+	if err := r.db.First(&profile, id).Error; err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
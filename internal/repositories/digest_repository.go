@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type DigestRepository interface {
+	Create(subscription schemas.DigestSubscription) error
+	Update(subscription schemas.DigestSubscription) error
+	FindByConfirmToken(token string) (*schemas.DigestSubscription, error)
+	FindByUnsubscribeToken(token string) (*schemas.DigestSubscription, error)
+	FindDue(now time.Time) ([]schemas.DigestSubscription, error)
+	StartDelivery(delivery schemas.DigestDelivery) (*schemas.DigestDelivery, error)
+	UpdateDelivery(delivery schemas.DigestDelivery) error
+	CompleteDelivery(delivery schemas.DigestDelivery, subscription schemas.DigestSubscription) error
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+type DigestRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewDigestRepository(db *gorm.DB) DigestRepository {
+	return &DigestRepositoryImpl{db: db}
+}
+
+func (r *DigestRepositoryImpl) Create(subscription schemas.DigestSubscription) error {
+	return r.db.Create(&subscription).Error
+}
+
+func (r *DigestRepositoryImpl) Update(subscription schemas.DigestSubscription) error {
+	return r.db.Save(&subscription).Error
+}
+
+func (r *DigestRepositoryImpl) FindByConfirmToken(token string) (*schemas.DigestSubscription, error) {
+	var subscription schemas.DigestSubscription
+	if err := r.db.Where("confirm_token = ?", token).First(&subscription).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (r *DigestRepositoryImpl) FindByUnsubscribeToken(token string) (*schemas.DigestSubscription, error) {
+	var subscription schemas.DigestSubscription
+	if err := r.db.Where("unsubscribe_token = ?", token).First(&subscription).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (r *DigestRepositoryImpl) FindDue(now time.Time) ([]schemas.DigestSubscription, error) {
+	var subscriptions []schemas.DigestSubscription
+	err := r.db.
+		Where("confirmed_at IS NOT NULL AND unsubscribed_at IS NULL AND next_run_at <= ?", now.UTC()).
+		Order("next_run_at").
+		Find(&subscriptions).Error
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// StartDelivery returns the delivery of the subscription period, creating
+// it if this is the first attempt.
+func (r *DigestRepositoryImpl) StartDelivery(delivery schemas.DigestDelivery) (*schemas.DigestDelivery, error) {
+	delivery.PeriodEnd = delivery.PeriodEnd.UTC()
+	err := r.db.
+		Where("subscription_id = ? AND period_end = ?", delivery.SubscriptionID, delivery.PeriodEnd).
+		Attrs(delivery).
+		FirstOrCreate(&delivery).Error
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (r *DigestRepositoryImpl) UpdateDelivery(delivery schemas.DigestDelivery) error {
+	return r.db.Save(&delivery).Error
+}
+
+// CompleteDelivery stores the outcome of the delivery and moves the
+// subscription to its next period atomically.
+func (r *DigestRepositoryImpl) CompleteDelivery(delivery schemas.DigestDelivery, subscription schemas.DigestSubscription) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&delivery).Error; err != nil {
+			return err
+		}
+		return tx.Save(&subscription).Error
+	})
+}
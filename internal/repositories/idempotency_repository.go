@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type IdempotencyRepository interface {
+	// Reserve stores the record unless its key is already taken, and
+	// reports whether it did.
+	Reserve(record *schemas.IdempotencyRecord) (bool, error)
+	FindByKey(key string) (*schemas.IdempotencyRecord, error)
+	Update(record schemas.IdempotencyRecord) error
+	// Renew sets the RenewedAt of key to at while its request is running.
+	Renew(key string, at time.Time) error
+	Delete(id uint) error
+	DeleteExpiredBefore(t time.Time) error
+}
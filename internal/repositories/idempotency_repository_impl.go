@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IdempotencyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &IdempotencyRepositoryImpl{db: db}
+}
+
+func (r *IdempotencyRepositoryImpl) Reserve(record *schemas.IdempotencyRecord) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *IdempotencyRepositoryImpl) FindByKey(key string) (*schemas.IdempotencyRecord, error) {
+	var record schemas.IdempotencyRecord
+	if err := r.db.Where("key = ?", key).First(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *IdempotencyRepositoryImpl) Update(record schemas.IdempotencyRecord) error {
+	return r.db.Save(&record).Error
+}
+
+func (r *IdempotencyRepositoryImpl) Renew(key string, at time.Time) error {
+	return r.db.Model(&schemas.IdempotencyRecord{}).
+		Where("key = ? AND status_code = 0", key).
+		UpdateColumn("renewed_at", at).Error
+}
+
+func (r *IdempotencyRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&schemas.IdempotencyRecord{}, id).Error
+}
+
+func (r *IdempotencyRepositoryImpl) DeleteExpiredBefore(t time.Time) error {
+	return r.db.Where("expires_at < ?", t.UTC()).Delete(&schemas.IdempotencyRecord{}).Error
+}
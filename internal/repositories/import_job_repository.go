@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type ImportJobRepository interface {
+	Create(job *schemas.ImportJob) error
+	FindByID(id uint) (*schemas.ImportJob, error)
+	Update(job schemas.ImportJob) error
+	FailUnfinished(message string, completedAt time.Time) (int64, error)
+}
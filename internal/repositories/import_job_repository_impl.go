@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+type ImportJobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewImportJobRepository(db *gorm.DB) ImportJobRepository {
+	return &ImportJobRepositoryImpl{db: db}
+}
+
+func (r *ImportJobRepositoryImpl) Create(job *schemas.ImportJob) error {
+	return r.db.Create(job).Error
+}
+
+func (r *ImportJobRepositoryImpl) FindByID(id uint) (*schemas.ImportJob, error) {
+	var job schemas.ImportJob
+	if err := r.db.First(&job, id).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *ImportJobRepositoryImpl) Update(job schemas.ImportJob) error {
+	return r.db.Save(&job).Error
+}
+
+// FailUnfinished marks every pending or running job as failed with message
+// and returns how many there were.
+func (r *ImportJobRepositoryImpl) FailUnfinished(message string, completedAt time.Time) (int64, error) {
+	result := r.db.Model(&schemas.ImportJob{}).
+		Where("status IN ?", []string{schemas.ImportJobPending, schemas.ImportJobRunning}).
+		Updates(map[string]interface{}{
+			"status":       schemas.ImportJobFailed,
+			"error":        message,
+			"completed_at": completedAt,
+		})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type JobAlertRepository interface {
+	Create(alert schemas.JobAlert) error
+	Update(alert schemas.JobAlert) error
+	FindByConfirmToken(token string) (*schemas.JobAlert, error)
+	FindByUnsubscribeToken(token string) (*schemas.JobAlert, error)
+	FindByID(id uint) (*schemas.JobAlert, error)
+	FindActive() ([]schemas.JobAlert, error)
+	CreateDeliveries(deliveries []schemas.JobAlertDelivery) error
+	FindDueDeliveries(now time.Time, limit int) ([]schemas.JobAlertDelivery, error)
+	UpdateDelivery(delivery schemas.JobAlertDelivery) error
+}
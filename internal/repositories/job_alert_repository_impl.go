@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobAlertRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewJobAlertRepository(db *gorm.DB) JobAlertRepository {
+	return &JobAlertRepositoryImpl{db: db}
+}
+
+func (r *JobAlertRepositoryImpl) Create(alert schemas.JobAlert) error {
+	return r.db.Create(&alert).Error
+}
+
+func (r *JobAlertRepositoryImpl) Update(alert schemas.JobAlert) error {
+	return r.db.Save(&alert).Error
+}
+
+func (r *JobAlertRepositoryImpl) FindByConfirmToken(token string) (*schemas.JobAlert, error) {
+	var alert schemas.JobAlert
+	if err := r.db.Where("confirm_token = ?", token).First(&alert).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *JobAlertRepositoryImpl) FindByUnsubscribeToken(token string) (*schemas.JobAlert, error) {
+	var alert schemas.JobAlert
+	if err := r.db.Where("unsubscribe_token = ?", token).First(&alert).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *JobAlertRepositoryImpl) FindByID(id uint) (*schemas.JobAlert, error) {
+	var alert schemas.JobAlert
+	if err := r.db.First(&alert, id).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *JobAlertRepositoryImpl) FindActive() ([]schemas.JobAlert, error) {
+	var alerts []schemas.JobAlert
+	err := r.db.Where("confirmed_at IS NOT NULL AND unsubscribed_at IS NULL").Order("id").Find(&alerts).Error
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// CreateDeliveries skips the deliveries of an event already queued for the
+// same alert.
+func (r *JobAlertRepositoryImpl) CreateDeliveries(deliveries []schemas.JobAlertDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+func (r *JobAlertRepositoryImpl) FindDueDeliveries(now time.Time, limit int) ([]schemas.JobAlertDelivery, error) {
+	var deliveries []schemas.JobAlertDelivery
+	err := r.db.
+		Where("status = ? AND next_attempt_at <= ?", schemas.JobAlertDeliveryPending, now.UTC()).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *JobAlertRepositoryImpl) UpdateDelivery(delivery schemas.JobAlertDelivery) error {
+	return r.db.Save(&delivery).Error
+}
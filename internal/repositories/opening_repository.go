@@ -1,13 +1,49 @@
 package repositories
 
 import (
+	"errors"
+	"time"
+
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
 )
 
+// ErrVersionConflict is returned by writes whose opening was changed, or
+// removed, since the version they were based on was read.
+var ErrVersionConflict = errors.New("opening version conflict")
+
+// ErrLinkChanged is returned by RecordLinkCheck when the opening no longer
+// has the link that was checked.
+var ErrLinkChanged = errors.New("opening link changed")
+
 type OpeningRepository interface {
-	Create(opening schemas.Opening) error
+	Create(opening *schemas.Opening) error
 	FindByID(id uint) (*schemas.Opening, error)
-	Update(opening schemas.Opening) error
-	Delete(id uint) error
-	FindAll(limit, offset int) ([]schemas.Opening, error)
+	Update(opening *schemas.Opening) error
+	Delete(id, version uint) error
+	FindAll(filter schemas.OpeningFilter, limit, offset int) ([]schemas.Opening, error)
+	// FindAfter returns up to limit openings matching filter whose ID is
+	// greater than afterID, in ID order, so a caller can walk every match
+	// in batches.
+	FindAfter(filter schemas.OpeningFilter, afterID uint, limit int) ([]schemas.Opening, error)
+	// FindLatest returns up to limit openings matching filter, newest first.
+	FindLatest(filter schemas.OpeningFilter, limit int) ([]schemas.Opening, error)
+	FindRevision(openingID, revision uint) (*schemas.OpeningRevision, error)
+	FindCreatedBetween(from, to time.Time) ([]schemas.Opening, error)
+	// FindBySource returns every opening synced from the ATS source,
+	// including closed and deleted ones.
+	FindBySource(source string) ([]schemas.Opening, error)
+	// FindDuplicateCandidates returns the open openings with the given
+	// normalized link, or whose normalized company starts with companyBlock.
+	FindDuplicateCandidates(linkKey, companyBlock string) ([]schemas.Opening, error)
+	// FindLinksDue returns up to limit openings with an open status, paused
+	// ones included, whose link was never checked or was last checked at or
+	// before checkedBefore, least recently checked first.
+	FindLinksDue(checkedBefore time.Time, limit int) ([]schemas.Opening, error)
+	// RecordLinkCheck saves the link check fields of the opening without
+	// touching its version, revisions or events, as long as it still has
+	// the same link; otherwise it fails with ErrLinkChanged.
+	RecordLinkCheck(opening *schemas.Opening) error
+	// Transaction runs fn with a repository whose writes are committed
+	// together when fn returns nil, and rolled back otherwise.
+	Transaction(fn func(repo OpeningRepository) error) error
 }
@@ -1,7 +1,12 @@
 package repositories
 
 import (
+	"encoding/json"
+	"strings"
+	"time"
+
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/dedup"
 	"gorm.io/gorm"
 )
 
@@ -13,11 +18,18 @@ func NewOpeningRepository(db *gorm.DB) OpeningRepository {
 	return &OpeningRepositoryImpl{db: db}
 }
 
-func (r *OpeningRepositoryImpl) Create(opening schemas.Opening) error {
-	if err := r.db.Create(&opening).Error; err != nil {
-		return err
-	}
-	return nil
+func (r *OpeningRepositoryImpl) Create(opening *schemas.Opening) error {
+	opening.Version = 1
+	setDuplicateKeys(opening)
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(opening).Error; err != nil {
+			return err
+		}
+		if err := createRevision(tx, *opening); err != nil {
+			return err
+		}
+		return createOutboxEvent(tx, schemas.OpeningCreatedEvent, *opening)
+	})
 }
 
 func (r *OpeningRepositoryImpl) FindByID(id uint) (*schemas.Opening, error) {
@@ -28,22 +40,254 @@ func (r *OpeningRepositoryImpl) FindByID(id uint) (*schemas.Opening, error) {
 	return &opening, nil
 }
 
-func (r *OpeningRepositoryImpl) Update(opening schemas.Opening) error {
-	if err := r.db.Save(&opening).Error; err != nil {
-		return err
+// Update saves the opening and refreshes it with the stored row. The write
+// only applies to the version the opening was read at; otherwise it fails
+// with ErrVersionConflict.
+func (r *OpeningRepositoryImpl) Update(opening *schemas.Opening) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		changes := *opening
+		changes.Version++
+		setDuplicateKeys(&changes)
+
+		// The last check says nothing about a new link.
+		err := tx.Model(&schemas.Opening{}).
+			Where("id = ? AND version = ? AND link <> ?", opening.ID, opening.Version, opening.Link).
+			UpdateColumns(map[string]interface{}{
+				"link_status":     0,
+				"link_redirect":   "",
+				"link_error":      "",
+				"link_checked_at": nil,
+				"link_failures":   0,
+				"link_paused":     false,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(&schemas.Opening{}).
+			Where("id = ? AND version = ?", opening.ID, opening.Version).
+			Select("*").
+			Omit(append([]string{"id", "created_at", "deleted_at"}, linkCheckColumns...)...).
+			Updates(&changes)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrVersionConflict
+		}
+
+		var saved schemas.Opening
+		if err := tx.First(&saved, opening.ID).Error; err != nil {
+			return err
+		}
+		*opening = saved
+		if err := createRevision(tx, saved); err != nil {
+			return err
+		}
+		return createOutboxEvent(tx, schemas.OpeningUpdatedEvent, saved)
+	})
+}
+
+// Delete removes the opening if it is still at the given version.
+func (r *OpeningRepositoryImpl) Delete(id, version uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var opening schemas.Opening
+		if err := tx.First(&opening, id).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("version = ?", version).Delete(&opening)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrVersionConflict
+		}
+		return createOutboxEvent(tx, schemas.OpeningDeletedEvent, opening)
+	})
+}
+
+func (r *OpeningRepositoryImpl) FindAll(filter schemas.OpeningFilter, limit, offset int) ([]schemas.Opening, error) {
+	var openings []schemas.Opening
+
+	if err := applyOpeningFilter(r.db, filter).Limit(limit).Offset(offset).Find(&openings).Error; err != nil {
+		return nil, err
 	}
-	return nil
+	return openings, nil
+}
+
+func (r *OpeningRepositoryImpl) FindAfter(filter schemas.OpeningFilter, afterID uint, limit int) ([]schemas.Opening, error) {
+	var openings []schemas.Opening
+
+	err := applyOpeningFilter(r.db, filter).
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&openings).Error
+	if err != nil {
+		return nil, err
+	}
+	return openings, nil
+}
+
+func (r *OpeningRepositoryImpl) FindLatest(filter schemas.OpeningFilter, limit int) ([]schemas.Opening, error) {
+	var openings []schemas.Opening
+
+	err := applyOpeningFilter(r.db, filter).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&openings).Error
+	if err != nil {
+		return nil, err
+	}
+	return openings, nil
+}
+
+func (r *OpeningRepositoryImpl) FindRevision(openingID, revision uint) (*schemas.OpeningRevision, error) {
+	var rev schemas.OpeningRevision
+	err := r.db.Where("opening_id = ? AND revision = ?", openingID, revision).First(&rev).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// FindCreatedBetween returns the openings created in the (from, to] range,
+// oldest first.
+func (r *OpeningRepositoryImpl) FindCreatedBetween(from, to time.Time) ([]schemas.Opening, error) {
+	var openings []schemas.Opening
+	err := r.db.
+		Where("created_at > ? AND created_at <= ?", from.Local(), to.Local()).
+		Order("created_at").
+		Find(&openings).Error
+	if err != nil {
+		return nil, err
+	}
+	return openings, nil
 }
 
-func (r *OpeningRepositoryImpl) Delete(id uint) error {
-	return r.db.Delete(&schemas.Opening{}, id).Error
+func (r *OpeningRepositoryImpl) FindBySource(source string) ([]schemas.Opening, error) {
+	var openings []schemas.Opening
+	if err := r.db.Unscoped().Where("source = ?", source).Order("id").Find(&openings).Error; err != nil {
+		return nil, err
+	}
+	return openings, nil
 }
 
-func (r *OpeningRepositoryImpl) FindAll(limit, offset int) ([]schemas.Opening, error) {
+func (r *OpeningRepositoryImpl) FindDuplicateCandidates(linkKey, companyBlock string) ([]schemas.Opening, error) {
 	var openings []schemas.Opening
+	err := applyOpeningFilter(r.db, schemas.OpeningFilter{Status: schemas.OpeningStatusOpen}).
+		Where("(link_key <> '' AND link_key = ?) OR (? <> '' AND company_key LIKE ?)", linkKey, companyBlock, companyBlock+"%").
+		Order("id").
+		Find(&openings).Error
+	if err != nil {
+		return nil, err
+	}
+	return openings, nil
+}
 
-	if err := r.db.Limit(limit).Offset(offset).Find(&openings).Error; err != nil {
+func (r *OpeningRepositoryImpl) FindLinksDue(checkedBefore time.Time, limit int) ([]schemas.Opening, error) {
+	var openings []schemas.Opening
+	err := r.db.
+		Where(openStatus, schemas.OpeningStatusOpen).
+		Where("link <> ''").
+		Where("link_checked_at IS NULL OR link_checked_at <= ?", checkedBefore.Local()).
+		Order("link_checked_at").
+		Order("id").
+		Limit(limit).
+		Find(&openings).Error
+	if err != nil {
 		return nil, err
 	}
 	return openings, nil
 }
+
+func (r *OpeningRepositoryImpl) RecordLinkCheck(opening *schemas.Opening) error {
+	result := r.db.Model(&schemas.Opening{}).
+		Where("id = ? AND link = ?", opening.ID, opening.Link).
+		Select(linkCheckColumns).
+		UpdateColumns(opening)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrLinkChanged
+	}
+	return nil
+}
+
+func (r *OpeningRepositoryImpl) Transaction(fn func(repo OpeningRepository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&OpeningRepositoryImpl{db: tx})
+	})
+}
+
+// linkCheckColumns are written by RecordLinkCheck alone, so that saving an
+// opening read before a check does not undo it.
+var linkCheckColumns = []string{"link_status", "link_redirect", "link_error", "link_checked_at", "link_failures", "link_paused"}
+
+// openStatus matches the openings whose status is open, whether their link
+// is paused or not.
+const openStatus = "status = ? OR status = '' OR status IS NULL"
+
+func setDuplicateKeys(opening *schemas.Opening) {
+	opening.LinkKey = dedup.LinkKey(opening.Link)
+	opening.CompanyKey = dedup.CompanyKey(opening.Company)
+}
+
+// createRevision appends a full snapshot of the opening as its next numbered
+// revision. It must run inside the transaction that wrote the opening.
+func createRevision(tx *gorm.DB, opening schemas.Opening) error {
+	var last uint
+	err := tx.Model(&schemas.OpeningRevision{}).
+		Where("opening_id = ?", opening.ID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&last).Error
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := json.Marshal(opening)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&schemas.OpeningRevision{
+		OpeningID: opening.ID,
+		Revision:  last + 1,
+		Snapshot:  string(snapshot),
+	}).Error
+}
+
+// applyOpeningFilter is the SQL counterpart of OpeningFilter.Matches.
+func applyOpeningFilter(db *gorm.DB, filter schemas.OpeningFilter) *gorm.DB {
+	if filter.Query != "" {
+		query := "%" + strings.ToLower(filter.Query) + "%"
+		db = db.Where("LOWER(role) LIKE ? OR LOWER(company) LIKE ? OR LOWER(location) LIKE ?", query, query, query)
+	}
+
+	if filter.Company != "" {
+		db = db.Where("LOWER(company) = ?", strings.ToLower(filter.Company))
+	}
+
+	if filter.Location != "" {
+		db = db.Where("LOWER(location) LIKE ?", "%"+strings.ToLower(filter.Location)+"%")
+	}
+
+	if filter.Remote != nil {
+		db = db.Where("remote = ?", *filter.Remote)
+	}
+
+	if filter.MinSalary > 0 {
+		db = db.Where("salary >= ?", filter.MinSalary)
+	}
+
+	switch filter.Status {
+	case schemas.OpeningStatusOpen:
+		db = db.Where(openStatus, schemas.OpeningStatusOpen).Where("link_paused = ?", false)
+	case schemas.OpeningStatusClosed:
+		db = db.Where("status = ? OR link_paused = ?", schemas.OpeningStatusClosed, true)
+	}
+
+	return db
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type OutboxRepository interface {
+	FindPending(limit int) ([]schemas.OutboxEvent, error)
+	Update(event schemas.OutboxEvent) error
+	DeletePublishedBefore(t time.Time) error
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+type OutboxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &OutboxRepositoryImpl{db: db}
+}
+
+// FindPending returns the oldest events neither published nor
+// dead-lettered yet, in the order they were written.
+func (r *OutboxRepositoryImpl) FindPending(limit int) ([]schemas.OutboxEvent, error) {
+	var events []schemas.OutboxEvent
+	err := r.db.
+		Where("published_at IS NULL AND dead_at IS NULL").
+		Order("id").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *OutboxRepositoryImpl) Update(event schemas.OutboxEvent) error {
+	return r.db.Save(&event).Error
+}
+
+func (r *OutboxRepositoryImpl) DeletePublishedBefore(t time.Time) error {
+	return r.db.Unscoped().
+		Where("published_at IS NOT NULL AND published_at < ?", t.UTC()).
+		Delete(&schemas.OutboxEvent{}).Error
+}
+
+// createOutboxEvent queues the event of an opening change. It must run
+// inside the transaction that wrote the opening.
+func createOutboxEvent(tx *gorm.DB, eventType string, opening schemas.Opening) error {
+	event := schemas.OpeningEvent{
+		ID:         newEventID(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Opening:    opening,
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&schemas.OutboxEvent{
+		EventID:       event.ID,
+		Type:          event.Type,
+		Payload:       string(payload),
+		NextAttemptAt: event.OccurredAt,
+	}).Error
+}
+
+func newEventID() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(id)
+}
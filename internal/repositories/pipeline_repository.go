@@ -0,0 +1,11 @@
+package repositories
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type PipelineRepository interface {
+	Save(pipeline schemas.Pipeline) error
+	FindByOpeningID(openingID uint) (*schemas.Pipeline, error)
+	FindByCompany(company string) (*schemas.Pipeline, error)
+}
@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+type PipelineRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPipelineRepository(db *gorm.DB) PipelineRepository {
+	return &PipelineRepositoryImpl{db: db}
+}
+
+// Save replaces the pipeline of the opening, or of the company when
+// OpeningID is zero.
+func (r *PipelineRepositoryImpl) Save(pipeline schemas.Pipeline) error {
+	var existing *schemas.Pipeline
+	var err error
+	if pipeline.OpeningID != 0 {
+		existing, err = r.FindByOpeningID(pipeline.OpeningID)
+	} else {
+		existing, err = r.FindByCompany(pipeline.Company)
+	}
+
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if existing != nil {
+		existing.Stages = pipeline.Stages
+		return r.db.Save(existing).Error
+	}
+	return r.db.Create(&pipeline).Error
+}
+
+func (r *PipelineRepositoryImpl) FindByOpeningID(openingID uint) (*schemas.Pipeline, error) {
+	var pipeline schemas.Pipeline
+	if err := r.db.Where("opening_id = ?", openingID).First(&pipeline).Error; err != nil {
+		return nil, err
+	}
+	return &pipeline, nil
+}
+
+func (r *PipelineRepositoryImpl) FindByCompany(company string) (*schemas.Pipeline, error) {
+	var pipeline schemas.Pipeline
+	if err := r.db.Where("opening_id = 0 AND company = ?", company).First(&pipeline).Error; err != nil {
+		return nil, err
+	}
+	return &pipeline, nil
+}
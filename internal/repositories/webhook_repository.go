@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type WebhookRepository interface {
+	Create(webhook *schemas.Webhook) error
+	FindByID(id uint) (*schemas.Webhook, error)
+	FindAll() ([]schemas.Webhook, error)
+	FindActive() ([]schemas.Webhook, error)
+	Delete(id uint) error
+	CreateDeliveries(deliveries []schemas.WebhookDelivery) error
+	FindDelivery(webhookID, id uint) (*schemas.WebhookDelivery, error)
+	FindDeliveries(webhookID uint, limit int) ([]schemas.WebhookDelivery, error)
+	FindDueDeliveries(now time.Time, limit int) ([]schemas.WebhookDelivery, error)
+	UpdateDelivery(delivery schemas.WebhookDelivery) error
+	RecordAttempt(delivery schemas.WebhookDelivery, attempt schemas.WebhookAttempt) error
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &WebhookRepositoryImpl{db: db}
+}
+
+func (r *WebhookRepositoryImpl) Create(webhook *schemas.Webhook) error {
+	return r.db.Create(webhook).Error
+}
+
+func (r *WebhookRepositoryImpl) FindByID(id uint) (*schemas.Webhook, error) {
+	var webhook schemas.Webhook
+	if err := r.db.First(&webhook, id).Error; err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func (r *WebhookRepositoryImpl) FindAll() ([]schemas.Webhook, error) {
+	var webhooks []schemas.Webhook
+	if err := r.db.Order("id").Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (r *WebhookRepositoryImpl) FindActive() ([]schemas.Webhook, error) {
+	var webhooks []schemas.Webhook
+	if err := r.db.Where("active = ?", true).Order("id").Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+func (r *WebhookRepositoryImpl) Delete(id uint) error {
+	return r.db.Delete(&schemas.Webhook{}, id).Error
+}
+
+// CreateDeliveries skips the deliveries of an event already queued for the
+// same webhook.
+func (r *WebhookRepositoryImpl) CreateDeliveries(deliveries []schemas.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+func (r *WebhookRepositoryImpl) FindDelivery(webhookID, id uint) (*schemas.WebhookDelivery, error) {
+	var delivery schemas.WebhookDelivery
+	err := r.db.
+		Where("webhook_id = ?", webhookID).
+		Preload("AttemptLog", orderByID).
+		First(&delivery, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindDeliveries returns the latest deliveries of the webhook with every
+// attempt made for them.
+func (r *WebhookRepositoryImpl) FindDeliveries(webhookID uint, limit int) ([]schemas.WebhookDelivery, error) {
+	var deliveries []schemas.WebhookDelivery
+	err := r.db.
+		Where("webhook_id = ?", webhookID).
+		Preload("AttemptLog", orderByID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookRepositoryImpl) FindDueDeliveries(now time.Time, limit int) ([]schemas.WebhookDelivery, error) {
+	var deliveries []schemas.WebhookDelivery
+	err := r.db.
+		Where("status = ? AND next_attempt_at <= ?", schemas.WebhookDeliveryPending, now.UTC()).
+		Order("next_attempt_at, id").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookRepositoryImpl) UpdateDelivery(delivery schemas.WebhookDelivery) error {
+	delivery.AttemptLog = nil
+	return r.db.Save(&delivery).Error
+}
+
+// RecordAttempt stores the attempt together with the delivery state it led
+// to.
+func (r *WebhookRepositoryImpl) RecordAttempt(delivery schemas.WebhookDelivery, attempt schemas.WebhookAttempt) error {
+	delivery.AttemptLog = nil
+	attempt.DeliveryID = delivery.ID
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&attempt).Error; err != nil {
+			return err
+		}
+		return tx.Save(&delivery).Error
+	})
+}
+
+func orderByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
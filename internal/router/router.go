@@ -6,9 +6,12 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
 )
 
-func SetupRouter() {
+// NewRouter builds the gin engine with every route. The opening usecase is
+// returned for the other servers that expose openings.
+func NewRouter() (*gin.Engine, opening_usecase.OpeningUsecase) {
 	r := gin.Default()
 
 	r.Use(cors.New(cors.Config{
@@ -19,13 +22,17 @@ func SetupRouter() {
 		MaxAge:           12 * time.Hour,
 	}))
 
-	initializeRoutes(r)
+	openings := initializeRoutes(r)
 	setupSwagger(r)
 
+	return r, openings
+}
+
+func Run(r *gin.Engine) error {
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
 
-	r.Run(":" + port)
+	return r.Run(":" + port)
 }
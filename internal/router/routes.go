@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"os"
 
 	"github.com/gin-gonic/gin"
@@ -8,31 +9,179 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"github.com/valdir-alves3000/go-opportunities/config"
 	docs "github.com/valdir-alves3000/go-opportunities/docs"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/alert_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/application_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/ats_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/candidate_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/digest_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/idempotency_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/import_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/link_check_usecase"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/outbox_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/pipeline_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/sitemap_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/webhook_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/internal/graphql"
 	"github.com/valdir-alves3000/go-opportunities/internal/handler"
 	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
 )
 
 const BASE_PATH = "/api/v1"
 
-func initializeRoutes(r *gin.Engine) {
+func initializeRoutes(r *gin.Engine) opening_usecase.OpeningUsecase {
 	db := config.GetSQLite()
 	opRepo := repositories.NewOpeningRepository(db)
-	opUsecase := opening_usecase.NewOpeningUseCase(opRepo)
-	opHandler := handler.NewOpeningHandler(opUsecase)
+	appRepo := repositories.NewApplicationRepository(db)
+	pipelineRepo := repositories.NewPipelineRepository(db)
+	pipelineUsecase := pipeline_usecase.NewPipelineUseCase(pipelineRepo, opRepo, appRepo)
+	pipelineHandler := handler.NewPipelineHandler(pipelineUsecase)
+
+	mail := config.GetMailer()
+	alertRepo := repositories.NewJobAlertRepository(db)
+	alertUsecase := alert_usecase.NewAlertUseCase(alertRepo, mail, config.GetBaseURL())
+	alertHandler := handler.NewAlertHandler(alertUsecase)
+	alertDispatcher := alert_usecase.NewDispatcher(alertRepo, mail, config.GetBaseURL(), config.GetAlertPolicy())
+	go alertDispatcher.Run(context.Background())
+
+	digestRepo := repositories.NewDigestRepository(db)
+	digestUsecase := digest_usecase.NewDigestUseCase(digestRepo, mail, config.GetBaseURL(), config.GetDigestSchedule())
+	digestHandler := handler.NewDigestHandler(digestUsecase)
+	digestScheduler := digest_usecase.NewScheduler(digestRepo, opRepo, mail, config.GetBaseURL(), config.GetDigestSchedule())
+	go digestScheduler.Run(context.Background())
+
+	webhookRepo := repositories.NewWebhookRepository(db)
+	webhookHandler := handler.NewWebhookHandler(webhook_usecase.NewWebhookUseCase(webhookRepo))
+	webhookDispatcher := webhook_usecase.NewDispatcher(webhookRepo, config.GetWebhookPolicy())
+	go webhookDispatcher.Run(context.Background())
+
+	streamPolicy := config.GetStreamPolicy()
+	broker := events.NewBroker(streamPolicy.ReplaySize)
+	streamHandler := handler.NewOpeningStreamHandler(broker, streamPolicy.Heartbeat)
+
+	publishers := append([]events.Publisher{alertDispatcher, webhookDispatcher, broker}, config.GetEventPublishers()...)
+	relay := outbox_usecase.NewRelay(repositories.NewOutboxRepository(db), config.GetOutboxPolicy(), publishers...)
+	go relay.Run(context.Background())
+
+	sitemapUsecase := sitemap_usecase.NewSitemapUseCase(opRepo, config.GetBaseURL(), sitemap_usecase.MaxURLs)
+
+	opUsecase := opening_usecase.NewOpeningUseCase(
+		opRepo,
+		opening_usecase.WithStageCounter(pipelineUsecase),
+		opening_usecase.WithNotifier(relay),
+		opening_usecase.WithNotifier(sitemapUsecase),
+		opening_usecase.WithDuplicatePolicy(config.GetDuplicatePolicy()),
+		opening_usecase.WithLinkPolicy(config.GetLinkPolicy()),
+	)
+	opHandler := handler.NewOpeningHandler(
+		opUsecase,
+		handler.WithIfMatchRequired(config.IfMatchRequired()),
+		handler.WithJobPostingPolicy(config.GetJobPostingPolicy()),
+	)
+
+	atsSyncer := ats_usecase.NewSyncer(opUsecase, config.GetATSPolicy())
+	go atsSyncer.Run(context.Background())
+
+	linkChecker := link_check_usecase.NewChecker(opRepo, opUsecase, config.GetLinkCheckPolicy())
+	go linkChecker.Run(context.Background())
+
+	importUsecase := import_usecase.NewImportUseCase(opUsecase, repositories.NewImportJobRepository(db), config.ImportAsyncRows())
+	if err := importUsecase.FailInterrupted(); err != nil {
+		panic(err)
+	}
+	importHandler := handler.NewImportHandler(importUsecase, handler.DefaultMaxImportSize, BASE_PATH)
+
+	graphQLSchema, err := graphql.NewSchema(opUsecase)
+	if err != nil {
+		panic(err)
+	}
+	graphQLHandler := handler.NewGraphQLHandler(graphQLSchema, config.GraphiQLEnabled(), BASE_PATH+"/graphql")
+
+	appUsecase := application_usecase.NewApplicationUseCase(appRepo, opRepo, pipelineRepo)
+	appHandler := handler.NewApplicationHandler(appUsecase)
+
+	blobs := config.GetStorage()
+	candRepo := repositories.NewCandidateProfileRepository(db)
+	candUsecase := candidate_usecase.NewCandidateUseCase(candRepo, opRepo, blobs, candidate_usecase.DefaultResumeLimits)
+	candHandler := handler.NewCandidateHandler(candUsecase, candidate_usecase.DefaultResumeLimits.MaxSize)
+
+	idemUsecase := idempotency_usecase.NewIdempotencyUseCase(repositories.NewIdempotencyRepository(db), config.GetIdempotencyPolicy())
+	go idemUsecase.Run(context.Background())
 
 	v1 := r.Group(BASE_PATH)
+	v1.Use(handler.Idempotency(idemUsecase, handler.DefaultMaxImportSize))
 	{
 		v1.GET("/openings", opHandler.List)
+		v1.GET("/openings/export", opHandler.Export)
+		v1.GET("/openings/stream", streamHandler.Stream)
 		v1.GET("/openings/:id", opHandler.ShowOpening)
 		v1.POST("/openings", opHandler.Create)
+		v1.POST("/openings/bulk", opHandler.Bulk)
+		v1.POST("/openings/import", importHandler.Import)
+		v1.GET("/openings/import/jobs/:id", importHandler.ShowJob)
 		v1.DELETE("/openings/:id", opHandler.Delete)
 		v1.PUT("/openings/:id", opHandler.Update)
+		v1.PATCH("/openings/:id", opHandler.Patch)
+		v1.GET("/openings/:id/revisions/:rev", opHandler.ShowRevision)
+		v1.POST("/openings/:id/revisions/:rev/restore", opHandler.RestoreRevision)
+
+		v1.POST("/openings/:id/applications", appHandler.Create)
+		v1.GET("/openings/:id/applications", appHandler.List)
+		v1.GET("/applications/:id", appHandler.ShowApplication)
+		v1.POST("/applications/:id/transitions", appHandler.Transition)
+		v1.GET("/applications/:id/history", appHandler.History)
+
+		v1.GET("/openings/:id/pipeline", pipelineHandler.ShowOpeningPipeline)
+		v1.PUT("/openings/:id/pipeline", pipelineHandler.DefineOpeningPipeline)
+		v1.PUT("/companies/:company/pipeline", pipelineHandler.DefineCompanyPipeline)
+
+		v1.POST("/openings/:id/candidates", candHandler.Create)
+		v1.GET("/candidates/:id", candHandler.ShowCandidate)
+		v1.GET("/candidates/:id/resume", candHandler.ResumeURL)
+
+		if local, ok := blobs.(*storage.LocalStorage); ok {
+			v1.GET("/files/*key", handler.NewFileHandler(local).Download)
+		}
+
+		v1.POST("/alerts", alertHandler.Subscribe)
+		v1.GET("/alerts/confirm", alertHandler.Confirm)
+		v1.GET("/alerts/unsubscribe", alertHandler.Unsubscribe)
+		v1.POST("/alerts/unsubscribe", alertHandler.Unsubscribe)
+
+		v1.POST("/digests", digestHandler.Subscribe)
+		v1.GET("/digests/confirm", digestHandler.Confirm)
+		v1.GET("/digests/unsubscribe", digestHandler.Unsubscribe)
+		v1.POST("/digests/unsubscribe", digestHandler.Unsubscribe)
+
+		v1.POST("/graphql", graphQLHandler.Query)
+		v1.GET("/graphql", graphQLHandler.Get)
+
+		v1.POST("/webhooks", webhookHandler.Create)
+		v1.GET("/webhooks", webhookHandler.List)
+		v1.DELETE("/webhooks/:id", webhookHandler.Delete)
+		v1.GET("/webhooks/:id/deliveries", webhookHandler.Deliveries)
+		v1.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
+
+		v1.GET("/admin/openings/duplicates", opHandler.ListDuplicates)
 	}
 
+	feedHandler := handler.NewFeedHandler(opUsecase, config.GetBaseURL())
+	r.GET("/feeds/openings.rss", feedHandler.RSS)
+	r.GET("/feeds/openings.atom", feedHandler.Atom)
+	r.GET("/feeds/openings.json", feedHandler.JSON)
+
+	sitemapHandler := handler.NewSitemapHandler(sitemapUsecase)
+	r.GET("/sitemap.xml", sitemapHandler.Index)
+	r.GET("/sitemaps/:file", sitemapHandler.Page)
+	r.GET("/robots.txt", sitemapHandler.Robots)
+
 	r.GET("/", func(c *gin.Context) {
 		c.Redirect(302, "/swagger/index.html")
 	})
+
+	return opUsecase
 }
 
 func setupSwagger(r *gin.Engine) {
@@ -0,0 +1,183 @@
+package rpc
+
+import (
+	"context"
+
+	openingv1 "github.com/valdir-alves3000/go-opportunities/api/opening/v1"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// OpeningServer serves openingv1.OpeningService on top of the opening
+// usecase.
+type OpeningServer struct {
+	openingv1.UnimplementedOpeningServiceServer
+	useCase opening_usecase.OpeningUsecase
+}
+
+func NewOpeningServer(useCase opening_usecase.OpeningUsecase) *OpeningServer {
+	return &OpeningServer{useCase: useCase}
+}
+
+func (s *OpeningServer) CreateOpening(ctx context.Context, req *openingv1.CreateOpeningRequest) (*openingv1.Opening, error) {
+	opening, errCase := s.useCase.Create(schemas.CreateOpeningRequest{
+		Role:     req.GetRole(),
+		Company:  req.GetCompany(),
+		Location: req.GetLocation(),
+		Remote:   req.Remote,
+		Link:     req.GetLink(),
+		Salary:   req.GetSalary(),
+	})
+	if errCase != nil {
+		return nil, statusError(errCase)
+	}
+	return toProto(opening), nil
+}
+
+func (s *OpeningServer) GetOpening(ctx context.Context, req *openingv1.GetOpeningRequest) (*openingv1.Opening, error) {
+	id, err := openingID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	opening, errCase := s.useCase.GetByID(id)
+	if errCase != nil {
+		return nil, statusError(errCase)
+	}
+	return toProto(opening), nil
+}
+
+func (s *OpeningServer) UpdateOpening(ctx context.Context, req *openingv1.UpdateOpeningRequest) (*openingv1.Opening, error) {
+	id, err := openingID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	errCase := s.useCase.Update(id, schemas.UpdateOpeningRequest{
+		Role:     req.GetRole(),
+		Company:  req.GetCompany(),
+		Location: req.GetLocation(),
+		Remote:   req.Remote,
+		Link:     req.GetLink(),
+		Salary:   req.GetSalary(),
+		Status:   req.GetStatus(),
+	}, nil)
+	if errCase != nil {
+		return nil, statusError(errCase)
+	}
+
+	opening, errCase := s.useCase.GetByID(id)
+	if errCase != nil {
+		return nil, statusError(errCase)
+	}
+	return toProto(opening), nil
+}
+
+func (s *OpeningServer) DeleteOpening(ctx context.Context, req *openingv1.DeleteOpeningRequest) (*openingv1.DeleteOpeningResponse, error) {
+	id, err := openingID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if errCase := s.useCase.DeleteByID(id, nil); errCase != nil {
+		return nil, statusError(errCase)
+	}
+	return &openingv1.DeleteOpeningResponse{}, nil
+}
+
+func (s *OpeningServer) ListOpenings(req *openingv1.ListOpeningsRequest, stream openingv1.OpeningService_ListOpeningsServer) error {
+	var filter schemas.OpeningFilter
+	if f := req.GetFilter(); f != nil {
+		filter = schemas.OpeningFilter{
+			Query:     f.GetQuery(),
+			Company:   f.GetCompany(),
+			Location:  f.GetLocation(),
+			Remote:    f.Remote,
+			MinSalary: f.GetMinSalary(),
+			Status:    f.GetStatus(),
+		}
+	}
+
+	page, all := int(req.GetPage()), req.GetPage() == 0
+	if all {
+		page = 1
+	}
+
+	for {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		openings, errCase := s.useCase.ListOpenings(filter, page)
+		if errCase != nil {
+			// Running out of pages is the end of the stream, not an error.
+			if errCase.Err == "not_found" {
+				return nil
+			}
+			return statusError(errCase)
+		}
+		if len(openings) == 0 {
+			return nil
+		}
+
+		for i := range openings {
+			if err := stream.Send(toProto(&openings[i])); err != nil {
+				return err
+			}
+		}
+
+		if !all {
+			return nil
+		}
+		page++
+	}
+}
+
+// statusError maps the kind of an internal_error to a gRPC status code.
+func statusError(err *internal_error.InternalError) error {
+	code := codes.Unknown
+	switch err.Err {
+	case "bad_request":
+		code = codes.InvalidArgument
+	case "not_found":
+		code = codes.NotFound
+	case "internal_server_error":
+		code = codes.Internal
+	case "conflict":
+		code = codes.Aborted
+	case "precondition_failed":
+		code = codes.FailedPrecondition
+	}
+	return status.Error(code, err.Message)
+}
+
+func openingID(id uint64) (uint, error) {
+	if id == 0 {
+		return 0, status.Error(codes.InvalidArgument, "param: id (type: uint64) is required")
+	}
+	return uint(id), nil
+}
+
+func toProto(o *schemas.Opening) *openingv1.Opening {
+	opening := &openingv1.Opening{
+		Id:          uint64(o.ID),
+		CreatedAt:   timestamppb.New(o.CreatedAt),
+		UpdatedAt:   timestamppb.New(o.UpdatedAt),
+		Role:        o.Role,
+		Company:     o.Company,
+		Location:    o.Location,
+		Remote:      o.Remote,
+		Link:        o.Link,
+		Salary:      o.Salary,
+		Status:      o.Status,
+		StageCounts: o.StageCounts,
+	}
+	if opening.Status == "" {
+		opening.Status = schemas.OpeningStatusOpen
+	}
+	return opening
+}
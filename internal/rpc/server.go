@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"net"
+
+	openingv1 "github.com/valdir-alves3000/go-opportunities/api/opening/v1"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewServer returns a gRPC server with the opening service and server
+// reflection, so that tools like grpcurl can discover it.
+func NewServer(useCase opening_usecase.OpeningUsecase, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	openingv1.RegisterOpeningServiceServer(server, NewOpeningServer(useCase))
+	reflection.Register(server)
+	return server
+}
+
+// ListenAndServe serves the gRPC API on addr until the listener fails.
+func ListenAndServe(addr string, useCase opening_usecase.OpeningUsecase) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return NewServer(useCase).Serve(listener)
+}
@@ -0,0 +1,74 @@
+// Package safehttp builds HTTP clients for requests to URLs that users
+// supply, which must not reach the network the server runs in.
+package safehttp
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned when a request would connect to an address
+// that Blocked rejects.
+var ErrBlockedAddress = errors.New("destination address is not allowed")
+
+// Options configure NewClient. AllowPrivate turns the address check off,
+// for development and tests against local servers.
+type Options struct {
+	Timeout         time.Duration
+	FollowRedirects bool
+	AllowPrivate    bool
+}
+
+// Blocked reports whether ip is a loopback, private, link-local,
+// unspecified or multicast address.
+func Blocked(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified()
+}
+
+// NewClient returns a client that refuses to connect to blocked addresses.
+// The check runs on the address being dialed, after DNS resolution, so a
+// name that resolves to an internal address is refused too, and so is every
+// hop of a redirect. Without FollowRedirects, a redirect is returned as the
+// response instead of being followed.
+func NewClient(options Options) *http.Client {
+	dialer := &net.Dialer{Timeout: options.Timeout}
+	if !options.AllowPrivate {
+		dialer.Control = control
+	}
+
+	client := &http.Client{
+		Timeout: options.Timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: options.Timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+	if !options.FollowRedirects {
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+	return client
+}
+
+func control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || Blocked(ip) {
+		return ErrBlockedAddress
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
+
+// BlobStorage stores uploaded files such as résumés. Keys are slash separated
+// paths relative to the backend root (e.g. "resumes/3f2a.pdf").
+type BlobStorage interface {
+	Put(key string, body io.Reader, size int64, contentType string) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+	SignedURL(key string, expiresIn time.Duration) (string, error)
+}
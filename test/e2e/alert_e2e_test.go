@@ -0,0 +1,130 @@
+package e2e
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/outbox_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+var tokenPattern = regexp.MustCompile(`token=([0-9a-f]{64})`)
+
+func TestJobAlertE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM job_alerts")
+		db.Exec("DELETE FROM job_alert_deliveries")
+		db.Exec("DELETE FROM outbox_events")
+		smtpServer.Reset()
+	}
+
+	openingReq := schemas.CreateOpeningRequest{
+		Role:     "Golang Developer",
+		Company:  "Alert Corp",
+		Location: "Remote",
+		Link:     "http://example.com/golang",
+		Remote:   new(bool),
+		Salary:   9000,
+	}
+
+	t.Run("ShouldEmailConfirmedSubscribersUntilTheyUnsubscribe", func(t *testing.T) {
+		clearDatabase()
+
+		w := sendJSON("POST", "/alerts", schemas.CreateJobAlertRequest{Email: "ada@example.com", Keywords: "golang", MinSalary: 5000})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		messages := smtpServer.WaitForMessages(1, 2*time.Second)
+		if !assert.Len(t, messages, 1) {
+			return
+		}
+		text, err := messages[0].Part("text/plain")
+		assert.NoError(t, err)
+		confirm := tokenPattern.FindStringSubmatch(text)
+		if !assert.NotNil(t, confirm) {
+			return
+		}
+
+		// Unconfirmed alerts get nothing.
+		smtpServer.Reset()
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+		assert.NoError(t, alertDispatcher.RunDue(time.Now()))
+		assert.Empty(t, smtpServer.WaitForMessages(1, 200*time.Millisecond))
+
+		w = sendJSON("GET", "/alerts/confirm?token="+confirm[1], nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "confirm-alert successfully")
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+		assert.NoError(t, alertDispatcher.RunDue(time.Now()))
+		messages = smtpServer.WaitForMessages(1, 2*time.Second)
+		if !assert.Len(t, messages, 1) {
+			return
+		}
+		assert.Equal(t, []string{"ada@example.com"}, messages[0].To)
+		parsed, err := messages[0].Parse()
+		assert.NoError(t, err)
+		assert.Equal(t, "New opening: Golang Developer at Alert Corp", parsed.Header.Get("Subject"))
+
+		unsubscribeURL := strings.Trim(parsed.Header.Get("List-Unsubscribe"), "<>")
+		assert.True(t, strings.HasPrefix(unsubscribeURL, "http://localhost:8080"+basePath+"/alerts/unsubscribe?token="))
+
+		req, _ := http.NewRequest("POST", strings.TrimPrefix(unsubscribeURL, "http://localhost:8080"), strings.NewReader("List-Unsubscribe=One-Click"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		smtpServer.Reset()
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+		assert.NoError(t, alertDispatcher.RunDue(time.Now()))
+		assert.Empty(t, smtpServer.WaitForMessages(1, 200*time.Millisecond))
+	})
+
+	t.Run("ShouldNotEmailAnAlertTwiceWhenAnEventIsRepublished", func(t *testing.T) {
+		clearDatabase()
+		server := mocks.NewNATSServerMock(true)
+		defer server.Close()
+		nats, _ := events.NewNATSPublisher(events.NATSConfig{URL: server.URL()})
+		defer nats.Close()
+
+		confirmedAt := time.Now()
+		db.Create(&schemas.JobAlert{Email: "ada@example.com", Keywords: "golang", ConfirmToken: "c1", UnsubscribeToken: "u1", ConfirmedAt: &confirmedAt})
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+
+		server.Reject("Authorization Violation")
+		relay := outbox_usecase.NewRelay(repositories.NewOutboxRepository(db), outboxPolicy, alertDispatcher, nats)
+		assert.Error(t, relay.RunDue(time.Now()))
+		assert.NoError(t, alertDispatcher.RunDue(time.Now()))
+		assert.Len(t, smtpServer.WaitForMessages(1, 2*time.Second), 1)
+
+		// A restarted relay publishes the event to every publisher again.
+		server.Reject("")
+		restarted := outbox_usecase.NewRelay(repositories.NewOutboxRepository(db), outboxPolicy, alertDispatcher, nats)
+		assert.NoError(t, restarted.RunDue(time.Now().Add(time.Minute)))
+		assert.NoError(t, alertDispatcher.RunDue(time.Now().Add(time.Minute)))
+
+		assert.Len(t, smtpServer.WaitForMessages(2, 200*time.Millisecond), 1)
+		assert.Len(t, server.Messages(), 1)
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownToken", func(t *testing.T) {
+		clearDatabase()
+
+		w := sendJSON("GET", "/alerts/confirm?token=unknown", nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "alert not found")
+	})
+}
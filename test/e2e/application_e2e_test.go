@@ -0,0 +1,106 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func createApplication(openingID uint, application schemas.CreateApplicationRequest) *httptest.ResponseRecorder {
+	reqJsonBody, _ := json.Marshal(application)
+	req, _ := http.NewRequest("POST", fmt.Sprintf(basePath+"/openings/%d/applications", openingID), bytes.NewBuffer(reqJsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestApplicationE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM applications")
+	}
+
+	seedOpening := func(t *testing.T) schemas.Opening {
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Tech Corp",
+			Location: "Silicon Valley",
+			Link:     "http://example.com",
+			Remote:   new(bool),
+			Salary:   50000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var opening schemas.Opening
+		if err := db.Last(&opening).Error; err != nil {
+			t.Fatal(err)
+		}
+		return opening
+	}
+
+	applicationReq := schemas.CreateApplicationRequest{
+		CandidateName: "Ada Lovelace",
+		Email:         "ada@example.com",
+		CoverLetter:   "Hello!",
+	}
+
+	t.Run("ShouldApplyAndListTheApplicationsOfAnOpening", func(t *testing.T) {
+		clearDatabase()
+		opening := seedOpening(t)
+		assert.Equal(t, schemas.OpeningStatusOpen, opening.Status)
+
+		w := createApplication(opening.ID, applicationReq)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf(basePath+"/openings/%d/applications", opening.ID), nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var resp struct {
+			Message string                `json:"message"`
+			Data    []schemas.Application `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, resp.Data, 1)
+		assert.Equal(t, schemas.StageApplied, resp.Data[0].Status)
+
+		req, _ = http.NewRequest("GET", fmt.Sprintf(basePath+"/applications/%d", resp.Data[0].ID), nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "ada@example.com")
+	})
+
+	t.Run("ShouldRejectApplicationsToAClosedOpening", func(t *testing.T) {
+		clearDatabase()
+		opening := seedOpening(t)
+
+		w := updateOpening(opening.ID, schemas.UpdateOpeningRequest{Status: schemas.OpeningStatusClosed, Salary: opening.Salary})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = createApplication(opening.ID, applicationReq)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "opening is closed for applications")
+	})
+
+	t.Run("ShouldRejectApplicationsToADeletedOpening", func(t *testing.T) {
+		clearDatabase()
+		opening := seedOpening(t)
+
+		w := deleteOpening(opening.ID)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = createApplication(opening.ID, applicationReq)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "opening not found")
+	})
+}
@@ -0,0 +1,129 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/ats_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestATSSyncE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	clearDatabase()
+	defer clearDatabase()
+
+	server := mocks.NewATSServerMock()
+	defer server.Close()
+
+	source := schemas.ATSSource{Name: "acme", Format: schemas.ATSFormatGreenhouse, URL: server.URL + "/v1/boards/acmebrasil/jobs"}
+	syncer := ats_usecase.NewSyncer(opUsecase, schemas.ATSPolicy{Sources: []schemas.ATSSource{source}, Timeout: 5 * time.Second})
+
+	findSynced := func(t *testing.T, externalID string) schemas.Opening {
+		var opening schemas.Opening
+		assert.NoError(t, db.Unscoped().Where("source = ? AND external_id = ?", "acme", externalID).First(&opening).Error)
+		return opening
+	}
+
+	t.Run("ShouldCreateAnOpeningForEveryJobOfTheFeed", func(t *testing.T) {
+		server.Serve("/v1/boards/acmebrasil/jobs", "../fixtures/ats/greenhouse.json")
+
+		report, err := syncer.Sync(context.Background(), source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 3, Created: 3}, report)
+
+		opening := findSynced(t, "4012399")
+		req, _ := http.NewRequest("GET", fmt.Sprintf("%s/openings/%d", basePath, opening.ID), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Data schemas.Opening `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Site Reliability Engineer", response.Data.Role)
+		assert.Equal(t, "Acme Brasil", response.Data.Company)
+		assert.True(t, response.Data.Remote)
+		assert.Equal(t, "4012399", response.Data.ExternalID)
+	})
+
+	t.Run("ShouldChangeNothingWhenTheFeedIsTheSame", func(t *testing.T) {
+		before := findSynced(t, "4012345")
+
+		report, err := syncer.Sync(context.Background(), source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Unchanged)
+		assert.Equal(t, before.Version, findSynced(t, "4012345").Version)
+	})
+
+	t.Run("ShouldUpdateChangedJobsAndCloseTheOnesThatVanished", func(t *testing.T) {
+		server.Serve("/v1/boards/acmebrasil/jobs", "../fixtures/ats/greenhouse-updated.json")
+
+		report, err := syncer.Sync(context.Background(), source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 2, Updated: 1, Unchanged: 1, Closed: 1}, report)
+		assert.Equal(t, "Senior Data Analyst", findSynced(t, "4012420").Role)
+		assert.Equal(t, schemas.OpeningStatusClosed, findSynced(t, "4012399").Status)
+		assert.Equal(t, schemas.OpeningStatusOpen, findSynced(t, "4012345").Status)
+	})
+
+	t.Run("ShouldKeepOpeningsWhenTheFeedIsDown", func(t *testing.T) {
+		server.Fail("/v1/boards/acmebrasil/jobs", http.StatusBadGateway)
+
+		_, err := syncer.Sync(context.Background(), source)
+
+		assert.Error(t, err)
+		assert.Equal(t, schemas.OpeningStatusOpen, findSynced(t, "4012345").Status)
+	})
+
+	t.Run("ShouldNotReopenAnOpeningClosedHere", func(t *testing.T) {
+		server.Serve("/v1/boards/acmebrasil/jobs", "../fixtures/ats/greenhouse-updated.json")
+		opening := findSynced(t, "4012345")
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("%s/openings/%d", basePath, opening.ID), strings.NewReader(`{"status":"closed","salary":9000}`))
+		req.Header.Set("Content-Type", schemas.MergePatchMediaType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		report, err := syncer.Sync(context.Background(), source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 2, Updated: 1, Unchanged: 1}, report)
+		closed := findSynced(t, "4012345")
+		assert.Equal(t, schemas.OpeningStatusClosed, closed.Status)
+		assert.Equal(t, int64(0), closed.Salary)
+	})
+
+	t.Run("ShouldNotRecreateAnOpeningDeletedHere", func(t *testing.T) {
+		server.Serve("/v1/boards/acmebrasil/jobs", "../fixtures/ats/greenhouse.json")
+		opening := findSynced(t, "4012345")
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("%s/openings/%d", basePath, opening.ID), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		report, err := syncer.Sync(context.Background(), source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 3, Updated: 2, Skipped: 1}, report)
+		var count int64
+		db.Model(&schemas.Opening{}).Where("source = ?", "acme").Count(&count)
+		assert.Equal(t, int64(2), count)
+	})
+}
@@ -0,0 +1,111 @@
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+)
+
+func TestBulkOpeningE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	remote := true
+	opening := func(role string, salary int64) schemas.UpdateOpeningRequest {
+		return schemas.UpdateOpeningRequest{
+			Role:     role,
+			Company:  "Bulk Corp",
+			Location: "Lisbon",
+			Link:     "http://example.com/bulk",
+			Remote:   &remote,
+			Salary:   salary,
+		}
+	}
+
+	sendBulk := func(t *testing.T, req schemas.BulkOpeningRequest) handler.BulkOpeningData {
+		w := sendJSON("POST", "/openings/bulk", req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp handler.BulkOpeningResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp.Data
+	}
+
+	countOpenings := func() int64 {
+		var count int64
+		db.Model(&schemas.Opening{}).Count(&count)
+		return count
+	}
+
+	t.Run("ShouldApplyEveryOperationOfAValidBatch", func(t *testing.T) {
+		clearDatabase()
+		existing := sendBulk(t, schemas.BulkOpeningRequest{Operations: []schemas.BulkOpeningOperation{
+			{Op: schemas.BulkOpCreate, Opening: opening("Go Developer", 9000)},
+			{Op: schemas.BulkOpCreate, Opening: opening("Rust Developer", 9000)},
+		}})
+		assert.Equal(t, 2, existing.Applied)
+		goID, rustID := existing.Results[0].ID, existing.Results[1].ID
+
+		data := sendBulk(t, schemas.BulkOpeningRequest{Operations: []schemas.BulkOpeningOperation{
+			{Op: schemas.BulkOpCreate, Opening: opening("Java Developer", 9000)},
+			{Op: schemas.BulkOpUpdate, ID: goID, Version: 1, Opening: schemas.UpdateOpeningRequest{Salary: 12000}},
+			{Op: schemas.BulkOpDelete, ID: rustID},
+		}})
+
+		assert.Equal(t, 3, data.Applied)
+		assert.Equal(t, 0, data.Failed)
+		assert.Equal(t, int64(2), countOpenings())
+
+		var updated schemas.Opening
+		db.First(&updated, goID)
+		assert.Equal(t, int64(12000), updated.Salary)
+		assert.Equal(t, uint(2), updated.Version)
+	})
+
+	t.Run("ShouldRollBackATransactionalBatchWithAnInvalidOperation", func(t *testing.T) {
+		clearDatabase()
+
+		data := sendBulk(t, schemas.BulkOpeningRequest{Operations: []schemas.BulkOpeningOperation{
+			{Op: schemas.BulkOpCreate, Opening: opening("Go Developer", 9000)},
+			{Op: schemas.BulkOpCreate, Opening: opening("", 9000)},
+			{Op: schemas.BulkOpCreate, Opening: opening("Rust Developer", 100)},
+		}})
+
+		assert.Equal(t, 0, data.Applied)
+		assert.Equal(t, 3, data.Failed)
+		assert.Equal(t, http.StatusFailedDependency, data.Results[0].Status)
+		assert.Equal(t, "param: role (type: string) is required", data.Results[1].Message)
+		assert.Equal(t, "salary must be at least 3k", data.Results[2].Message)
+		assert.Equal(t, []rest_err.Causes{{Field: "salary", Message: "salary must be at least 3k"}}, data.Results[2].Causes)
+		assert.Empty(t, data.Results[0].Causes)
+		assert.Equal(t, int64(0), countOpenings())
+
+		var events int64
+		db.Model(&schemas.OutboxEvent{}).Count(&events)
+		assert.Equal(t, int64(0), events)
+	})
+
+	t.Run("ShouldKeepTheValidOperationsOfABestEffortBatch", func(t *testing.T) {
+		clearDatabase()
+
+		data := sendBulk(t, schemas.BulkOpeningRequest{Mode: schemas.BulkModeBestEffort, Operations: []schemas.BulkOpeningOperation{
+			{Op: schemas.BulkOpCreate, Opening: opening("Go Developer", 9000)},
+			{Op: schemas.BulkOpDelete, ID: 9999},
+			{Op: schemas.BulkOpCreate, Opening: opening("Rust Developer", 9000)},
+		}})
+
+		assert.Equal(t, 2, data.Applied)
+		assert.Equal(t, http.StatusNotFound, data.Results[1].Status)
+		assert.Equal(t, "opening not found", data.Results[1].Message)
+		assert.Equal(t, int64(2), countOpenings())
+	})
+}
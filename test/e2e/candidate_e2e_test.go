@@ -0,0 +1,98 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func createCandidate(openingID uint, name, email, fileName string, content []byte) *httptest.ResponseRecorder {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	writer.WriteField("name", name)
+	writer.WriteField("email", email)
+	part, _ := writer.CreateFormFile("resume", fileName)
+	part.Write(content)
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", fmt.Sprintf(basePath+"/openings/%d/candidates", openingID), body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestCandidateE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM candidate_profiles")
+	}
+
+	t.Run("ShouldUploadAResumeAndDownloadItThroughASignedURL", func(t *testing.T) {
+		clearDatabase()
+		createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Tech Corp",
+			Location: "Silicon Valley",
+			Link:     "http://example.com",
+			Remote:   new(bool),
+			Salary:   50000,
+		})
+		var opening schemas.Opening
+		assert.NoError(t, db.Last(&opening).Error)
+
+		pdf := []byte("%PDF-1.7\n%e2e\n")
+		w := createCandidate(opening.ID, "Ada Lovelace", "ada@example.com", "cv.pdf", pdf)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var profile schemas.CandidateProfile
+		assert.NoError(t, db.Last(&profile).Error)
+		assert.Equal(t, schemas.ResumeContentTypePDF, profile.ResumeContentType)
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf(basePath+"/candidates/%d/resume", profile.ID), nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var resp struct {
+			Data schemas.ResumeURLResponse `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		downloadURL := strings.TrimPrefix(resp.Data.URL, "http://localhost:8080")
+		req, _ = http.NewRequest("GET", downloadURL, nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, pdf, w.Body.Bytes())
+	})
+
+	t.Run("ShouldRejectUnsupportedFileTypes", func(t *testing.T) {
+		clearDatabase()
+		createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Tech Corp",
+			Location: "Silicon Valley",
+			Link:     "http://example.com",
+			Remote:   new(bool),
+			Salary:   50000,
+		})
+		var opening schemas.Opening
+		assert.NoError(t, db.Last(&opening).Error)
+
+		w := createCandidate(opening.ID, "Ada Lovelace", "ada@example.com", "cv.exe", []byte("MZ\x90\x00"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "resume must be a PDF or DOCX file")
+	})
+}
@@ -0,0 +1,130 @@
+package e2e
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/digest_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+func TestDigestE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM digest_subscriptions")
+		db.Exec("DELETE FROM digest_deliveries")
+		smtpServer.Reset()
+	}
+
+	subscribe := func(t *testing.T) schemas.DigestSubscription {
+		w := sendJSON("POST", "/digests", schemas.CreateDigestSubscriptionRequest{Email: "ada@example.com", Cadence: schemas.DigestDaily})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		messages := smtpServer.WaitForMessages(1, 2*time.Second)
+		if len(messages) != 1 {
+			t.Fatalf("expected the confirmation email, got %d messages", len(messages))
+		}
+		text, _ := messages[0].Part("text/plain")
+		token := tokenPattern.FindStringSubmatch(text)
+		if token == nil {
+			t.Fatalf("confirmation link not found in %q", text)
+		}
+
+		w = sendJSON("GET", "/digests/confirm?token="+token[1], nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		smtpServer.Reset()
+
+		var subscription schemas.DigestSubscription
+		if err := db.Last(&subscription).Error; err != nil {
+			t.Fatal(err)
+		}
+		return subscription
+	}
+
+	openingReq := schemas.CreateOpeningRequest{
+		Role:     "Go Developer",
+		Company:  "Digest Corp",
+		Location: "Remote",
+		Link:     "http://example.com/go",
+		Remote:   new(bool),
+		Salary:   7000,
+	}
+
+	t.Run("ShouldSendEachDigestExactlyOnceAcrossRestarts", func(t *testing.T) {
+		clearDatabase()
+		subscription := subscribe(t)
+		assert.Equal(t, schemas.DigestDaily, subscription.Cadence)
+		assert.Equal(t, 8, subscription.NextRunAt.UTC().Hour())
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		otherReq := openingReq
+		otherReq.Role = "Rust Developer"
+		assert.Equal(t, http.StatusCreated, createOpening(otherReq).Code)
+
+		// Nothing is due before the scheduled time.
+		assert.NoError(t, digestScheduler.RunDue(time.Now()))
+		assert.Empty(t, smtpServer.WaitForMessages(1, 100*time.Millisecond))
+
+		sendTime := subscription.NextRunAt.Add(time.Minute)
+		assert.NoError(t, digestScheduler.RunDue(sendTime))
+
+		messages := smtpServer.WaitForMessages(1, 2*time.Second)
+		if !assert.Len(t, messages, 1) {
+			return
+		}
+		parsed, _ := messages[0].Parse()
+		assert.Equal(t, "Your daily digest: 2 new openings", parsed.Header.Get("Subject"))
+		text, _ := messages[0].Part("text/plain")
+		html, _ := messages[0].Part("text/html")
+		assert.Contains(t, text, "* Go Developer at Digest Corp")
+		assert.Contains(t, text, "* Rust Developer at Digest Corp")
+		assert.Contains(t, html, `<a href="http://example.com/go"><strong>Go Developer</strong></a>`)
+
+		// A restarted process shares nothing in memory with the first one.
+		smtpServer.Reset()
+		restarted := digest_usecase.NewScheduler(
+			repositories.NewDigestRepository(db),
+			repositories.NewOpeningRepository(db),
+			newE2EMailer(t),
+			"http://localhost:8080"+basePath,
+			digestSchedule,
+		)
+		assert.NoError(t, restarted.RunDue(sendTime))
+		assert.Empty(t, smtpServer.WaitForMessages(1, 100*time.Millisecond))
+
+		var deliveries []schemas.DigestDelivery
+		db.Where("subscription_id = ?", subscription.ID).Find(&deliveries)
+		if assert.Len(t, deliveries, 1) {
+			assert.Equal(t, schemas.DigestDeliverySent, deliveries[0].Status)
+			assert.Equal(t, 2, deliveries[0].Openings)
+		}
+
+		var updated schemas.DigestSubscription
+		db.First(&updated, subscription.ID)
+		assert.True(t, updated.NextRunAt.Equal(subscription.NextRunAt.AddDate(0, 0, 1)))
+	})
+
+	t.Run("ShouldStopSendingAfterUnsubscribing", func(t *testing.T) {
+		clearDatabase()
+		subscription := subscribe(t)
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+
+		w := sendJSON("GET", "/digests/unsubscribe?token="+subscription.UnsubscribeToken, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.NoError(t, digestScheduler.RunDue(subscription.NextRunAt.Add(time.Minute)))
+		assert.Empty(t, smtpServer.WaitForMessages(1, 100*time.Millisecond))
+	})
+}
+
+func newE2EMailer(t *testing.T) mailer.Mailer {
+	m, err := mailer.NewSMTPMailer(mailer.SMTPConfig{Host: smtpServer.Host(), Port: smtpServer.Port(), From: "no-reply@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
@@ -0,0 +1,136 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestDuplicateOpeningE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	clearDatabase()
+	defer clearDatabase()
+
+	listClusters := func(t *testing.T) [][]uint {
+		req, _ := http.NewRequest("GET", basePath+"/admin/openings/duplicates", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Data []schemas.DuplicateCluster `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		ids := [][]uint{}
+		for _, cluster := range resp.Data {
+			var clusterIDs []uint
+			for _, opening := range cluster.Openings {
+				clusterIDs = append(clusterIDs, opening.ID)
+			}
+			ids = append(ids, clusterIDs)
+		}
+		return ids
+	}
+
+	var original schemas.Opening
+
+	t.Run("ShouldFlagAnOpeningThatDuplicatesAnOpenOne", func(t *testing.T) {
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Senior Go Developer",
+			Company:  "Dedup Corp",
+			Location: "São Paulo",
+			Link:     "https://jobs.example.com/dedup/1",
+			Remote:   new(bool),
+			Salary:   9000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.NoError(t, db.Where("company = ?", "Dedup Corp").First(&original).Error)
+
+		w = createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer Sr.",
+			Company:  "DEDUP CORP Ltda.",
+			Location: "Sao Paulo",
+			Link:     "https://www.linkedin.example.com/jobs/991",
+			Remote:   new(bool),
+			Salary:   9000,
+		})
+
+		var resp struct {
+			DuplicateOf uint `json:"duplicateOf"`
+		}
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, original.ID, resp.DuplicateOf)
+	})
+
+	t.Run("ShouldCreateADifferentRoleWithoutAFlag", func(t *testing.T) {
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Product Designer",
+			Company:  "Dedup Corp",
+			Location: "São Paulo",
+			Link:     "https://jobs.example.com/dedup/2",
+			Remote:   new(bool),
+			Salary:   9000,
+		})
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.NotContains(t, w.Body.String(), "duplicateOf")
+	})
+
+	t.Run("ShouldListTheSuspectedDuplicatesAsACluster", func(t *testing.T) {
+		var flagged schemas.Opening
+		assert.NoError(t, db.Where("duplicate_of = ?", original.ID).First(&flagged).Error)
+
+		assert.Equal(t, [][]uint{{original.ID, flagged.ID}}, listClusters(t))
+	})
+
+	t.Run("ShouldLeaveClosedOpeningsOutOfTheClusters", func(t *testing.T) {
+		w := sendJSON("PUT", fmt.Sprintf("/openings/%d", original.ID), schemas.UpdateOpeningRequest{
+			Status: schemas.OpeningStatusClosed,
+			Salary: 9000,
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, [][]uint{}, listClusters(t))
+	})
+
+	t.Run("ShouldFlagADuplicateWhoseCompanyIsSpelledDifferently", func(t *testing.T) {
+		clearDatabase()
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Data Engineer",
+			Company:  "Typo Labs Inc",
+			Location: "Recife",
+			Link:     "https://jobs.example.com/typo/1",
+			Remote:   new(bool),
+			Salary:   9000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var first schemas.Opening
+		assert.NoError(t, db.Where("company = ?", "Typo Labs Inc").First(&first).Error)
+
+		w = createOpening(schemas.CreateOpeningRequest{
+			Role:     "Data Engineer",
+			Company:  "Typpo Labs Incorporated",
+			Location: "Recife",
+			Link:     "https://careers.example.com/typpo/9",
+			Remote:   new(bool),
+			Salary:   9000,
+		})
+
+		var resp struct {
+			DuplicateOf uint `json:"duplicateOf"`
+		}
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, first.ID, resp.DuplicateOf)
+	})
+}
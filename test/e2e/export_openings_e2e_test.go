@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestExportOpeningsE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	export := func(query string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", basePath+"/openings/export"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	clearDatabase()
+	remote, onSite := true, false
+	// More openings than one batch, so the export has to page through them.
+	for i := 0; i < 520; i++ {
+		company, isRemote := "Export Corp", &remote
+		if i%2 == 1 {
+			company, isRemote = "Other Corp", &onSite
+		}
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     fmt.Sprintf("Developer %03d", i),
+			Company:  company,
+			Location: "Lisbon",
+			Remote:   isRemote,
+			Link:     fmt.Sprintf("http://example.com/%d", i),
+			Salary:   5000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+	}
+
+	t.Run("ShouldExportEveryMatchingOpeningAsCSV", func(t *testing.T) {
+		w := export("?company=Export%20Corp&columns=role,company,remote")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Disposition"), ".csv")
+
+		records, err := csv.NewReader(w.Body).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, records, 261)
+		assert.Equal(t, []string{"role", "company", "remote"}, records[0])
+		assert.Equal(t, []string{"Developer 000", "Export Corp", "true"}, records[1])
+		assert.Equal(t, []string{"Developer 518", "Export Corp", "true"}, records[260])
+	})
+
+	t.Run("ShouldExportNDJSON", func(t *testing.T) {
+		w := export("?format=ndjson&remote=false")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		scanner := bufio.NewScanner(w.Body)
+		lines := 0
+		for scanner.Scan() {
+			var row map[string]interface{}
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+			assert.Equal(t, "Other Corp", row["company"])
+			assert.Equal(t, "open", row["status"])
+			lines++
+		}
+		assert.Equal(t, 260, lines)
+	})
+
+	t.Run("ShouldExportAWorkbook", func(t *testing.T) {
+		w := export("?format=xlsx&columns=role&company=Other%20Corp")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", w.Header().Get("Content-Type"))
+
+		body := w.Body.Bytes()
+		archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		assert.NoError(t, err)
+		for _, f := range archive.File {
+			if f.Name != "xl/worksheets/sheet1.xml" {
+				continue
+			}
+			r, _ := f.Open()
+			sheet, _ := io.ReadAll(r)
+			assert.Equal(t, 261, strings.Count(string(sheet), "<row>"))
+			assert.Contains(t, string(sheet), "Developer 519")
+		}
+	})
+}
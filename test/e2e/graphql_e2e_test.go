@@ -0,0 +1,91 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+)
+
+func TestGraphQLE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	type graphQLResponse struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Errors []handler.GraphQLError     `json:"errors"`
+	}
+
+	send := func(t *testing.T, query string, variables map[string]interface{}) graphQLResponse {
+		w := sendJSON("POST", "/graphql", handler.GraphQLRequest{Query: query, Variables: variables})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp graphQLResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	createMutation := `mutation ($input: CreateOpeningInput!) { createOpening(input: $input) { id role status } }`
+	input := func(role string, salary int) map[string]interface{} {
+		return map[string]interface{}{"input": map[string]interface{}{
+			"role": role, "company": "Graph Corp", "location": "Remote", "remote": true, "link": "http://example.com/graph", "salary": salary,
+		}}
+	}
+
+	t.Run("ShouldCreateQueryUpdateAndDeleteAnOpening", func(t *testing.T) {
+		clearDatabase()
+
+		resp := send(t, createMutation, input("Go Developer", 9000))
+		assert.Empty(t, resp.Errors)
+		var created struct {
+			ID     string `json:"id"`
+			Role   string `json:"role"`
+			Status string `json:"status"`
+		}
+		json.Unmarshal(resp.Data["createOpening"], &created)
+		assert.NotEmpty(t, created.ID)
+		assert.Equal(t, "Go Developer", created.Role)
+		assert.Equal(t, schemas.OpeningStatusOpen, created.Status)
+
+		var outboxCount int64
+		db.Model(&schemas.OutboxEvent{}).Count(&outboxCount)
+		assert.Equal(t, int64(1), outboxCount)
+
+		resp = send(t, `{ openings(filter: {company: "graph corp"}) { role company { name openings { role } } } }`, nil)
+		assert.Empty(t, resp.Errors)
+		assert.JSONEq(t, `[{"role":"Go Developer","company":{"name":"Graph Corp","openings":[{"role":"Go Developer"}]}}]`, string(resp.Data["openings"]))
+
+		resp = send(t, fmt.Sprintf(`mutation { updateOpening(id: "%s", input: {role: "Senior Go Developer", salary: 12000}) { role salary } }`, created.ID), nil)
+		assert.Empty(t, resp.Errors)
+		assert.JSONEq(t, `{"role":"Senior Go Developer","salary":12000}`, string(resp.Data["updateOpening"]))
+
+		resp = send(t, fmt.Sprintf(`mutation { deleteOpening(id: "%s") }`, created.ID), nil)
+		assert.Empty(t, resp.Errors)
+
+		resp = send(t, fmt.Sprintf(`{ opening(id: "%s") { id } }`, created.ID), nil)
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, "NOT_FOUND", resp.Errors[0].Extensions["code"])
+		}
+	})
+
+	t.Run("ShouldApplyTheSameValidationAsTheRESTAPI", func(t *testing.T) {
+		clearDatabase()
+
+		resp := send(t, createMutation, input("Go Developer", 100))
+
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, "salary must be at least 3k", resp.Errors[0].Message)
+			assert.Equal(t, "BAD_REQUEST", resp.Errors[0].Extensions["code"])
+		}
+		assert.Nil(t, resp.Data)
+	})
+}
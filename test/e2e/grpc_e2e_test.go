@@ -0,0 +1,122 @@
+package e2e
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	openingv1 "github.com/valdir-alves3000/go-opportunities/api/opening/v1"
+	"github.com/valdir-alves3000/go-opportunities/internal/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGRPCE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	listener := bufconn.Listen(1 << 20)
+	server := rpc.NewServer(opUsecase)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := openingv1.NewOpeningServiceClient(conn)
+	ctx := context.Background()
+
+	createReq := func(role string) *openingv1.CreateOpeningRequest {
+		return &openingv1.CreateOpeningRequest{
+			Role:     role,
+			Company:  "RPC Corp",
+			Location: "Remote",
+			Remote:   proto.Bool(true),
+			Link:     "http://example.com/rpc",
+			Salary:   9000,
+		}
+	}
+
+	t.Run("ShouldCreateGetUpdateAndDeleteAnOpening", func(t *testing.T) {
+		clearDatabase()
+
+		created, err := client.CreateOpening(ctx, createReq("Go Developer"))
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotZero(t, created.GetId())
+
+		got, err := client.GetOpening(ctx, &openingv1.GetOpeningRequest{Id: created.GetId()})
+		assert.NoError(t, err)
+		assert.Equal(t, "Go Developer", got.GetRole())
+
+		updated, err := client.UpdateOpening(ctx, &openingv1.UpdateOpeningRequest{Id: created.GetId(), Role: "Staff Go Developer", Salary: 15000})
+		assert.NoError(t, err)
+		assert.Equal(t, "Staff Go Developer", updated.GetRole())
+		assert.Equal(t, int64(15000), updated.GetSalary())
+
+		_, err = client.DeleteOpening(ctx, &openingv1.DeleteOpeningRequest{Id: created.GetId()})
+		assert.NoError(t, err)
+
+		_, err = client.GetOpening(ctx, &openingv1.GetOpeningRequest{Id: created.GetId()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ShouldRejectAnInvalidOpening", func(t *testing.T) {
+		clearDatabase()
+
+		req := createReq("Go Developer")
+		req.Remote = nil
+		_, err := client.CreateOpening(ctx, req)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "param: remote (type: bool) is required", status.Convert(err).Message())
+	})
+
+	t.Run("ShouldStreamEveryMatchingOpening", func(t *testing.T) {
+		clearDatabase()
+
+		for i := 0; i < 12; i++ {
+			_, err := client.CreateOpening(ctx, createReq("Go Developer"))
+			assert.NoError(t, err)
+		}
+		_, err := client.CreateOpening(ctx, createReq("Java Developer"))
+		assert.NoError(t, err)
+
+		stream, err := client.ListOpenings(ctx, &openingv1.ListOpeningsRequest{Filter: &openingv1.OpeningFilter{Query: "go"}})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		count := 0
+		for {
+			opening, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "Go Developer", opening.GetRole())
+			count++
+		}
+		assert.Equal(t, 12, count)
+	})
+}
@@ -0,0 +1,135 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+)
+
+func TestIdempotencyE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+		db.Exec("DELETE FROM idempotency_records")
+		db.Exec("DELETE FROM import_jobs")
+	}
+	defer clearDatabase()
+
+	postTo := func(path, contentType, key string, payload []byte) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", basePath+path, bytes.NewBuffer(payload))
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Idempotency-Key", key)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	post := func(key string, body interface{}) *httptest.ResponseRecorder {
+		payload, _ := json.Marshal(body)
+		return postTo("/openings", "application/json", key, payload)
+	}
+
+	remote := true
+	opening := schemas.CreateOpeningRequest{
+		Role:     "Go Developer",
+		Company:  "Retry Corp",
+		Location: "Lisbon",
+		Link:     "http://example.com/retry",
+		Remote:   &remote,
+		Salary:   9000,
+	}
+	countOpenings := func() int64 {
+		var count int64
+		db.Model(&schemas.Opening{}).Count(&count)
+		return count
+	}
+
+	t.Run("ShouldCreateTheOpeningOnceForRetries", func(t *testing.T) {
+		clearDatabase()
+
+		first := post("import-42", opening)
+		second := post("import-42", opening)
+
+		assert.Equal(t, http.StatusCreated, first.Code)
+		assert.Equal(t, http.StatusCreated, second.Code)
+		assert.Equal(t, first.Body.String(), second.Body.String())
+		assert.Empty(t, first.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, int64(1), countOpenings())
+	})
+
+	t.Run("ShouldRejectTheKeyForADifferentBody", func(t *testing.T) {
+		clearDatabase()
+		assert.Equal(t, http.StatusCreated, post("import-43", opening).Code)
+
+		changed := opening
+		changed.Role = "Rust Developer"
+		w := post("import-43", changed)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "Idempotency-Key was already used for a different request")
+		assert.Equal(t, int64(1), countOpenings())
+	})
+
+	t.Run("ShouldReplayValidationErrors", func(t *testing.T) {
+		clearDatabase()
+		invalid := opening
+		invalid.Salary = 10
+
+		first := post("import-44", invalid)
+		second := post("import-44", invalid)
+
+		assert.Equal(t, http.StatusBadRequest, first.Code)
+		assert.Equal(t, http.StatusBadRequest, second.Code)
+		assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("ShouldKeepDistinctKeysApart", func(t *testing.T) {
+		clearDatabase()
+
+		assert.Equal(t, http.StatusCreated, post("import-45", opening).Code)
+		assert.Equal(t, http.StatusCreated, post("import-46", opening).Code)
+
+		assert.Equal(t, int64(2), countOpenings())
+	})
+
+	t.Run("ShouldReplayTheLocationOfAnImportJob", func(t *testing.T) {
+		clearDatabase()
+		var file strings.Builder
+		file.WriteString("role,company,location,remote,link,salary\n")
+		for i := 0; i <= importAsyncRows; i++ {
+			fmt.Fprintf(&file, "Developer %d,Retry Corp,Lisbon,true,http://example.com/%d,9000\n", i, i)
+		}
+
+		first := postTo("/openings/import", "text/csv", "import-47", []byte(file.String()))
+		importUsecase.Wait()
+		second := postTo("/openings/import", "text/csv", "import-47", []byte(file.String()))
+
+		assert.Equal(t, http.StatusAccepted, first.Code)
+		assert.Equal(t, http.StatusAccepted, second.Code)
+		assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+		assert.NotEmpty(t, first.Header().Get("Location"))
+		assert.Equal(t, first.Header().Get("Location"), second.Header().Get("Location"))
+		assert.Equal(t, int64(importAsyncRows+1), countOpenings())
+	})
+
+	t.Run("ShouldRejectABodyOverTheLimit", func(t *testing.T) {
+		clearDatabase()
+
+		w := postTo("/openings/import", "text/csv", "import-48", bytes.Repeat([]byte("a"), handler.DefaultMaxImportSize+1))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "request body too large")
+		var records int64
+		db.Model(&schemas.IdempotencyRecord{}).Count(&records)
+		assert.Zero(t, records)
+	})
+}
@@ -0,0 +1,162 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+)
+
+func sendImport(query, contentType, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", basePath+"/openings/import"+query, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestImportOpeningsE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+		db.Exec("DELETE FROM import_jobs")
+	}
+	defer clearDatabase()
+
+	countOpenings := func() int64 {
+		var count int64
+		db.Model(&schemas.Opening{}).Count(&count)
+		return count
+	}
+
+	readReport := func(t *testing.T, w *httptest.ResponseRecorder) schemas.ImportReport {
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp handler.ImportOpeningsResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp.Data
+	}
+
+	spreadsheet := "Cargo,Empresa,Local,Remoto,Link,Salário\n" +
+		"Go Developer,Import Corp,Lisbon,sim,http://example.com/go,9000\n" +
+		"SRE,Import Corp,Porto,não,http://example.com/sre,12000\n"
+	mapping := "&map[role]=Cargo&map[company]=Empresa&map[location]=Local&map[remote]=Remoto&map[salary]=Sal%C3%A1rio"
+
+	t.Run("ShouldValidateACSVFileWithoutStoringIt", func(t *testing.T) {
+		clearDatabase()
+
+		report := readReport(t, sendImport("?dryRun=true"+mapping, "text/csv", spreadsheet))
+
+		assert.Equal(t, schemas.ImportValidated, report.Result)
+		assert.Equal(t, 2, report.Total)
+		assert.Equal(t, 0, report.Invalid)
+		assert.Equal(t, []schemas.ImportRowResult{{Line: 2}, {Line: 3}}, report.Rows)
+		assert.Equal(t, int64(0), countOpenings())
+	})
+
+	t.Run("ShouldImportACSVFileWithMappedColumns", func(t *testing.T) {
+		clearDatabase()
+
+		report := readReport(t, sendImport("?"+mapping[1:], "text/csv", spreadsheet))
+
+		assert.Equal(t, schemas.ImportImported, report.Result)
+		assert.Equal(t, int64(2), countOpenings())
+
+		var opening schemas.Opening
+		db.First(&opening, report.Rows[1].ID)
+		assert.Equal(t, "SRE", opening.Role)
+		assert.Equal(t, "Porto", opening.Location)
+		assert.False(t, opening.Remote)
+		assert.Equal(t, int64(12000), opening.Salary)
+	})
+
+	t.Run("ShouldRejectTheWholeFileWhenARowIsInvalid", func(t *testing.T) {
+		clearDatabase()
+		file := `{"role":"Go Developer","company":"Import Corp","location":"Lisbon","remote":true,"link":"http://example.com/go","salary":9000}
+{"role":"Go Developer","company":"Import Corp","location":"Lisbon","remote":true,"link":"http://example.com/go","salary":100}
+{"role":"Go Developer","company":"Import Corp","location":"Lisbon","link":"asdf","salary":9000}
+`
+
+		report := readReport(t, sendImport("", "application/x-ndjson", file))
+
+		assert.Equal(t, schemas.ImportRejected, report.Result)
+		assert.Equal(t, 2, report.Invalid)
+		assert.Equal(t, []schemas.ImportRowResult{
+			{Line: 1},
+			{Line: 2, Error: "salary must be at least 3k", Causes: []schemas.ImportRowCause{
+				{Field: "salary", Message: "salary must be at least 3k"},
+			}},
+			{Line: 3, Error: "link must be an absolute http or https URL; param: remote (type: bool) is required", Causes: []schemas.ImportRowCause{
+				{Field: "link", Message: "link must be an absolute http or https URL"},
+				{Field: "remote", Message: "param: remote (type: bool) is required"},
+			}},
+		}, report.Rows)
+		assert.Equal(t, int64(0), countOpenings())
+	})
+
+	t.Run("ShouldRunALargeFileAsAJob", func(t *testing.T) {
+		clearDatabase()
+		var file strings.Builder
+		file.WriteString("role,company,location,remote,link,salary\n")
+		for i := 0; i <= importAsyncRows; i++ {
+			fmt.Fprintf(&file, "Developer %d,Import Corp,Lisbon,true,http://example.com/%d,9000\n", i, i)
+		}
+
+		w := sendImport("", "text/csv", file.String())
+		assert.Equal(t, http.StatusAccepted, w.Code)
+
+		var queued handler.ImportJobResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &queued))
+		location := w.Header().Get("Location")
+		assert.Equal(t, fmt.Sprintf("%s/openings/import/jobs/%d", basePath, queued.Data.ID), location)
+
+		importUsecase.Wait()
+
+		w = sendJSON("GET", strings.TrimPrefix(location, basePath), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var job handler.ImportJobResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+		assert.Equal(t, schemas.ImportJobCompleted, job.Data.Status)
+		assert.Equal(t, importAsyncRows+1, job.Data.Rows)
+		assert.NotNil(t, job.Data.CompletedAt)
+		assert.Equal(t, schemas.ImportImported, job.Data.Report.Result)
+		assert.Equal(t, int64(importAsyncRows+1), countOpenings())
+	})
+
+	t.Run("ShouldFailTheJobsLeftUnfinishedByARestart", func(t *testing.T) {
+		clearDatabase()
+		running := schemas.ImportJob{Status: schemas.ImportJobRunning, Format: schemas.ImportFormatCSV, Rows: 10}
+		completed := schemas.ImportJob{Status: schemas.ImportJobCompleted, Format: schemas.ImportFormatCSV, Rows: 1}
+		db.Create(&running)
+		db.Create(&completed)
+
+		assert.NoError(t, importUsecase.FailInterrupted())
+
+		var job handler.ImportJobResponse
+		w := sendJSON("GET", fmt.Sprintf("/openings/import/jobs/%d", running.ID), nil)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+		assert.Equal(t, schemas.ImportJobFailed, job.Data.Status)
+		assert.Equal(t, schemas.ImportJobInterrupted, job.Data.Error)
+		assert.NotNil(t, job.Data.CompletedAt)
+
+		w = sendJSON("GET", fmt.Sprintf("/openings/import/jobs/%d", completed.ID), nil)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &job))
+		assert.Equal(t, schemas.ImportJobCompleted, job.Data.Status)
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownJob", func(t *testing.T) {
+		w := sendJSON("GET", "/openings/import/jobs/999", nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,54 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestJobPostingE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	clearDatabase()
+	defer clearDatabase()
+
+	remote := true
+	w := createOpening(schemas.CreateOpeningRequest{
+		Role:     "Go Developer",
+		Company:  "Posting Corp",
+		Location: "Lisbon",
+		Remote:   &remote,
+		Link:     "http://example.com/jobs/go",
+		Salary:   9000,
+	})
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var opening schemas.Opening
+	db.Where("company = ?", "Posting Corp").First(&opening)
+
+	t.Run("ShouldServeTheOpeningAsAJobPosting", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("%s/openings/%d", basePath, opening.ID), nil)
+		req.Header.Set("Accept", "application/ld+json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var doc map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+		assert.Equal(t, "JobPosting", doc["@type"])
+		assert.Equal(t, "Go Developer", doc["title"])
+		assert.Equal(t, "TELECOMMUTE", doc["jobLocationType"])
+		assert.Equal(t, opening.CreatedAt.UTC().Format("2006-01-02"), doc["datePosted"])
+		assert.NotEmpty(t, doc["validThrough"])
+		assert.Equal(t, "Posting Corp", doc["hiringOrganization"].(map[string]interface{})["name"])
+	})
+}
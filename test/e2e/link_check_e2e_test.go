@@ -0,0 +1,182 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/link_check_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestLinkCheckE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	clearDatabase()
+	defer clearDatabase()
+
+	server := mocks.NewLinkServerMock()
+	defer server.Close()
+	server.Respond("/jobs/gone", http.StatusNotFound)
+	server.Redirect("/jobs/moved", "/jobs/ok")
+
+	policy := schemas.LinkCheckPolicy{Enabled: true, Interval: 24 * time.Hour, HostDelay: time.Millisecond, Timeout: 5 * time.Second, MaxFailures: 2, AllowPrivateHosts: true}
+	checker := link_check_usecase.NewChecker(repositories.NewOpeningRepository(db), opUsecase, policy)
+
+	ids := map[string]uint{}
+	for _, path := range []string{"/jobs/ok", "/jobs/gone", "/jobs/moved"} {
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Link Check " + path,
+			Company:  "Links Corp " + path,
+			Location: "Remote",
+			Link:     server.URL + path,
+			Remote:   new(bool),
+			Salary:   5000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var opening schemas.Opening
+		assert.NoError(t, db.Where("link = ?", server.URL+path).First(&opening).Error)
+		ids[path] = opening.ID
+	}
+
+	find := func(t *testing.T, path string) schemas.Opening {
+		var opening schemas.Opening
+		assert.NoError(t, db.First(&opening, ids[path]).Error)
+		return opening
+	}
+
+	show := func(id uint, ifNoneMatch string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("%s/openings/%d", basePath, id), nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	now := time.Now()
+
+	t.Run("ShouldRecordTheCheckOfEveryLinkWithoutANewVersion", func(t *testing.T) {
+		assert.NoError(t, checker.CheckDue(context.Background(), now))
+
+		ok := find(t, "/jobs/ok")
+		assert.Equal(t, 200, ok.LinkStatus)
+		assert.NotNil(t, ok.LinkCheckedAt)
+		assert.Equal(t, 0, ok.LinkFailures)
+		assert.Equal(t, uint(1), ok.Version)
+
+		gone := find(t, "/jobs/gone")
+		assert.Equal(t, 404, gone.LinkStatus)
+		assert.Equal(t, "404 Not Found", gone.LinkError)
+		assert.Equal(t, 1, gone.LinkFailures)
+		assert.True(t, gone.IsOpen())
+
+		moved := find(t, "/jobs/moved")
+		assert.Equal(t, 200, moved.LinkStatus)
+		assert.Equal(t, server.URL+"/jobs/ok", moved.LinkRedirect)
+	})
+
+	t.Run("ShouldNotCheckALinkAgainWithinTheInterval", func(t *testing.T) {
+		received := len(server.Received())
+
+		assert.NoError(t, checker.CheckDue(context.Background(), now))
+
+		assert.Len(t, server.Received(), received)
+	})
+
+	t.Run("ShouldPauseAnOpeningWhoseLinkKeepsFailing", func(t *testing.T) {
+		w := show(ids["/jobs/gone"], "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		etag := w.Header().Get("ETag")
+
+		assert.NoError(t, checker.CheckDue(context.Background(), now.Add(25*time.Hour)))
+
+		gone := find(t, "/jobs/gone")
+		assert.Equal(t, schemas.OpeningStatusOpen, gone.Status)
+		assert.True(t, gone.LinkPaused)
+		assert.False(t, gone.IsOpen())
+		assert.Equal(t, 2, gone.LinkFailures)
+		assert.Equal(t, uint(1), gone.Version)
+
+		var events int64
+		db.Model(&schemas.OutboxEvent{}).Where("type = ?", schemas.OpeningUpdatedEvent).Count(&events)
+		assert.Equal(t, int64(0), events)
+
+		w = show(ids["/jobs/gone"], etag)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+
+		w = sendJSON("GET", "/openings?status=open", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "Link Check /jobs/gone")
+		assert.Contains(t, w.Body.String(), "Link Check /jobs/ok")
+
+		ok := find(t, "/jobs/ok")
+		assert.True(t, ok.IsOpen())
+	})
+
+	t.Run("ShouldKeepCheckingAPausedLinkAndResumeItOnceHealthy", func(t *testing.T) {
+		server.Respond("/jobs/gone", http.StatusOK)
+
+		assert.NoError(t, checker.CheckDue(context.Background(), now.Add(50*time.Hour)))
+
+		gone := find(t, "/jobs/gone")
+		assert.False(t, gone.LinkPaused)
+		assert.True(t, gone.IsOpen())
+		assert.Equal(t, 200, gone.LinkStatus)
+		assert.Equal(t, 0, gone.LinkFailures)
+		assert.Equal(t, uint(1), gone.Version)
+	})
+
+	t.Run("ShouldForgetTheChecksOfALinkThatChanged", func(t *testing.T) {
+		stale := find(t, "/jobs/gone")
+		w := sendJSON("PUT", fmt.Sprintf("/openings/%d", ids["/jobs/gone"]), schemas.UpdateOpeningRequest{
+			Link:   server.URL + "/jobs/new",
+			Status: schemas.OpeningStatusOpen,
+			Salary: 5000,
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		gone := find(t, "/jobs/gone")
+		assert.True(t, gone.IsOpen())
+		assert.Equal(t, 0, gone.LinkStatus)
+		assert.Empty(t, gone.LinkError)
+		assert.Nil(t, gone.LinkCheckedAt)
+		assert.Equal(t, 0, gone.LinkFailures)
+		assert.False(t, gone.LinkPaused)
+
+		// A check of the old link that finishes after the change is dropped.
+		stale.LinkStatus = 404
+		stale.LinkFailures = 3
+		stale.LinkPaused = true
+		err := repositories.NewOpeningRepository(db).RecordLinkCheck(&stale)
+		assert.ErrorIs(t, err, repositories.ErrLinkChanged)
+
+		gone = find(t, "/jobs/gone")
+		assert.Equal(t, 0, gone.LinkStatus)
+		assert.False(t, gone.LinkPaused)
+	})
+
+	t.Run("ShouldKeepTheChecksWhenOtherFieldsChange", func(t *testing.T) {
+		w := sendJSON("PUT", fmt.Sprintf("/openings/%d", ids["/jobs/ok"]), schemas.UpdateOpeningRequest{
+			Role:   "Senior Link Checker",
+			Salary: 6000,
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		ok := find(t, "/jobs/ok")
+		assert.Equal(t, 200, ok.LinkStatus)
+		assert.NotNil(t, ok.LinkCheckedAt)
+	})
+}
@@ -0,0 +1,79 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestLinkValidationE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	clearDatabase()
+	defer clearDatabase()
+
+	request := func(link string) schemas.CreateOpeningRequest {
+		return schemas.CreateOpeningRequest{
+			Role:     "Link Validation",
+			Company:  "Valid Links",
+			Location: "Remote",
+			Link:     link,
+			Remote:   new(bool),
+			Salary:   5000,
+		}
+	}
+
+	type errorBody struct {
+		Message string `json:"message"`
+		Causes  []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"causes"`
+	}
+
+	t.Run("ShouldRejectAJavaScriptLinkWithItsCause", func(t *testing.T) {
+		w := createOpening(request("javascript:alert(document.cookie)"))
+
+		var body errorBody
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Len(t, body.Causes, 1)
+		assert.Equal(t, "link", body.Causes[0].Field)
+		assert.Equal(t, "link must be an absolute http or https URL", body.Causes[0].Message)
+	})
+
+	t.Run("ShouldRejectALinkToADeniedHost", func(t *testing.T) {
+		w := createOpening(request("https://careers.spam.example/jobs/1"))
+
+		var body errorBody
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "link points to a host that is not allowed", body.Message)
+	})
+
+	t.Run("ShouldStoreTheNormalizedLink", func(t *testing.T) {
+		w := createOpening(request("https://Careers.Example.com/jobs/1?utm_source=linkedin&team=go#apply"))
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var opening schemas.Opening
+		assert.NoError(t, db.Where("company = ?", "Valid Links").First(&opening).Error)
+		assert.Equal(t, "https://careers.example.com/jobs/1?team=go", opening.Link)
+	})
+
+	t.Run("ShouldRejectAnInvalidLinkOnUpdate", func(t *testing.T) {
+		var opening schemas.Opening
+		assert.NoError(t, db.Where("company = ?", "Valid Links").First(&opening).Error)
+
+		w := sendJSON("PUT", fmt.Sprintf("/openings/%d", opening.ID), schemas.UpdateOpeningRequest{Link: "asdf", Salary: 5000})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"link"`)
+	})
+}
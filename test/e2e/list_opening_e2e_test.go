@@ -250,4 +250,45 @@ func TestListOpeningE2E(t *testing.T) {
 		assert.Equal(t, "Go Developer 48", resp.Data[7].Role)
 	})
 
+	t.Run("ShouldFilterTheOpenings", func(t *testing.T) {
+		clearDatabase()
+
+		remote, onSite := true, false
+		openings := []schemas.CreateOpeningRequest{
+			{Role: "Go Developer", Company: "Acme", Location: "Lisbon, Portugal", Link: "http://example.com/1", Remote: &remote, Salary: 9000},
+			{Role: "Java Developer", Company: "Acme", Location: "Porto, Portugal", Link: "http://example.com/2", Remote: &onSite, Salary: 7000},
+			{Role: "Go Engineer", Company: "Other Corp", Location: "Berlin", Link: "http://example.com/3", Remote: &onSite, Salary: 4000},
+		}
+		for _, openingReq := range openings {
+			assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		}
+
+		for query, roles := range map[string][]string{
+			"q=go":                           {"Go Developer", "Go Engineer"},
+			"company=acme":                   {"Go Developer", "Java Developer"},
+			"location=portugal&remote=false": {"Java Developer"},
+			"minSalary=5000":                 {"Go Developer", "Java Developer"},
+			"q=go&company=acme&status=open":  {"Go Developer"},
+		} {
+			w := sendJSON("GET", "/openings?"+query, nil)
+			assert.Equal(t, http.StatusOK, w.Code, query)
+
+			var resp struct {
+				Data []schemas.OpeningResponse `json:"data"`
+			}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			var got []string
+			for _, opening := range resp.Data {
+				got = append(got, opening.Role)
+			}
+			assert.Equal(t, roles, got, query)
+		}
+
+		w := sendJSON("GET", "/openings?status=closed", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = sendJSON("GET", "/openings?status=archived", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
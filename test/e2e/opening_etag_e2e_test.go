@@ -0,0 +1,160 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+)
+
+func TestOpeningETagE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	send := func(method string, id uint, contentType string, body interface{}, headers map[string]string) *httptest.ResponseRecorder {
+		var payload []byte
+		if raw, ok := body.(string); ok {
+			payload = []byte(raw)
+		} else if body != nil {
+			payload, _ = json.Marshal(body)
+		}
+		req, _ := http.NewRequest(method, fmt.Sprintf("%s/openings/%d", basePath, id), bytes.NewBuffer(payload))
+		req.Header.Set("Content-Type", contentType)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	createStored := func(t *testing.T) schemas.Opening {
+		remote := true
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Version Corp",
+			Location: "Lisbon",
+			Link:     "http://example.com/version",
+			Remote:   &remote,
+			Salary:   9000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var opening schemas.Opening
+		db.Last(&opening)
+		return opening
+	}
+
+	t.Run("ShouldVersionEveryWrite", func(t *testing.T) {
+		clearDatabase()
+		opening := createStored(t)
+		assert.Equal(t, uint(1), opening.Version)
+
+		w := send("GET", opening.ID, "application/json", nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		first := w.Header().Get("ETag")
+		assert.Regexp(t, `^"1-[0-9a-f]+"$`, first)
+
+		w = send("GET", opening.ID, "application/json", nil, map[string]string{"If-None-Match": first})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+
+		w = send("PUT", opening.ID, "application/json", schemas.UpdateOpeningRequest{Role: "Go Engineer", Salary: 9000}, map[string]string{"If-Match": first})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = send("PATCH", opening.ID, schemas.MergePatchMediaType, `{"location":"Porto"}`, map[string]string{"If-Match": `"2"`})
+		assert.Equal(t, http.StatusOK, w.Code)
+		patched := w.Header().Get("ETag")
+		assert.Regexp(t, `^"3-[0-9a-f]+"$`, patched)
+
+		w = send("GET", opening.ID, "application/json", nil, map[string]string{"If-None-Match": first})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, patched, w.Header().Get("ETag"))
+
+		var stored schemas.Opening
+		db.First(&stored, opening.ID)
+		assert.Equal(t, "Go Engineer", stored.Role)
+		assert.Equal(t, "Porto", stored.Location)
+		assert.Equal(t, uint(3), stored.Version)
+		assert.True(t, stored.CreatedAt.Equal(opening.CreatedAt))
+		assert.True(t, stored.UpdatedAt.After(opening.UpdatedAt))
+	})
+
+	t.Run("ShouldChangeTheETagWhenAnApplicationMoves", func(t *testing.T) {
+		clearDatabase()
+		db.Exec("DELETE FROM applications")
+		db.Exec("DELETE FROM application_stage_changes")
+		opening := createStored(t)
+
+		w := createApplication(opening.ID, schemas.CreateApplicationRequest{CandidateName: "Ada Lovelace", Email: "ada@example.com"})
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var application schemas.Application
+		db.Last(&application)
+
+		w = send("GET", opening.ID, "application/json", nil, nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		etag := w.Header().Get("ETag")
+
+		w = sendJSON("POST", fmt.Sprintf("/applications/%d/transitions", application.ID), schemas.TransitionApplicationRequest{Stage: "screening"})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = send("GET", opening.ID, "application/json", nil, map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), `"screening":1`)
+
+		// The version is unchanged, so writes still accept the old tag.
+		w = send("PATCH", opening.ID, schemas.MergePatchMediaType, `{"location":"Porto"}`, map[string]string{"If-Match": etag})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("ShouldRejectWritesBasedOnAStaleVersion", func(t *testing.T) {
+		clearDatabase()
+		opening := createStored(t)
+		w := send("PUT", opening.ID, "application/json", schemas.UpdateOpeningRequest{Role: "Go Engineer", Salary: 9000}, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		stale := map[string]string{"If-Match": `"1"`}
+		w = send("PUT", opening.ID, "application/json", schemas.UpdateOpeningRequest{Role: "Rust Engineer", Salary: 9000}, stale)
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		w = send("PATCH", opening.ID, schemas.MergePatchMediaType, `{"role":"Rust Engineer"}`, stale)
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		w = send("DELETE", opening.ID, "application/json", nil, stale)
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+		var stored schemas.Opening
+		assert.NoError(t, db.First(&stored, opening.ID).Error)
+		assert.Equal(t, "Go Engineer", stored.Role)
+
+		w = send("DELETE", opening.ID, "application/json", nil, map[string]string{"If-Match": `"2"`})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("ShouldNotOverwriteAConcurrentWrite", func(t *testing.T) {
+		clearDatabase()
+		opening := createStored(t)
+		repo := repositories.NewOpeningRepository(db)
+
+		first, second := opening, opening
+		first.Role = "Go Engineer"
+		second.Role = "Rust Engineer"
+
+		assert.NoError(t, repo.Update(&first))
+		assert.ErrorIs(t, repo.Update(&second), repositories.ErrVersionConflict)
+		assert.ErrorIs(t, repo.Delete(opening.ID, opening.Version), repositories.ErrVersionConflict)
+
+		var stored schemas.Opening
+		db.First(&stored, opening.ID)
+		assert.Equal(t, "Go Engineer", stored.Role)
+		assert.Equal(t, uint(2), stored.Version)
+	})
+}
@@ -0,0 +1,97 @@
+package e2e
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestOpeningFeedE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	getFeed := func(path, ifNoneMatch string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	clearDatabase()
+	remote, onSite := true, false
+	for _, o := range []struct {
+		role   string
+		remote *bool
+	}{{"Go Developer", &remote}, {"SRE", &onSite}, {"Data Engineer", &remote}} {
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     o.role,
+			Company:  "Feed Corp",
+			Location: "Lisbon",
+			Remote:   o.remote,
+			Link:     "http://example.com/jobs",
+			Salary:   8000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+	}
+	var goDeveloper schemas.Opening
+	db.Where("role = ?", "Go Developer").First(&goDeveloper)
+	guid := fmt.Sprintf("http://localhost:8080%s/openings/%d", basePath, goDeveloper.ID)
+
+	t.Run("ShouldListTheFilteredOpeningsInEveryFormat", func(t *testing.T) {
+		w := getFeed("/feeds/openings.rss?remote=true", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var rss struct {
+			Items []struct {
+				Title string `xml:"title"`
+				GUID  string `xml:"guid"`
+			} `xml:"channel>item"`
+		}
+		assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), &rss))
+		assert.Len(t, rss.Items, 2)
+		assert.Equal(t, "Go Developer at Feed Corp", rss.Items[1].Title)
+		assert.Equal(t, guid, rss.Items[1].GUID)
+
+		w = getFeed("/feeds/openings.atom?remote=false", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "<title>SRE at Feed Corp</title>")
+		assert.NotContains(t, w.Body.String(), "Go Developer")
+
+		w = getFeed("/feeds/openings.json", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		var jsonFeed struct {
+			Items []struct {
+				ID string `json:"id"`
+			} `json:"items"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &jsonFeed))
+		assert.Len(t, jsonFeed.Items, 3)
+	})
+
+	t.Run("ShouldRevalidateUntilAnOpeningChanges", func(t *testing.T) {
+		etag := getFeed("/feeds/openings.atom", "").Header().Get("ETag")
+		assert.Equal(t, http.StatusNotModified, getFeed("/feeds/openings.atom", etag).Code)
+
+		w := sendJSON("PUT", fmt.Sprintf("/openings/%d", goDeveloper.ID), schemas.UpdateOpeningRequest{Salary: 9500})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = getFeed("/feeds/openings.atom", etag)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), "<id>"+guid+"</id>")
+		assert.Contains(t, w.Body.String(), "Salary: 9500")
+	})
+}
@@ -0,0 +1,86 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func showOpeningRevision(id, rev uint) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", fmt.Sprintf(basePath+"/openings/%d/revisions/%d", id, rev), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func restoreOpeningRevision(id, rev uint) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", fmt.Sprintf(basePath+"/openings/%d/revisions/%d/restore", id, rev), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestOpeningRevisionE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+	}
+
+	type revisionResp struct {
+		Message string                          `json:"message"`
+		Data    schemas.OpeningRevisionResponse `json:"data"`
+	}
+
+	t.Run("ShouldRecordARevisionForEachChangeAndRestoreAsANewRevision", func(t *testing.T) {
+		clearDatabase()
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Tech Corp",
+			Location: "Silicon Valley",
+			Link:     "http://example.com",
+			Remote:   new(bool),
+			Salary:   50000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var opening schemas.Opening
+		assert.NoError(t, db.First(&opening).Error)
+
+		w = updateOpening(opening.ID, schemas.UpdateOpeningRequest{Role: "Rust Developer", Salary: 60000})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = restoreOpeningRevision(opening.ID, 1)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp revisionResp
+		w = showOpeningRevision(opening.ID, 2)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Rust Developer", resp.Data.Opening.Role)
+
+		w = showOpeningRevision(opening.ID, 3)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Go Developer", resp.Data.Opening.Role)
+		assert.Equal(t, int64(50000), resp.Data.Opening.Salary)
+
+		var restored schemas.Opening
+		assert.NoError(t, db.First(&restored, opening.ID).Error)
+		assert.Equal(t, "Go Developer", restored.Role)
+	})
+
+	t.Run("ShouldReturnNotFoundWhenRevisionDoesNotExist", func(t *testing.T) {
+		clearDatabase()
+		w := showOpeningRevision(1, 1)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "revision not found")
+	})
+}
@@ -0,0 +1,122 @@
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/outbox_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestOutboxE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM outbox_events")
+		db.Exec("DELETE FROM webhooks")
+		db.Exec("DELETE FROM webhook_deliveries")
+	}
+	defer clearDatabase()
+
+	openingReq := schemas.CreateOpeningRequest{
+		Role:     "Golang Developer",
+		Company:  "Outbox Corp",
+		Location: "Remote",
+		Link:     "http://example.com/golang",
+		Remote:   new(bool),
+		Salary:   9000,
+	}
+
+	pendingEvents := func() []schemas.OutboxEvent {
+		var rows []schemas.OutboxEvent
+		db.Where("published_at IS NULL").Order("id").Find(&rows)
+		return rows
+	}
+
+	t.Run("ShouldStoreTheEventWithTheOpening", func(t *testing.T) {
+		clearDatabase()
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		var opening schemas.Opening
+		db.Last(&opening)
+
+		rows := pendingEvents()
+		if !assert.Len(t, rows, 1) {
+			return
+		}
+		var event schemas.OpeningEvent
+		assert.NoError(t, json.Unmarshal([]byte(rows[0].Payload), &event))
+		assert.Equal(t, rows[0].EventID, event.ID)
+		assert.Equal(t, schemas.OpeningCreatedEvent, event.Type)
+		assert.Equal(t, opening.ID, event.Opening.ID)
+
+		// A rejected change leaves no event behind.
+		w := sendJSON("DELETE", "/openings/9999", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Len(t, pendingEvents(), 1)
+	})
+
+	t.Run("ShouldRetryUntilThePublisherAcceptsTheEvent", func(t *testing.T) {
+		clearDatabase()
+		server := mocks.NewNATSServerMock(true)
+		defer server.Close()
+		nats, err := events.NewNATSPublisher(events.NATSConfig{URL: server.URL()})
+		assert.NoError(t, err)
+		defer nats.Close()
+		relay := outbox_usecase.NewRelay(repositories.NewOutboxRepository(db), outboxPolicy, nats)
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+
+		server.Reject("Maximum Payload Violation")
+		now := time.Now()
+		assert.EqualError(t, relay.RunDue(now), "outbox event "+pendingEvents()[0].EventID+": nats: Maximum Payload Violation")
+
+		rows := pendingEvents()
+		if !assert.Len(t, rows, 1) {
+			return
+		}
+		assert.Equal(t, 1, rows[0].Attempts)
+		assert.Equal(t, "nats: Maximum Payload Violation", rows[0].LastError)
+
+		server.Reject("")
+		assert.NoError(t, relay.RunDue(now.Add(2*time.Second)))
+
+		assert.Empty(t, pendingEvents())
+		messages := server.Messages()
+		if assert.Len(t, messages, 1) {
+			assert.Equal(t, "go-opportunities.opening.created", messages[0].Subject)
+			assert.Equal(t, rows[0].EventID, messages[0].Headers["Nats-Msg-Id"])
+		}
+	})
+
+	t.Run("ShouldNotQueueAWebhookTwiceWhenAnEventIsRepublished", func(t *testing.T) {
+		clearDatabase()
+		server := mocks.NewNATSServerMock(true)
+		defer server.Close()
+		nats, _ := events.NewNATSPublisher(events.NATSConfig{URL: server.URL()})
+		defer nats.Close()
+
+		w := sendJSON("POST", "/webhooks", schemas.CreateWebhookRequest{URL: "http://localhost:1/hook", Secret: "s3cret"})
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+
+		server.Reject("Authorization Violation")
+		relay := outbox_usecase.NewRelay(repositories.NewOutboxRepository(db), outboxPolicy, webhookDispatcher, nats)
+		assert.Error(t, relay.RunDue(time.Now()))
+
+		// A restarted relay publishes the event to every publisher again.
+		server.Reject("")
+		restarted := outbox_usecase.NewRelay(repositories.NewOutboxRepository(db), outboxPolicy, webhookDispatcher, nats)
+		assert.NoError(t, restarted.RunDue(time.Now().Add(time.Minute)))
+
+		var deliveries int64
+		db.Model(&schemas.WebhookDelivery{}).Count(&deliveries)
+		assert.Equal(t, int64(1), deliveries)
+		assert.Len(t, server.Messages(), 1)
+	})
+}
@@ -0,0 +1,102 @@
+package e2e
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestPatchOpeningE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	sendPatch := func(id uint, contentType, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("PATCH", fmt.Sprintf("%s/openings/%d", basePath, id), bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	createStored := func(t *testing.T) schemas.Opening {
+		remote := true
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Patch Corp",
+			Location: "Lisbon",
+			Link:     "http://example.com/patch",
+			Remote:   &remote,
+			Salary:   9000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var opening schemas.Opening
+		db.Last(&opening)
+		return opening
+	}
+
+	t.Run("ShouldApplyAMergePatch", func(t *testing.T) {
+		clearDatabase()
+		opening := createStored(t)
+
+		w := sendPatch(opening.ID, schemas.MergePatchMediaType, `{"remote":false,"location":"Porto"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var stored schemas.Opening
+		db.First(&stored, opening.ID)
+		assert.False(t, stored.Remote)
+		assert.Equal(t, "Porto", stored.Location)
+		assert.Equal(t, int64(9000), stored.Salary)
+	})
+
+	t.Run("ShouldApplyAJSONPatch", func(t *testing.T) {
+		clearDatabase()
+		opening := createStored(t)
+
+		w := sendPatch(opening.ID, schemas.JSONPatchMediaType, `[
+			{"op": "test", "path": "/salary", "value": 9000},
+			{"op": "replace", "path": "/salary", "value": 11000},
+			{"op": "copy", "from": "/company", "path": "/role"}
+		]`)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var stored schemas.Opening
+		db.First(&stored, opening.ID)
+		assert.Equal(t, int64(11000), stored.Salary)
+		assert.Equal(t, "Patch Corp", stored.Role)
+	})
+
+	t.Run("ShouldNotStoreAPatchThatFailsValidation", func(t *testing.T) {
+		clearDatabase()
+		opening := createStored(t)
+
+		w := sendPatch(opening.ID, schemas.MergePatchMediaType, `{"link":null,"role":"Go Engineer"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "param: link (type: string) is required")
+
+		w = sendPatch(opening.ID, schemas.JSONPatchMediaType, `[{"op":"test","path":"/salary","value":1}]`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var stored schemas.Opening
+		db.First(&stored, opening.ID)
+		assert.Equal(t, "Go Developer", stored.Role)
+		assert.Equal(t, "http://example.com/patch", stored.Link)
+	})
+
+	t.Run("ShouldReturnNotFoundForAMissingOpening", func(t *testing.T) {
+		clearDatabase()
+
+		w := sendPatch(9999, schemas.MergePatchMediaType, `{"role":"Go Engineer"}`)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
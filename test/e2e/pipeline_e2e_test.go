@@ -0,0 +1,120 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func sendJSON(method, path string, body interface{}) *httptest.ResponseRecorder {
+	reqJsonBody, _ := json.Marshal(body)
+	req, _ := http.NewRequest(method, basePath+path, bytes.NewBuffer(reqJsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestPipelineE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM applications")
+		db.Exec("DELETE FROM application_stage_changes")
+		db.Exec("DELETE FROM pipelines")
+	}
+
+	seedApplication := func(t *testing.T) (schemas.Opening, schemas.Application) {
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Pipeline Corp",
+			Location: "Remote",
+			Link:     "http://example.com",
+			Remote:   new(bool),
+			Salary:   50000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var opening schemas.Opening
+		if err := db.Last(&opening).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		w = createApplication(opening.ID, schemas.CreateApplicationRequest{CandidateName: "Ada Lovelace", Email: "ada@example.com"})
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var application schemas.Application
+		if err := db.Last(&application).Error; err != nil {
+			t.Fatal(err)
+		}
+		return opening, application
+	}
+
+	t.Run("ShouldMoveAnApplicationThroughTheCompanyPipeline", func(t *testing.T) {
+		clearDatabase()
+
+		w := sendJSON("PUT", "/companies/Pipeline Corp/pipeline", schemas.DefinePipelineRequest{
+			Stages: []string{"applied", "tech-test", "hired", "rejected"},
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		opening, application := seedApplication(t)
+		assert.Equal(t, schemas.StageApplied, application.Status)
+
+		var pipelineResp struct {
+			Data schemas.PipelineResponse `json:"data"`
+		}
+		w = sendJSON("GET", fmt.Sprintf("/openings/%d/pipeline", opening.ID), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &pipelineResp))
+		assert.Equal(t, schemas.PipelineSourceCompany, pipelineResp.Data.Source)
+
+		w = sendJSON("POST", fmt.Sprintf("/applications/%d/transitions", application.ID), schemas.TransitionApplicationRequest{Stage: "tech-test", Note: "sent the challenge"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), fmt.Sprintf("application with id: %d moved to tech-test", application.ID))
+
+		w = sendJSON("POST", fmt.Sprintf("/applications/%d/transitions", application.ID), schemas.TransitionApplicationRequest{Stage: "interview"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "stage interview is not part of the pipeline")
+
+		var historyResp struct {
+			Data []schemas.ApplicationStageChange `json:"data"`
+		}
+		w = sendJSON("GET", fmt.Sprintf("/applications/%d/history", application.ID), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &historyResp))
+		if assert.Len(t, historyResp.Data, 2) {
+			assert.Equal(t, "", historyResp.Data[0].FromStage)
+			assert.Equal(t, schemas.StageApplied, historyResp.Data[0].ToStage)
+			assert.Equal(t, schemas.StageApplied, historyResp.Data[1].FromStage)
+			assert.Equal(t, "tech-test", historyResp.Data[1].ToStage)
+			assert.Equal(t, "sent the challenge", historyResp.Data[1].Note)
+			assert.False(t, historyResp.Data[1].ChangedAt.IsZero())
+		}
+
+		var openingResp struct {
+			Data schemas.Opening `json:"data"`
+		}
+		w = showOpening(opening.ID)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &openingResp))
+		assert.Equal(t, map[string]int64{"applied": 0, "tech-test": 1, "hired": 0, "rejected": 0}, openingResp.Data.StageCounts)
+	})
+
+	t.Run("ShouldNotRemoveAStageInUse", func(t *testing.T) {
+		clearDatabase()
+		opening, _ := seedApplication(t)
+
+		w := sendJSON("PUT", fmt.Sprintf("/openings/%d/pipeline", opening.ID), schemas.DefinePipelineRequest{
+			Stages: []string{"new", "hired"},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "stage applied is in use by applications and cannot be removed")
+	})
+}
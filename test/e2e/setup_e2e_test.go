@@ -10,18 +10,50 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/valdir-alves3000/go-opportunities/config"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/alert_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/application_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/candidate_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/digest_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/idempotency_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/import_usecase"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/outbox_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/pipeline_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/sitemap_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/webhook_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/internal/graphql"
 	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
 	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 var (
-	router   *gin.Engine
-	db       *gorm.DB
-	logger   *config.Logger
-	basePath = "/api/v1"
+	router            *gin.Engine
+	db                *gorm.DB
+	logger            *config.Logger
+	smtpServer        *mocks.SMTPServerMock
+	digestScheduler   *digest_usecase.Scheduler
+	digestSchedule    = schemas.DigestSchedule{Hour: 8, Weekday: time.Monday, Location: time.UTC}
+	alertDispatcher   *alert_usecase.Dispatcher
+	webhookDispatcher *webhook_usecase.Dispatcher
+	outboxRelay       *outbox_usecase.Relay
+	broker            *events.Broker
+	opUsecase         *opening_usecase.OpeningUseCase
+	importUsecase     *import_usecase.ImportUseCase
+	importAsyncRows   = 5
+	sitemapPageSize   = 2
+	webhookPolicy     = schemas.WebhookPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour, Timeout: 5 * time.Second, AllowPrivateHosts: true}
+	outboxPolicy      = schemas.OutboxPolicy{BaseDelay: time.Second, MaxDelay: time.Minute}
+	alertPolicy       = schemas.AlertPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour}
+	idempotencyPolicy = schemas.IdempotencyPolicy{TTL: time.Hour}
+	duplicatePolicy   = schemas.DuplicatePolicy{Mode: schemas.DuplicateModeFlag, Threshold: schemas.DefaultDuplicateThreshold}
+	linkPolicy        = schemas.LinkPolicy{DeniedHosts: []string{"spam.example"}}
+	basePath          = "/api/v1"
 )
 
 func setupE2E() {
@@ -55,23 +87,157 @@ func setupE2E() {
 		panic(fmt.Sprintf("failed to connect to database: %v", err))
 	}
 
-	err = db.AutoMigrate(&schemas.Opening{})
+	err = db.AutoMigrate(
+		&schemas.Opening{},
+		&schemas.OpeningRevision{},
+		&schemas.Application{},
+		&schemas.CandidateProfile{},
+		&schemas.Pipeline{},
+		&schemas.ApplicationStageChange{},
+		&schemas.JobAlert{},
+		&schemas.JobAlertDelivery{},
+		&schemas.DigestSubscription{},
+		&schemas.DigestDelivery{},
+		&schemas.Webhook{},
+		&schemas.WebhookDelivery{},
+		&schemas.WebhookAttempt{},
+		&schemas.OutboxEvent{},
+		&schemas.IdempotencyRecord{},
+		&schemas.ImportJob{},
+	)
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate database: %v", err))
 	}
 
 	opRepo := repositories.NewOpeningRepository(db)
-	opUsecase := opening_usecase.NewOpeningUseCase(opRepo)
+	appRepo := repositories.NewApplicationRepository(db)
+	pipelineRepo := repositories.NewPipelineRepository(db)
+	pipelineUsecase := pipeline_usecase.NewPipelineUseCase(pipelineRepo, opRepo, appRepo)
+	pipelineHandler := handler.NewPipelineHandler(pipelineUsecase)
+
+	smtpServer = mocks.NewSMTPServerMock()
+	mail, err := mailer.NewSMTPMailer(mailer.SMTPConfig{
+		Host: smtpServer.Host(),
+		Port: smtpServer.Port(),
+		From: "no-reply@example.com",
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to create mailer: %v", err))
+	}
+	alertRepo := repositories.NewJobAlertRepository(db)
+	alertUsecase := alert_usecase.NewAlertUseCase(alertRepo, mail, "http://localhost:8080"+basePath)
+	alertHandler := handler.NewAlertHandler(alertUsecase)
+	alertDispatcher = alert_usecase.NewDispatcher(alertRepo, mail, "http://localhost:8080"+basePath, alertPolicy)
+
+	digestRepo := repositories.NewDigestRepository(db)
+	digestUsecase := digest_usecase.NewDigestUseCase(digestRepo, mail, "http://localhost:8080"+basePath, digestSchedule)
+	digestHandler := handler.NewDigestHandler(digestUsecase)
+	digestScheduler = digest_usecase.NewScheduler(digestRepo, opRepo, mail, "http://localhost:8080"+basePath, digestSchedule)
+
+	webhookRepo := repositories.NewWebhookRepository(db)
+	webhookHandler := handler.NewWebhookHandler(webhook_usecase.NewWebhookUseCase(webhookRepo))
+	webhookDispatcher = webhook_usecase.NewDispatcher(webhookRepo, webhookPolicy)
+	broker = events.NewBroker(10)
+	streamHandler := handler.NewOpeningStreamHandler(broker, time.Second)
+	outboxRelay = outbox_usecase.NewRelay(repositories.NewOutboxRepository(db), outboxPolicy, alertDispatcher, webhookDispatcher, broker)
+
+	sitemapUsecase := sitemap_usecase.NewSitemapUseCase(opRepo, "http://localhost:8080"+basePath, sitemapPageSize)
+
+	opUsecase = opening_usecase.NewOpeningUseCase(
+		opRepo,
+		opening_usecase.WithStageCounter(pipelineUsecase),
+		opening_usecase.WithNotifier(outboxRelay),
+		opening_usecase.WithNotifier(sitemapUsecase),
+		opening_usecase.WithDuplicatePolicy(duplicatePolicy),
+		opening_usecase.WithLinkPolicy(linkPolicy),
+	)
 	opHandler := handler.NewOpeningHandler(opUsecase)
 
+	importUsecase = import_usecase.NewImportUseCase(opUsecase, repositories.NewImportJobRepository(db), importAsyncRows)
+	importHandler := handler.NewImportHandler(importUsecase, handler.DefaultMaxImportSize, basePath)
+
+	graphQLSchema, err := graphql.NewSchema(opUsecase)
+	if err != nil {
+		panic(fmt.Sprintf("failed to build graphql schema: %v", err))
+	}
+	graphQLHandler := handler.NewGraphQLHandler(graphQLSchema, false, basePath+"/graphql")
+
+	appUsecase := application_usecase.NewApplicationUseCase(appRepo, opRepo, pipelineRepo)
+	appHandler := handler.NewApplicationHandler(appUsecase)
+
+	blobs, err := storage.NewLocalStorage("./db/storage", "http://localhost:8080"+basePath, []byte("e2e-secret"))
+	if err != nil {
+		panic(fmt.Sprintf("failed to create storage: %v", err))
+	}
+	candRepo := repositories.NewCandidateProfileRepository(db)
+	candUsecase := candidate_usecase.NewCandidateUseCase(candRepo, opRepo, blobs, candidate_usecase.DefaultResumeLimits)
+	candHandler := handler.NewCandidateHandler(candUsecase, candidate_usecase.DefaultResumeLimits.MaxSize)
+
+	idemUsecase := idempotency_usecase.NewIdempotencyUseCase(repositories.NewIdempotencyRepository(db), idempotencyPolicy)
+
 	// Route Definitions
+	feedHandler := handler.NewFeedHandler(opUsecase, "http://localhost:8080"+basePath)
+	router.GET("/feeds/openings.rss", feedHandler.RSS)
+	router.GET("/feeds/openings.atom", feedHandler.Atom)
+	router.GET("/feeds/openings.json", feedHandler.JSON)
+
+	sitemapHandler := handler.NewSitemapHandler(sitemapUsecase)
+	router.GET("/sitemap.xml", sitemapHandler.Index)
+	router.GET("/sitemaps/:file", sitemapHandler.Page)
+	router.GET("/robots.txt", sitemapHandler.Robots)
+
 	v1 := router.Group(basePath)
+	v1.Use(handler.Idempotency(idemUsecase, handler.DefaultMaxImportSize))
 	{
 		v1.POST("/openings", opHandler.Create)
+		v1.POST("/openings/bulk", opHandler.Bulk)
+		v1.POST("/openings/import", importHandler.Import)
+		v1.GET("/openings/import/jobs/:id", importHandler.ShowJob)
 		v1.GET("/openings", opHandler.List)
+		v1.GET("/openings/export", opHandler.Export)
+		v1.GET("/openings/stream", streamHandler.Stream)
 		v1.GET("/openings/:id", opHandler.ShowOpening)
 		v1.DELETE("/openings/:id", opHandler.Delete)
 		v1.PUT("/openings/:id", opHandler.Update)
+		v1.PATCH("/openings/:id", opHandler.Patch)
+		v1.GET("/openings/:id/revisions/:rev", opHandler.ShowRevision)
+		v1.POST("/openings/:id/revisions/:rev/restore", opHandler.RestoreRevision)
+
+		v1.POST("/openings/:id/applications", appHandler.Create)
+		v1.GET("/openings/:id/applications", appHandler.List)
+		v1.GET("/applications/:id", appHandler.ShowApplication)
+		v1.POST("/applications/:id/transitions", appHandler.Transition)
+		v1.GET("/applications/:id/history", appHandler.History)
+
+		v1.GET("/openings/:id/pipeline", pipelineHandler.ShowOpeningPipeline)
+		v1.PUT("/openings/:id/pipeline", pipelineHandler.DefineOpeningPipeline)
+		v1.PUT("/companies/:company/pipeline", pipelineHandler.DefineCompanyPipeline)
+
+		v1.POST("/openings/:id/candidates", candHandler.Create)
+		v1.GET("/candidates/:id", candHandler.ShowCandidate)
+		v1.GET("/candidates/:id/resume", candHandler.ResumeURL)
+		v1.GET("/files/*key", handler.NewFileHandler(blobs).Download)
+
+		v1.POST("/alerts", alertHandler.Subscribe)
+		v1.GET("/alerts/confirm", alertHandler.Confirm)
+		v1.GET("/alerts/unsubscribe", alertHandler.Unsubscribe)
+		v1.POST("/alerts/unsubscribe", alertHandler.Unsubscribe)
+
+		v1.POST("/digests", digestHandler.Subscribe)
+		v1.GET("/digests/confirm", digestHandler.Confirm)
+		v1.GET("/digests/unsubscribe", digestHandler.Unsubscribe)
+		v1.POST("/digests/unsubscribe", digestHandler.Unsubscribe)
+
+		v1.POST("/graphql", graphQLHandler.Query)
+		v1.GET("/graphql", graphQLHandler.Get)
+
+		v1.POST("/webhooks", webhookHandler.Create)
+		v1.GET("/webhooks", webhookHandler.List)
+		v1.DELETE("/webhooks/:id", webhookHandler.Delete)
+		v1.GET("/webhooks/:id/deliveries", webhookHandler.Deliveries)
+		v1.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
+
+		v1.GET("/admin/openings/duplicates", opHandler.ListDuplicates)
 	}
 }
 
@@ -82,6 +248,7 @@ func tearDownE2E() {
 	}
 
 	sqlDB.Close()
+	smtpServer.Close()
 	err = os.Remove("./db/test.db")
 	if err != nil {
 		logger.Errorf("Error removing test database: %v", err)
@@ -103,6 +103,11 @@ func TestShowOpeningE2E(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.NoError(t, err)
 		assert.Equal(t, "show-opening successfully", resp.Message)
+
+		opening.StageCounts = map[string]int64{}
+		for _, stage := range schemas.DefaultPipelineStages {
+			opening.StageCounts[stage] = 0
+		}
 		assert.Equal(t, opening, resp.Data)
 	})
 }
@@ -0,0 +1,83 @@
+package e2e
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestSitemapE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM opening_revisions")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	countSitemaps := func(t *testing.T) int {
+		w := get("/sitemap.xml")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var index struct {
+			Sitemaps []struct {
+				Loc string `xml:"loc"`
+			} `xml:"sitemap"`
+		}
+		assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), &index))
+		return len(index.Sitemaps)
+	}
+
+	clearDatabase()
+	remote := true
+	for i := 0; i < 3; i++ {
+		w := createOpening(schemas.CreateOpeningRequest{
+			Role:     fmt.Sprintf("Developer %d", i),
+			Company:  "Sitemap Corp",
+			Location: "Lisbon",
+			Remote:   &remote,
+			Link:     "http://example.com/jobs",
+			Salary:   8000,
+		})
+		assert.Equal(t, http.StatusCreated, w.Code)
+	}
+	var openings []schemas.Opening
+	db.Order("id").Find(&openings)
+
+	t.Run("ShouldListEveryOpeningAcrossPages", func(t *testing.T) {
+		assert.Equal(t, 2, countSitemaps(t))
+
+		w := get("/sitemaps/openings-2.xml")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), fmt.Sprintf("<loc>http://localhost:8080%s/openings/%d</loc>", basePath, openings[2].ID))
+		assert.Contains(t, w.Body.String(), "<lastmod>"+openings[2].UpdatedAt.UTC().Format("2006-01-02T15:04:05Z")+"</lastmod>")
+
+		assert.Equal(t, http.StatusNotFound, get("/sitemaps/openings-3.xml").Code)
+	})
+
+	t.Run("ShouldDropDeletedOpenings", func(t *testing.T) {
+		w := sendJSON("DELETE", fmt.Sprintf("/openings/%d", openings[2].ID), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, 1, countSitemaps(t))
+		assert.NotContains(t, get("/sitemaps/openings-1.xml").Body.String(), fmt.Sprintf("/openings/%d<", openings[2].ID))
+	})
+
+	t.Run("ShouldReferenceTheSitemapFromRobots", func(t *testing.T) {
+		w := get("/robots.txt")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Sitemap: http://localhost:8080/sitemap.xml\n")
+	})
+}
@@ -0,0 +1,114 @@
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestStreamOpeningsE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	openStream := func(t *testing.T, query, lastEventID string) *bufio.Scanner {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		t.Cleanup(cancel)
+
+		req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+basePath+"/openings/stream?"+query, nil)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() && scanner.Text() != ": connected" {
+		}
+		return scanner
+	}
+
+	// nextEvent reads the stream up to the next event.
+	nextEvent := func(scanner *bufio.Scanner) (string, schemas.OpeningEvent) {
+		var id string
+		var event schemas.OpeningEvent
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "id: ") {
+				id = strings.TrimPrefix(line, "id: ")
+			}
+			if strings.HasPrefix(line, "data: ") {
+				json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event)
+				break
+			}
+		}
+		return id, event
+	}
+
+	openingReq := func(company string) schemas.CreateOpeningRequest {
+		return schemas.CreateOpeningRequest{
+			Role:     "Golang Developer",
+			Company:  company,
+			Location: "Remote",
+			Link:     "http://example.com/golang",
+			Remote:   new(bool),
+			Salary:   9000,
+		}
+	}
+
+	t.Run("ShouldStreamTheChangesOfTheFilteredOpenings", func(t *testing.T) {
+		clearDatabase()
+		scanner := openStream(t, "company=stream%20corp", "")
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq("Other Corp")).Code)
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq("Stream Corp")).Code)
+		var opening schemas.Opening
+		db.Last(&opening)
+		assert.Equal(t, http.StatusOK, sendJSON("DELETE", fmt.Sprintf("/openings/%d", opening.ID), nil).Code)
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+
+		id, created := nextEvent(scanner)
+		assert.Equal(t, created.ID, id)
+		assert.Equal(t, schemas.OpeningCreatedEvent, created.Type)
+		assert.Equal(t, "Stream Corp", created.Opening.Company)
+
+		_, deleted := nextEvent(scanner)
+		assert.Equal(t, schemas.OpeningDeletedEvent, deleted.Type)
+		assert.Equal(t, opening.ID, deleted.Opening.ID)
+	})
+
+	t.Run("ShouldResumeFromTheLastEventID", func(t *testing.T) {
+		clearDatabase()
+		first := openStream(t, "", "")
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq("Resume Corp")).Code)
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq("Resume Corp")).Code)
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+
+		lastID, _ := nextEvent(first)
+		missedID, _ := nextEvent(first)
+
+		resumed := openStream(t, "", lastID)
+		id, event := nextEvent(resumed)
+		assert.Equal(t, missedID, id)
+		assert.Equal(t, "Resume Corp", event.Opening.Company)
+	})
+}
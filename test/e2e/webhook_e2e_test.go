@@ -0,0 +1,213 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/webhook_usecase"
+)
+
+type webhookRequest struct {
+	Header http.Header
+	Body   []byte
+}
+
+// webhookReceiver records the requests it gets and answers with the next
+// queued status, or 200 once the queue is empty.
+type webhookReceiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []webhookRequest
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, webhookRequest{Header: req.Header.Clone(), Body: body})
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *webhookReceiver) Requests() []webhookRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]webhookRequest(nil), r.requests...)
+}
+
+func TestWebhookE2E(t *testing.T) {
+	clearDatabase := func() {
+		db.Exec("DELETE FROM openings")
+		db.Exec("DELETE FROM webhooks")
+		db.Exec("DELETE FROM webhook_deliveries")
+		db.Exec("DELETE FROM webhook_attempts")
+		db.Exec("DELETE FROM outbox_events")
+	}
+	defer clearDatabase()
+
+	openingReq := schemas.CreateOpeningRequest{
+		Role:     "Golang Developer",
+		Company:  "Hook Corp",
+		Location: "Remote",
+		Link:     "http://example.com/golang",
+		Remote:   new(bool),
+		Salary:   9000,
+	}
+
+	createWebhook := func(t *testing.T, req schemas.CreateWebhookRequest) schemas.Webhook {
+		w := sendJSON("POST", "/webhooks", req)
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response struct {
+			Data schemas.Webhook `json:"data"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response.Data
+	}
+
+	listDeliveries := func(t *testing.T, webhookID uint) []schemas.WebhookDelivery {
+		w := sendJSON("GET", fmt.Sprintf("/webhooks/%d/deliveries", webhookID), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Data []schemas.WebhookDelivery `json:"data"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		return response.Data
+	}
+
+	t.Run("ShouldDeliverSignedEventsForSubscribedTypes", func(t *testing.T) {
+		clearDatabase()
+		receiver := &webhookReceiver{}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		webhook := createWebhook(t, schemas.CreateWebhookRequest{
+			URL:    server.URL,
+			Secret: "s3cret",
+			Events: []string{schemas.OpeningCreatedEvent, schemas.OpeningUpdatedEvent},
+		})
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		var opening schemas.Opening
+		db.Last(&opening)
+
+		w := sendJSON("PUT", fmt.Sprintf("/openings/%d", opening.ID), schemas.UpdateOpeningRequest{Salary: 12000})
+		assert.Equal(t, http.StatusOK, w.Code)
+		w = sendJSON("DELETE", fmt.Sprintf("/openings/%d", opening.ID), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+		assert.NoError(t, webhookDispatcher.RunDue(time.Now()))
+
+		requests := receiver.Requests()
+		if !assert.Len(t, requests, 2) {
+			return
+		}
+
+		var created, updated schemas.OpeningEvent
+		assert.NoError(t, json.Unmarshal(requests[0].Body, &created))
+		assert.NoError(t, json.Unmarshal(requests[1].Body, &updated))
+		assert.Equal(t, schemas.OpeningCreatedEvent, created.Type)
+		assert.Equal(t, opening.ID, created.Opening.ID)
+		assert.Equal(t, schemas.OpeningUpdatedEvent, updated.Type)
+		assert.Equal(t, int64(12000), updated.Opening.Salary)
+		assert.NotEqual(t, created.ID, updated.ID)
+
+		for _, req := range requests {
+			timestamp := req.Header.Get("X-Webhook-Timestamp")
+			assert.Equal(t, webhook_usecase.Sign("s3cret", timestamp, req.Body), req.Header.Get("X-Webhook-Signature"))
+		}
+
+		deliveries := listDeliveries(t, webhook.ID)
+		if assert.Len(t, deliveries, 2) {
+			for _, delivery := range deliveries {
+				assert.Equal(t, schemas.WebhookDeliverySucceeded, delivery.Status)
+				assert.Len(t, delivery.AttemptLog, 1)
+			}
+		}
+	})
+
+	t.Run("ShouldRetryFailedDeliveriesAndRedeliverOnRequest", func(t *testing.T) {
+		clearDatabase()
+		receiver := &webhookReceiver{statuses: []int{
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+		}}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		webhook := createWebhook(t, schemas.CreateWebhookRequest{URL: server.URL, Secret: "s3cret"})
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+
+		now := time.Now()
+		assert.NoError(t, webhookDispatcher.RunDue(now))
+
+		// Nothing is retried before the backoff has passed.
+		assert.NoError(t, webhookDispatcher.RunDue(now.Add(30*time.Second)))
+		assert.Len(t, receiver.Requests(), 1)
+
+		assert.NoError(t, webhookDispatcher.RunDue(now.Add(2*time.Minute)))
+		assert.NoError(t, webhookDispatcher.RunDue(now.Add(10*time.Minute)))
+		assert.Len(t, receiver.Requests(), 3)
+
+		deliveries := listDeliveries(t, webhook.ID)
+		if !assert.Len(t, deliveries, 1) {
+			return
+		}
+		assert.Equal(t, schemas.WebhookDeliveryFailed, deliveries[0].Status)
+		assert.Equal(t, 3, deliveries[0].Attempts)
+		if assert.Len(t, deliveries[0].AttemptLog, 3) {
+			assert.Equal(t, http.StatusInternalServerError, deliveries[0].AttemptLog[0].StatusCode)
+			assert.Equal(t, http.StatusServiceUnavailable, deliveries[0].AttemptLog[2].StatusCode)
+		}
+
+		w := sendJSON("POST", fmt.Sprintf("/webhooks/%d/deliveries/%d/redeliver", webhook.ID, deliveries[0].ID), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, webhookDispatcher.RunDue(time.Now()))
+
+		requests := receiver.Requests()
+		if assert.Len(t, requests, 4) {
+			assert.Equal(t, requests[0].Body, requests[3].Body)
+			assert.Equal(t, requests[0].Header.Get("X-Webhook-ID"), requests[3].Header.Get("X-Webhook-ID"))
+		}
+
+		deliveries = listDeliveries(t, webhook.ID)
+		assert.Equal(t, schemas.WebhookDeliverySucceeded, deliveries[0].Status)
+		assert.Len(t, deliveries[0].AttemptLog, 4)
+	})
+
+	t.Run("ShouldStopSendingToDeletedWebhooks", func(t *testing.T) {
+		clearDatabase()
+		receiver := &webhookReceiver{}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		webhook := createWebhook(t, schemas.CreateWebhookRequest{URL: server.URL, Secret: "s3cret"})
+		w := sendJSON("DELETE", fmt.Sprintf("/webhooks/%d", webhook.ID), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, http.StatusCreated, createOpening(openingReq).Code)
+		assert.NoError(t, outboxRelay.RunDue(time.Now()))
+		assert.NoError(t, webhookDispatcher.RunDue(time.Now()))
+
+		assert.Empty(t, receiver.Requests())
+		w = sendJSON("GET", fmt.Sprintf("/webhooks/%d/deliveries", webhook.ID), nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type AlertUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *AlertUseCaseMock) Subscribe(ca schemas.CreateJobAlertRequest) *internal_error.InternalError {
+	args := m.Called(ca)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *AlertUseCaseMock) Confirm(token string) (*schemas.JobAlert, *internal_error.InternalError) {
+	args := m.Called(token)
+	return args.Get(0).(*schemas.JobAlert), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *AlertUseCaseMock) Unsubscribe(token string) *internal_error.InternalError {
+	args := m.Called(token)
+	return args.Get(0).(*internal_error.InternalError)
+}
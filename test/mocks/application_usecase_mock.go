@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type ApplicationUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *ApplicationUseCaseMock) Create(openingID uint, ca schemas.CreateApplicationRequest) *internal_error.InternalError {
+	args := m.Called(openingID, ca)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *ApplicationUseCaseMock) GetByID(id uint) (*schemas.Application, *internal_error.InternalError) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.Application), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *ApplicationUseCaseMock) ListByOpening(openingID uint, page int) ([]schemas.Application, *internal_error.InternalError) {
+	args := m.Called(openingID, page)
+	return args.Get(0).([]schemas.Application), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *ApplicationUseCaseMock) Transition(id uint, ta schemas.TransitionApplicationRequest) *internal_error.InternalError {
+	args := m.Called(id, ta)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *ApplicationUseCaseMock) History(id uint) ([]schemas.ApplicationStageChange, *internal_error.InternalError) {
+	args := m.Called(id)
+	return args.Get(0).([]schemas.ApplicationStageChange), args.Get(1).(*internal_error.InternalError)
+}
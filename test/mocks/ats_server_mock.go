@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+)
+
+// ATSServerMock stands in for the job feeds of ATS platforms. It serves
+// recorded fixture files by path, and any other status set for a path.
+type ATSServerMock struct {
+	*httptest.Server
+	mu       sync.Mutex
+	feeds    map[string]string
+	statuses map[string]int
+	Accepts  []string
+}
+
+func NewATSServerMock() *ATSServerMock {
+	s := &ATSServerMock{feeds: map[string]string{}, statuses: map[string]int{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Serve makes path respond with the content of fixture.
+func (s *ATSServerMock) Serve(path, fixture string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.feeds[path] = fixture
+	delete(s.statuses, path)
+}
+
+// Fail makes path respond with status.
+func (s *ATSServerMock) Fail(path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[path] = status
+}
+
+func (s *ATSServerMock) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Accepts = append(s.Accepts, r.Header.Get("Accept"))
+	if status, ok := s.statuses[r.URL.Path]; ok {
+		w.WriteHeader(status)
+		return
+	}
+
+	fixture, ok := s.feeds[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := os.ReadFile(fixture)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Write(body)
+}
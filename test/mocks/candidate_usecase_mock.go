@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type CandidateUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *CandidateUseCaseMock) Create(openingID uint, cp schemas.CreateCandidateProfileRequest, resume schemas.ResumeUpload) *internal_error.InternalError {
+	args := m.Called(openingID, cp, resume.FileName)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *CandidateUseCaseMock) GetByID(id uint) (*schemas.CandidateProfile, *internal_error.InternalError) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.CandidateProfile), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *CandidateUseCaseMock) ResumeURL(id uint) (*schemas.ResumeURLResponse, *internal_error.InternalError) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.ResumeURLResponse), args.Get(1).(*internal_error.InternalError)
+}
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type DigestUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *DigestUseCaseMock) Subscribe(cd schemas.CreateDigestSubscriptionRequest) *internal_error.InternalError {
+	args := m.Called(cd)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *DigestUseCaseMock) Confirm(token string) (*schemas.DigestSubscription, *internal_error.InternalError) {
+	args := m.Called(token)
+	return args.Get(0).(*schemas.DigestSubscription), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *DigestUseCaseMock) Unsubscribe(token string) *internal_error.InternalError {
+	args := m.Called(token)
+	return args.Get(0).(*internal_error.InternalError)
+}
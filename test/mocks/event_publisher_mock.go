@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+type EventPublisherMock struct {
+	mock.Mock
+}
+
+func (m *EventPublisherMock) Publish(event schemas.OpeningEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+type NotifierMock struct {
+	mock.Mock
+}
+
+func (m *NotifierMock) Notify() {
+	m.Called()
+}
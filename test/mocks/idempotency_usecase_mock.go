@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type IdempotencyUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *IdempotencyUseCaseMock) Begin(key, fingerprint string) (*schemas.IdempotencyRecord, *internal_error.InternalError) {
+	args := m.Called(key, fingerprint)
+	return args.Get(0).(*schemas.IdempotencyRecord), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *IdempotencyUseCaseMock) Hold(key string) func() {
+	args := m.Called(key)
+	return args.Get(0).(func())
+}
+
+func (m *IdempotencyUseCaseMock) Finish(key string, statusCode int, header map[string]string, body []byte) *internal_error.InternalError {
+	args := m.Called(key, statusCode, header, body)
+	return args.Get(0).(*internal_error.InternalError)
+}
@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"io"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type ImportUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *ImportUseCaseMock) Import(r io.Reader, opts schemas.ImportOptions) (*schemas.ImportReport, *schemas.ImportJobResponse, *internal_error.InternalError) {
+	body, _ := io.ReadAll(r)
+	args := m.Called(string(body), opts)
+	return args.Get(0).(*schemas.ImportReport), args.Get(1).(*schemas.ImportJobResponse), args.Get(2).(*internal_error.InternalError)
+}
+
+func (m *ImportUseCaseMock) GetJob(id uint) (*schemas.ImportJobResponse, *internal_error.InternalError) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.ImportJobResponse), args.Get(1).(*internal_error.InternalError)
+}
@@ -0,0 +1,82 @@
+package mocks
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+// LinkRequest is a request received by a LinkServerMock.
+type LinkRequest struct {
+	Method    string
+	Path      string
+	UserAgent string
+	At        time.Time
+}
+
+// LinkServerMock stands in for the job pages openings link to. Paths
+// answer 200 unless set otherwise with Respond, Redirect or RejectHead.
+type LinkServerMock struct {
+	*httptest.Server
+	mu         sync.Mutex
+	statuses   map[string]int
+	redirects  map[string]string
+	rejectHead map[string]bool
+	requests   []LinkRequest
+}
+
+func NewLinkServerMock() *LinkServerMock {
+	s := &LinkServerMock{statuses: map[string]int{}, redirects: map[string]string{}, rejectHead: map[string]bool{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Respond makes path respond with status.
+func (s *LinkServerMock) Respond(path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses[path] = status
+}
+
+// Redirect makes path redirect to target.
+func (s *LinkServerMock) Redirect(path, target string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.redirects[path] = target
+}
+
+// RejectHead makes path answer HEAD requests with 405.
+func (s *LinkServerMock) RejectHead(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectHead[path] = true
+}
+
+// Received returns the requests received so far.
+func (s *LinkServerMock) Received() []LinkRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]LinkRequest(nil), s.requests...)
+}
+
+func (s *LinkServerMock) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, LinkRequest{Method: r.Method, Path: r.URL.Path, UserAgent: r.UserAgent(), At: time.Now()})
+
+	if target, ok := s.redirects[r.URL.Path]; ok {
+		http.Redirect(w, r, target, http.StatusMovedPermanently)
+		return
+	}
+	if r.Method == http.MethodHead && s.rejectHead[r.URL.Path] {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if status, ok := s.statuses[r.URL.Path]; ok {
+		w.WriteHeader(status)
+		return
+	}
+	w.Write([]byte("<html><body>Apply now</body></html>"))
+}
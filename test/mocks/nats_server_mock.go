@@ -0,0 +1,167 @@
+package mocks
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type NATSMessage struct {
+	Subject string
+	Headers map[string]string
+	Data    string
+}
+
+// NATSServerMock is a minimal local NATS server that keeps every published
+// message in memory. Reject makes it answer publishes with -ERR instead.
+type NATSServerMock struct {
+	listener net.Listener
+	headers  bool
+
+	mu       sync.Mutex
+	messages []NATSMessage
+	connects []map[string]interface{}
+	reject   string
+}
+
+// NewNATSServerMock starts the server. headers tells whether it announces
+// support for HPUB.
+func NewNATSServerMock(headers bool) *NATSServerMock {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	s := &NATSServerMock{listener: listener, headers: headers}
+	go s.serve()
+	return s
+}
+
+func (s *NATSServerMock) URL() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+func (s *NATSServerMock) Close() {
+	s.listener.Close()
+}
+
+func (s *NATSServerMock) Messages() []NATSMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]NATSMessage(nil), s.messages...)
+}
+
+// Connects returns the CONNECT options sent by each client.
+func (s *NATSServerMock) Connects() []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]map[string]interface{}(nil), s.connects...)
+}
+
+// Reject makes publishes fail with the given error; an empty one accepts
+// them again.
+func (s *NATSServerMock) Reject(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reject = message
+}
+
+func (s *NATSServerMock) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.session(conn)
+	}
+}
+
+func (s *NATSServerMock) session(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	info, _ := json.Marshal(map[string]interface{}{"server_id": "mock", "headers": s.headers, "max_payload": 1 << 20})
+	reply("INFO " + string(info))
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(strings.TrimRight(line, "\r\n"))
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "CONNECT":
+			var options map[string]interface{}
+			json.Unmarshal([]byte(strings.TrimSpace(line[len("CONNECT"):])), &options)
+			s.mu.Lock()
+			s.connects = append(s.connects, options)
+			s.mu.Unlock()
+		case "PUB", "HPUB":
+			msg, ok := readNATSMessage(r, fields)
+			if !ok {
+				reply("-ERR 'Unknown Protocol Operation'")
+				return
+			}
+
+			s.mu.Lock()
+			reject := s.reject
+			if reject == "" {
+				s.messages = append(s.messages, msg)
+			}
+			s.mu.Unlock()
+			if reject != "" {
+				reply("-ERR '" + reject + "'")
+			}
+		case "PING":
+			reply("PONG")
+		case "PONG":
+		default:
+			reply("-ERR 'Unknown Protocol Operation'")
+			return
+		}
+	}
+}
+
+// readNATSMessage reads the payload announced by "PUB <subject> <size>" or
+// "HPUB <subject> <header size> <total size>".
+func readNATSMessage(r *bufio.Reader, fields []string) (NATSMessage, bool) {
+	hpub := strings.ToUpper(fields[0]) == "HPUB"
+	if (hpub && len(fields) != 4) || (!hpub && len(fields) != 3) {
+		return NATSMessage{}, false
+	}
+
+	total, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return NATSMessage{}, false
+	}
+	headerSize := 0
+	if hpub {
+		if headerSize, err = strconv.Atoi(fields[2]); err != nil || headerSize > total {
+			return NATSMessage{}, false
+		}
+	}
+
+	data := make([]byte, total+2)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return NATSMessage{}, false
+	}
+
+	msg := NATSMessage{Subject: fields[1], Headers: map[string]string{}, Data: string(data[headerSize:total])}
+	for _, header := range strings.Split(string(data[:headerSize]), "\r\n")[1:] {
+		if key, value, ok := strings.Cut(header, ":"); ok {
+			msg.Headers[key] = strings.TrimSpace(value)
+		}
+	}
+	return msg, true
+}
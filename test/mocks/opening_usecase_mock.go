@@ -3,6 +3,7 @@ package mocks
 import (
 	"github.com/stretchr/testify/mock"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
 	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
 )
 
@@ -10,13 +11,13 @@ type OpeningUseCaseMock struct {
 	mock.Mock
 }
 
-func (m *OpeningUseCaseMock) Create(co schemas.CreateOpeningRequest) *internal_error.InternalError {
+func (m *OpeningUseCaseMock) Create(co schemas.CreateOpeningRequest) (*schemas.Opening, *internal_error.InternalError) {
 	args := m.Called(co)
-	return args.Get(0).(*internal_error.InternalError)
+	return args.Get(0).(*schemas.Opening), args.Get(1).(*internal_error.InternalError)
 }
 
-func (m *OpeningUseCaseMock) DeleteByID(id uint) *internal_error.InternalError {
-	args := m.Called(id)
+func (m *OpeningUseCaseMock) DeleteByID(id uint, precondition *schemas.Precondition) *internal_error.InternalError {
+	args := m.Called(id, precondition)
 	return args.Get(0).(*internal_error.InternalError)
 }
 
@@ -25,12 +26,69 @@ func (m *OpeningUseCaseMock) GetByID(id uint) (*schemas.Opening, *internal_error
 	return args.Get(0).(*schemas.Opening), args.Get(1).(*internal_error.InternalError)
 }
 
-func (m *OpeningUseCaseMock) Update(id uint, upo schemas.UpdateOpeningRequest) *internal_error.InternalError {
-	args := m.Called(id)
+func (m *OpeningUseCaseMock) Update(id uint, upo schemas.UpdateOpeningRequest, precondition *schemas.Precondition) *internal_error.InternalError {
+	args := m.Called(id, precondition)
 	return args.Get(0).(*internal_error.InternalError)
 }
 
-func (m *OpeningUseCaseMock) ListOpenings(page int) ([]schemas.Opening, *internal_error.InternalError) {
-	args := m.Called(page)
+func (m *OpeningUseCaseMock) Patch(id uint, mediaType string, patch []byte, precondition *schemas.Precondition) (*schemas.Opening, *internal_error.InternalError) {
+	args := m.Called(id, mediaType, patch, precondition)
+	return args.Get(0).(*schemas.Opening), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) Bulk(req schemas.BulkOpeningRequest) ([]opening_usecase.BulkResult, *internal_error.InternalError) {
+	args := m.Called(req)
+	return args.Get(0).([]opening_usecase.BulkResult), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) Import(openings []schemas.CreateOpeningRequest, dryRun bool) ([]opening_usecase.BulkResult, *internal_error.InternalError) {
+	args := m.Called(openings, dryRun)
+	return args.Get(0).([]opening_usecase.BulkResult), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) Latest(filter schemas.OpeningFilter, limit int) ([]schemas.Opening, *internal_error.InternalError) {
+	args := m.Called(filter, limit)
+	return args.Get(0).([]schemas.Opening), args.Get(1).(*internal_error.InternalError)
+}
+
+// Export hands write each batch given to Return, stopping at its first
+// error like the usecase does.
+func (m *OpeningUseCaseMock) Export(filter schemas.OpeningFilter, write func(openings []schemas.Opening) error) *internal_error.InternalError {
+	args := m.Called(filter)
+	for _, batch := range args.Get(0).([][]schemas.Opening) {
+		if err := write(batch); err != nil {
+			return internal_error.NewInternalServerError("error writing openings")
+		}
+	}
+	return args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) Sync(source string, openings []schemas.Opening) (*schemas.ATSSyncReport, *internal_error.InternalError) {
+	args := m.Called(source, openings)
+	return args.Get(0).(*schemas.ATSSyncReport), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) DuplicateClusters() ([]schemas.DuplicateCluster, *internal_error.InternalError) {
+	args := m.Called()
+	return args.Get(0).([]schemas.DuplicateCluster), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) RecordLinkCheck(id uint, check schemas.LinkCheck, maxFailures int) (*schemas.Opening, *internal_error.InternalError) {
+	args := m.Called(id, check, maxFailures)
+	return args.Get(0).(*schemas.Opening), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) ListOpenings(filter schemas.OpeningFilter, page int) ([]schemas.Opening, *internal_error.InternalError) {
+	args := m.Called(filter, page)
 	return args.Get(0).([]schemas.Opening), args.Get(1).(*internal_error.InternalError)
 }
+
+func (m *OpeningUseCaseMock) GetRevision(id, revision uint) (*schemas.OpeningRevisionResponse, *internal_error.InternalError) {
+	args := m.Called(id, revision)
+	return args.Get(0).(*schemas.OpeningRevisionResponse), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *OpeningUseCaseMock) RestoreRevision(id, revision uint) *internal_error.InternalError {
+	args := m.Called(id, revision)
+	return args.Get(0).(*internal_error.InternalError)
+}
@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type PipelineUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *PipelineUseCaseMock) GetForOpening(openingID uint) (*schemas.PipelineResponse, *internal_error.InternalError) {
+	args := m.Called(openingID)
+	return args.Get(0).(*schemas.PipelineResponse), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *PipelineUseCaseMock) DefineForOpening(openingID uint, dp schemas.DefinePipelineRequest) *internal_error.InternalError {
+	args := m.Called(openingID, dp)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *PipelineUseCaseMock) DefineForCompany(company string, dp schemas.DefinePipelineRequest) *internal_error.InternalError {
+	args := m.Called(company, dp)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *PipelineUseCaseMock) StageCounts(openingID uint) (map[string]int64, error) {
+	args := m.Called(openingID)
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
@@ -1,15 +1,18 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/mock"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
 )
 
 type OpeningRepositoryMock struct {
 	mock.Mock
 }
 
-func (m *OpeningRepositoryMock) Create(opening schemas.Opening) error {
+func (m *OpeningRepositoryMock) Create(opening *schemas.Opening) error {
 	args := m.Called(opening)
 	return args.Error(0)
 }
@@ -19,17 +22,368 @@ func (m *OpeningRepositoryMock) FindByID(id uint) (*schemas.Opening, error) {
 	return args.Get(0).(*schemas.Opening), args.Error(1)
 }
 
-func (m *OpeningRepositoryMock) Update(opening schemas.Opening) error {
+func (m *OpeningRepositoryMock) Update(opening *schemas.Opening) error {
 	args := m.Called(opening)
 	return args.Error(0)
 }
 
-func (m *OpeningRepositoryMock) Delete(id uint) error {
-	args := m.Called(id)
+func (m *OpeningRepositoryMock) Delete(id, version uint) error {
+	args := m.Called(id, version)
 	return args.Error(0)
 }
 
-func (m *OpeningRepositoryMock) FindAll(limit, offset int) ([]schemas.Opening, error) {
-	args := m.Called(limit, offset)
+func (m *OpeningRepositoryMock) FindAll(filter schemas.OpeningFilter, limit, offset int) ([]schemas.Opening, error) {
+	args := m.Called(filter, limit, offset)
+	return args.Get(0).([]schemas.Opening), args.Error(1)
+}
+
+func (m *OpeningRepositoryMock) FindAfter(filter schemas.OpeningFilter, afterID uint, limit int) ([]schemas.Opening, error) {
+	args := m.Called(filter, afterID, limit)
+	return args.Get(0).([]schemas.Opening), args.Error(1)
+}
+
+func (m *OpeningRepositoryMock) FindLatest(filter schemas.OpeningFilter, limit int) ([]schemas.Opening, error) {
+	args := m.Called(filter, limit)
+	return args.Get(0).([]schemas.Opening), args.Error(1)
+}
+
+func (m *OpeningRepositoryMock) FindRevision(openingID, revision uint) (*schemas.OpeningRevision, error) {
+	args := m.Called(openingID, revision)
+	return args.Get(0).(*schemas.OpeningRevision), args.Error(1)
+}
+
+func (m *OpeningRepositoryMock) FindCreatedBetween(from, to time.Time) ([]schemas.Opening, error) {
+	args := m.Called(from, to)
 	return args.Get(0).([]schemas.Opening), args.Error(1)
 }
+
+func (m *OpeningRepositoryMock) FindBySource(source string) ([]schemas.Opening, error) {
+	args := m.Called(source)
+	return args.Get(0).([]schemas.Opening), args.Error(1)
+}
+
+func (m *OpeningRepositoryMock) FindDuplicateCandidates(linkKey, companyBlock string) ([]schemas.Opening, error) {
+	args := m.Called(linkKey, companyBlock)
+	return args.Get(0).([]schemas.Opening), args.Error(1)
+}
+
+func (m *OpeningRepositoryMock) FindLinksDue(checkedBefore time.Time, limit int) ([]schemas.Opening, error) {
+	args := m.Called(checkedBefore, limit)
+	return args.Get(0).([]schemas.Opening), args.Error(1)
+}
+
+func (m *OpeningRepositoryMock) RecordLinkCheck(opening *schemas.Opening) error {
+	args := m.Called(opening)
+	return args.Error(0)
+}
+
+// Transaction runs fn against the mock itself, so nothing is rolled back.
+// The returned error stands for a failed commit.
+func (m *OpeningRepositoryMock) Transaction(fn func(repo repositories.OpeningRepository) error) error {
+	args := m.Called()
+	if err := fn(m); err != nil {
+		return err
+	}
+	return args.Error(0)
+}
+
+type ApplicationRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *ApplicationRepositoryMock) Create(application schemas.Application) error {
+	args := m.Called(application)
+	return args.Error(0)
+}
+
+func (m *ApplicationRepositoryMock) FindByID(id uint) (*schemas.Application, error) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.Application), args.Error(1)
+}
+
+func (m *ApplicationRepositoryMock) FindAllByOpeningID(openingID uint, limit, offset int) ([]schemas.Application, error) {
+	args := m.Called(openingID, limit, offset)
+	return args.Get(0).([]schemas.Application), args.Error(1)
+}
+
+type CandidateProfileRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *CandidateProfileRepositoryMock) Create(profile schemas.CandidateProfile) error {
+	args := m.Called(profile)
+	return args.Error(0)
+}
+
+func (m *CandidateProfileRepositoryMock) FindByID(id uint) (*schemas.CandidateProfile, error) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.CandidateProfile), args.Error(1)
+}
+
+func (m *ApplicationRepositoryMock) UpdateStage(application schemas.Application, change schemas.ApplicationStageChange) error {
+	args := m.Called(application, change)
+	return args.Error(0)
+}
+
+func (m *ApplicationRepositoryMock) FindHistory(applicationID uint) ([]schemas.ApplicationStageChange, error) {
+	args := m.Called(applicationID)
+	return args.Get(0).([]schemas.ApplicationStageChange), args.Error(1)
+}
+
+func (m *ApplicationRepositoryMock) CountByStage(openingID uint) (map[string]int64, error) {
+	args := m.Called(openingID)
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+
+func (m *ApplicationRepositoryMock) FindStagesInUse(openingID uint, company string) ([]string, error) {
+	args := m.Called(openingID, company)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type PipelineRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *PipelineRepositoryMock) Save(pipeline schemas.Pipeline) error {
+	args := m.Called(pipeline)
+	return args.Error(0)
+}
+
+func (m *PipelineRepositoryMock) FindByOpeningID(openingID uint) (*schemas.Pipeline, error) {
+	args := m.Called(openingID)
+	return args.Get(0).(*schemas.Pipeline), args.Error(1)
+}
+
+func (m *PipelineRepositoryMock) FindByCompany(company string) (*schemas.Pipeline, error) {
+	args := m.Called(company)
+	return args.Get(0).(*schemas.Pipeline), args.Error(1)
+}
+
+type JobAlertRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *JobAlertRepositoryMock) Create(alert schemas.JobAlert) error {
+	args := m.Called(alert)
+	return args.Error(0)
+}
+
+func (m *JobAlertRepositoryMock) Update(alert schemas.JobAlert) error {
+	args := m.Called(alert)
+	return args.Error(0)
+}
+
+func (m *JobAlertRepositoryMock) FindByConfirmToken(token string) (*schemas.JobAlert, error) {
+	args := m.Called(token)
+	return args.Get(0).(*schemas.JobAlert), args.Error(1)
+}
+
+func (m *JobAlertRepositoryMock) FindByUnsubscribeToken(token string) (*schemas.JobAlert, error) {
+	args := m.Called(token)
+	return args.Get(0).(*schemas.JobAlert), args.Error(1)
+}
+
+func (m *JobAlertRepositoryMock) FindByID(id uint) (*schemas.JobAlert, error) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.JobAlert), args.Error(1)
+}
+
+func (m *JobAlertRepositoryMock) FindActive() ([]schemas.JobAlert, error) {
+	args := m.Called()
+	return args.Get(0).([]schemas.JobAlert), args.Error(1)
+}
+
+func (m *JobAlertRepositoryMock) CreateDeliveries(deliveries []schemas.JobAlertDelivery) error {
+	args := m.Called(deliveries)
+	return args.Error(0)
+}
+
+func (m *JobAlertRepositoryMock) FindDueDeliveries(now time.Time, limit int) ([]schemas.JobAlertDelivery, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]schemas.JobAlertDelivery), args.Error(1)
+}
+
+func (m *JobAlertRepositoryMock) UpdateDelivery(delivery schemas.JobAlertDelivery) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+type DigestRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *DigestRepositoryMock) Create(subscription schemas.DigestSubscription) error {
+	args := m.Called(subscription)
+	return args.Error(0)
+}
+
+func (m *DigestRepositoryMock) Update(subscription schemas.DigestSubscription) error {
+	args := m.Called(subscription)
+	return args.Error(0)
+}
+
+func (m *DigestRepositoryMock) FindByConfirmToken(token string) (*schemas.DigestSubscription, error) {
+	args := m.Called(token)
+	return args.Get(0).(*schemas.DigestSubscription), args.Error(1)
+}
+
+func (m *DigestRepositoryMock) FindByUnsubscribeToken(token string) (*schemas.DigestSubscription, error) {
+	args := m.Called(token)
+	return args.Get(0).(*schemas.DigestSubscription), args.Error(1)
+}
+
+func (m *DigestRepositoryMock) FindDue(now time.Time) ([]schemas.DigestSubscription, error) {
+	args := m.Called(now)
+	return args.Get(0).([]schemas.DigestSubscription), args.Error(1)
+}
+
+func (m *DigestRepositoryMock) StartDelivery(delivery schemas.DigestDelivery) (*schemas.DigestDelivery, error) {
+	args := m.Called(delivery)
+	return args.Get(0).(*schemas.DigestDelivery), args.Error(1)
+}
+
+func (m *DigestRepositoryMock) UpdateDelivery(delivery schemas.DigestDelivery) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *DigestRepositoryMock) CompleteDelivery(delivery schemas.DigestDelivery, subscription schemas.DigestSubscription) error {
+	args := m.Called(delivery, subscription)
+	return args.Error(0)
+}
+
+type WebhookRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *WebhookRepositoryMock) Create(webhook *schemas.Webhook) error {
+	args := m.Called(webhook)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) FindByID(id uint) (*schemas.Webhook, error) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.Webhook), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) FindAll() ([]schemas.Webhook, error) {
+	args := m.Called()
+	return args.Get(0).([]schemas.Webhook), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) FindActive() ([]schemas.Webhook, error) {
+	args := m.Called()
+	return args.Get(0).([]schemas.Webhook), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) Delete(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) CreateDeliveries(deliveries []schemas.WebhookDelivery) error {
+	args := m.Called(deliveries)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) FindDelivery(webhookID, id uint) (*schemas.WebhookDelivery, error) {
+	args := m.Called(webhookID, id)
+	return args.Get(0).(*schemas.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) FindDeliveries(webhookID uint, limit int) ([]schemas.WebhookDelivery, error) {
+	args := m.Called(webhookID, limit)
+	return args.Get(0).([]schemas.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) FindDueDeliveries(now time.Time, limit int) ([]schemas.WebhookDelivery, error) {
+	args := m.Called(now, limit)
+	return args.Get(0).([]schemas.WebhookDelivery), args.Error(1)
+}
+
+func (m *WebhookRepositoryMock) UpdateDelivery(delivery schemas.WebhookDelivery) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+func (m *WebhookRepositoryMock) RecordAttempt(delivery schemas.WebhookDelivery, attempt schemas.WebhookAttempt) error {
+	args := m.Called(delivery, attempt)
+	return args.Error(0)
+}
+
+type OutboxRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *OutboxRepositoryMock) FindPending(limit int) ([]schemas.OutboxEvent, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]schemas.OutboxEvent), args.Error(1)
+}
+
+func (m *OutboxRepositoryMock) Update(event schemas.OutboxEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func (m *OutboxRepositoryMock) DeletePublishedBefore(t time.Time) error {
+	args := m.Called(t)
+	return args.Error(0)
+}
+
+type IdempotencyRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *IdempotencyRepositoryMock) Reserve(record *schemas.IdempotencyRecord) (bool, error) {
+	args := m.Called(record)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *IdempotencyRepositoryMock) FindByKey(key string) (*schemas.IdempotencyRecord, error) {
+	args := m.Called(key)
+	return args.Get(0).(*schemas.IdempotencyRecord), args.Error(1)
+}
+
+func (m *IdempotencyRepositoryMock) Update(record schemas.IdempotencyRecord) error {
+	args := m.Called(record)
+	return args.Error(0)
+}
+
+func (m *IdempotencyRepositoryMock) Renew(key string, at time.Time) error {
+	args := m.Called(key, at)
+	return args.Error(0)
+}
+
+func (m *IdempotencyRepositoryMock) Delete(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *IdempotencyRepositoryMock) DeleteExpiredBefore(t time.Time) error {
+	args := m.Called(t)
+	return args.Error(0)
+}
+
+type ImportJobRepositoryMock struct {
+	mock.Mock
+}
+
+func (m *ImportJobRepositoryMock) Create(job *schemas.ImportJob) error {
+	args := m.Called(job)
+	return args.Error(0)
+}
+
+func (m *ImportJobRepositoryMock) FindByID(id uint) (*schemas.ImportJob, error) {
+	args := m.Called(id)
+	return args.Get(0).(*schemas.ImportJob), args.Error(1)
+}
+
+func (m *ImportJobRepositoryMock) Update(job schemas.ImportJob) error {
+	args := m.Called(job)
+	return args.Error(0)
+}
+
+func (m *ImportJobRepositoryMock) FailUnfinished(message string, completedAt time.Time) (int64, error) {
+	args := m.Called(message, completedAt)
+	return args.Get(0).(int64), args.Error(1)
+}
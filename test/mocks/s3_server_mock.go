@@ -0,0 +1,56 @@
+package mocks
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// S3ServerMock is an in-memory stand-in for an S3-compatible object store
+// using path-style addressing. It only checks that requests are signed.
+type S3ServerMock struct {
+	*httptest.Server
+	mu      sync.Mutex
+	Objects map[string][]byte
+	Types   map[string]string
+}
+
+func NewS3ServerMock() *S3ServerMock {
+	s := &S3ServerMock{Objects: map[string][]byte{}, Types: map[string]string{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *S3ServerMock) handle(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	presigned := r.URL.Query().Get("X-Amz-Signature") != ""
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=") && !presigned {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		s.Objects[r.URL.Path] = body
+		s.Types[r.URL.Path] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		body, ok := s.Objects[r.URL.Path]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", s.Types[r.URL.Path])
+		w.Write(body)
+	case http.MethodDelete:
+		delete(s.Objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
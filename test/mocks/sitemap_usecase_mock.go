@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/sitemap_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type SitemapUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *SitemapUseCaseMock) Index() (*sitemap_usecase.File, *internal_error.InternalError) {
+	args := m.Called()
+	return args.Get(0).(*sitemap_usecase.File), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *SitemapUseCaseMock) Page(n int) (*sitemap_usecase.File, *internal_error.InternalError) {
+	args := m.Called(n)
+	return args.Get(0).(*sitemap_usecase.File), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *SitemapUseCaseMock) Robots() []byte {
+	args := m.Called()
+	return args.Get(0).([]byte)
+}
@@ -0,0 +1,211 @@
+package mocks
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+)
+
+type SMTPMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// Parse reads the headers and raw body of the message.
+func (m SMTPMessage) Parse() (*mail.Message, error) {
+	return mail.ReadMessage(strings.NewReader(m.Data))
+}
+
+// Part returns the decoded body of the message part with the given media
+// type, e.g. text/plain, looking inside multipart messages. Line endings
+// are returned as LF.
+func (m SMTPMessage) Part(mediaType string) (string, error) {
+	msg, err := m.Parse()
+	if err != nil {
+		return "", err
+	}
+
+	return findPart(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body, mediaType)
+}
+
+func findPart(contentType, encoding string, body io.Reader, mediaType string) (string, error) {
+	typ, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.HasPrefix(typ, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if err == io.EOF {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+
+			text, err := findPart(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part, mediaType)
+			if err != nil || text != "" {
+				return text, err
+			}
+		}
+	}
+
+	if typ != mediaType {
+		return "", nil
+	}
+
+	if strings.EqualFold(encoding, "quoted-printable") {
+		body = quotedprintable.NewReader(body)
+	}
+	decoded, err := io.ReadAll(body)
+	return strings.ReplaceAll(string(decoded), "\r\n", "\n"), err
+}
+
+// SMTPServerMock is a minimal local SMTP server that accepts every message
+// and keeps it in memory.
+type SMTPServerMock struct {
+	listener net.Listener
+	mu       sync.Mutex
+	messages []SMTPMessage
+	received chan struct{}
+}
+
+func NewSMTPServerMock() *SMTPServerMock {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	s := &SMTPServerMock{listener: listener, received: make(chan struct{}, 1024)}
+	go s.serve()
+	return s
+}
+
+func (s *SMTPServerMock) Host() string {
+	host, _, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host
+}
+
+func (s *SMTPServerMock) Port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+func (s *SMTPServerMock) Close() {
+	s.listener.Close()
+}
+
+func (s *SMTPServerMock) Messages() []SMTPMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SMTPMessage(nil), s.messages...)
+}
+
+func (s *SMTPServerMock) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = nil
+	for len(s.received) > 0 {
+		<-s.received
+	}
+}
+
+// WaitForMessages blocks until n messages have been received since the last
+// Reset or the timeout expires, and returns what was received.
+func (s *SMTPServerMock) WaitForMessages(n int, timeout time.Duration) []SMTPMessage {
+	deadline := time.After(timeout)
+	for len(s.Messages()) < n {
+		select {
+		case <-s.received:
+		case <-deadline:
+			return s.Messages()
+		}
+	}
+	return s.Messages()
+}
+
+func (s *SMTPServerMock) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.session(conn)
+	}
+}
+
+func (s *SMTPServerMock) session(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+
+	var msg SMTPMessage
+	reply("220 localhost SMTP mock ready")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch verb {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "MAIL":
+			msg = SMTPMessage{From: addressOf(line)}
+			reply("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, addressOf(line))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			msg.Data = data.String()
+
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			s.received <- struct{}{}
+			reply("250 OK")
+		case "RSET", "NOOP":
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func addressOf(line string) string {
+	start, end := strings.Index(line, "<"), strings.LastIndex(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
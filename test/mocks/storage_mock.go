@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"io"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type BlobStorageMock struct {
+	mock.Mock
+	Stored map[string][]byte
+}
+
+func (m *BlobStorageMock) Put(key string, body io.Reader, size int64, contentType string) error {
+	args := m.Called(key, size, contentType)
+	if args.Error(0) == nil {
+		content, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		if m.Stored == nil {
+			m.Stored = map[string][]byte{}
+		}
+		m.Stored[key] = content
+	}
+	return args.Error(0)
+}
+
+func (m *BlobStorageMock) Get(key string) (io.ReadCloser, error) {
+	args := m.Called(key)
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *BlobStorageMock) Delete(key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+func (m *BlobStorageMock) SignedURL(key string, expiresIn time.Duration) (string, error) {
+	args := m.Called(key, expiresIn)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+type WebhookUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *WebhookUseCaseMock) Create(cw schemas.CreateWebhookRequest) (*schemas.Webhook, *internal_error.InternalError) {
+	args := m.Called(cw)
+	return args.Get(0).(*schemas.Webhook), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *WebhookUseCaseMock) List() ([]schemas.Webhook, *internal_error.InternalError) {
+	args := m.Called()
+	return args.Get(0).([]schemas.Webhook), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *WebhookUseCaseMock) Delete(id uint) *internal_error.InternalError {
+	args := m.Called(id)
+	return args.Get(0).(*internal_error.InternalError)
+}
+
+func (m *WebhookUseCaseMock) Deliveries(webhookID uint) ([]schemas.WebhookDelivery, *internal_error.InternalError) {
+	args := m.Called(webhookID)
+	return args.Get(0).([]schemas.WebhookDelivery), args.Get(1).(*internal_error.InternalError)
+}
+
+func (m *WebhookUseCaseMock) Redeliver(webhookID, deliveryID uint) (*schemas.WebhookDelivery, *internal_error.InternalError) {
+	args := m.Called(webhookID, deliveryID)
+	return args.Get(0).(*schemas.WebhookDelivery), args.Get(1).(*internal_error.InternalError)
+}
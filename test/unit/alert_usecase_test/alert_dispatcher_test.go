@@ -0,0 +1,188 @@
+package alert_usecase_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"gorm.io/gorm"
+)
+
+func TestAlertDispatcherPublish(t *testing.T) {
+	event := schemas.OpeningEvent{
+		ID:   "evt-1",
+		Type: schemas.OpeningCreatedEvent,
+		Opening: schemas.Opening{
+			Role:     "Senior Golang Developer",
+			Company:  "Tech Corp",
+			Location: "São Paulo, Brazil",
+			Remote:   true,
+			Link:     "https://example.com/job",
+			Salary:   9000,
+		},
+	}
+
+	t.Run("ShouldQueueAnEmailForEveryMatchingAlert", func(t *testing.T) {
+		dispatcher, alertRepo, mailerMock := setupDispatcherTest()
+		alerts := []schemas.JobAlert{
+			{Model: gorm.Model{ID: 1}, Email: "match@example.com", Keywords: "golang", Remote: boolPtr(true), Location: "brazil", MinSalary: 9000},
+			{Model: gorm.Model{ID: 2}, Email: "keyword@example.com", Keywords: "golang rust"},
+			{Model: gorm.Model{ID: 3}, Email: "onsite@example.com", Remote: boolPtr(false)},
+			{Model: gorm.Model{ID: 4}, Email: "salary@example.com", MinSalary: 10000},
+			{Model: gorm.Model{ID: 5}, Email: "location@example.com", Location: "Lisbon"},
+			{Model: gorm.Model{ID: 6}, Email: "anything@example.com"},
+		}
+		payload, _ := json.Marshal(event.Opening)
+
+		alertRepo.On("FindActive").Return(alerts, nil).Once()
+		alertRepo.On("CreateDeliveries", mock.MatchedBy(func(deliveries []schemas.JobAlertDelivery) bool {
+			if len(deliveries) != 2 || deliveries[0].AlertID != 1 || deliveries[1].AlertID != 6 {
+				return false
+			}
+			for _, d := range deliveries {
+				if d.EventID != "evt-1" || d.Payload != string(payload) ||
+					d.Status != schemas.JobAlertDeliveryPending || d.NextAttemptAt == nil {
+					return false
+				}
+			}
+			return true
+		})).Return(nil).Once()
+
+		err := dispatcher.Publish(event)
+
+		assert.NoError(t, err)
+		alertRepo.AssertExpectations(t)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything)
+	})
+
+	t.Run("ShouldIgnoreOtherEvents", func(t *testing.T) {
+		dispatcher, alertRepo, _ := setupDispatcherTest()
+		updated := event
+		updated.Type = schemas.OpeningUpdatedEvent
+
+		err := dispatcher.Publish(updated)
+
+		assert.NoError(t, err)
+		alertRepo.AssertNotCalled(t, "FindActive")
+	})
+
+	t.Run("ShouldReturnStorageErrors", func(t *testing.T) {
+		dispatcher, alertRepo, _ := setupDispatcherTest()
+
+		alertRepo.On("FindActive").Return([]schemas.JobAlert(nil), gorm.ErrInvalidDB).Once()
+
+		assert.ErrorIs(t, dispatcher.Publish(event), gorm.ErrInvalidDB)
+	})
+}
+
+func TestAlertDispatcherRunDue(t *testing.T) {
+	confirmedAt := time.Now()
+	alert := &schemas.JobAlert{Model: gorm.Model{ID: 1}, Email: "ada@example.com", Keywords: "golang", UnsubscribeToken: "t1", ConfirmedAt: &confirmedAt}
+	payload, _ := json.Marshal(schemas.Opening{
+		Role:     "Senior Golang Developer",
+		Company:  "Tech Corp",
+		Location: "São Paulo, Brazil",
+		Link:     "https://example.com/job",
+		Salary:   9000,
+	})
+	pending := schemas.JobAlertDelivery{Model: gorm.Model{ID: 9}, AlertID: 1, EventID: "evt-1", Payload: string(payload), Status: schemas.JobAlertDeliveryPending}
+
+	t.Run("ShouldEmailTheAlertAndMarkTheDeliverySent", func(t *testing.T) {
+		dispatcher, alertRepo, mailerMock := setupDispatcherTest()
+		now := time.Now()
+
+		alertRepo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.JobAlertDelivery{pending}, nil).Once()
+		alertRepo.On("FindByID", uint(1)).Return(alert, nil).Once()
+		mailerMock.On("Send", mock.MatchedBy(func(msg mailer.Message) bool {
+			return msg.To[0] == "ada@example.com" &&
+				msg.Subject == "New opening: Senior Golang Developer at Tech Corp" &&
+				strings.Contains(msg.Text, "Apply: https://example.com/job") &&
+				msg.Headers["List-Unsubscribe"] == "<"+baseURL+"/alerts/unsubscribe?token=t1>" &&
+				msg.Headers["List-Unsubscribe-Post"] == "List-Unsubscribe=One-Click"
+		})).Return(nil).Once()
+		alertRepo.On("UpdateDelivery", mock.MatchedBy(func(d schemas.JobAlertDelivery) bool {
+			return d.Status == schemas.JobAlertDeliverySent && d.Attempts == 1 && d.NextAttemptAt == nil && d.SentAt != nil
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		alertRepo.AssertExpectations(t)
+		mailerMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRetryWithBackoffWhenTheMailServerFails", func(t *testing.T) {
+		dispatcher, alertRepo, mailerMock := setupDispatcherTest()
+		delivery := pending
+		delivery.Attempts = 1
+		now := time.Now()
+
+		alertRepo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.JobAlertDelivery{delivery}, nil).Once()
+		alertRepo.On("FindByID", uint(1)).Return(alert, nil).Once()
+		mailerMock.On("Send", mock.Anything).Return(errors.New("mailbox unavailable")).Once()
+		alertRepo.On("UpdateDelivery", mock.MatchedBy(func(d schemas.JobAlertDelivery) bool {
+			return d.Status == schemas.JobAlertDeliveryPending && d.Attempts == 2 &&
+				d.LastError == "mailbox unavailable" &&
+				d.NextAttemptAt != nil && !d.NextAttemptAt.Before(now.Add(2*time.Minute))
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		alertRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldGiveUpAfterTheLastAttempt", func(t *testing.T) {
+		dispatcher, alertRepo, mailerMock := setupDispatcherTest()
+		delivery := pending
+		delivery.Attempts = 2
+		now := time.Now()
+
+		alertRepo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.JobAlertDelivery{delivery}, nil).Once()
+		alertRepo.On("FindByID", uint(1)).Return(alert, nil).Once()
+		mailerMock.On("Send", mock.Anything).Return(errors.New("mailbox unavailable")).Once()
+		alertRepo.On("UpdateDelivery", mock.MatchedBy(func(d schemas.JobAlertDelivery) bool {
+			return d.Status == schemas.JobAlertDeliveryFailed && d.Attempts == 3 && d.NextAttemptAt == nil
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		alertRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotEmailAnAlertThatWasUnsubscribed", func(t *testing.T) {
+		dispatcher, alertRepo, mailerMock := setupDispatcherTest()
+		unsubscribedAt := time.Now()
+		unsubscribed := *alert
+		unsubscribed.UnsubscribedAt = &unsubscribedAt
+		now := time.Now()
+
+		alertRepo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.JobAlertDelivery{pending}, nil).Once()
+		alertRepo.On("FindByID", uint(1)).Return(&unsubscribed, nil).Once()
+		alertRepo.On("UpdateDelivery", mock.MatchedBy(func(d schemas.JobAlertDelivery) bool {
+			return d.Status == schemas.JobAlertDeliveryFailed && d.LastError == "alert is no longer active"
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		alertRepo.AssertExpectations(t)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything)
+	})
+
+	t.Run("ShouldReturnStorageErrors", func(t *testing.T) {
+		dispatcher, alertRepo, _ := setupDispatcherTest()
+		now := time.Now()
+
+		alertRepo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.JobAlertDelivery(nil), gorm.ErrInvalidDB).Once()
+
+		assert.ErrorIs(t, dispatcher.RunDue(now), gorm.ErrInvalidDB)
+	})
+}
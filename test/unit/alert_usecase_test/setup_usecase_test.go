@@ -0,0 +1,37 @@
+package alert_usecase_test
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/alert_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+const baseURL = "https://jobs.example.com/api/v1"
+
+var policy = schemas.AlertPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Minute,
+	MaxDelay:    10 * time.Minute,
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func setupUsecaseTest() (*alert_usecase.AlertUseCase, *mocks.JobAlertRepositoryMock, *mocks.MailerMock) {
+	repo := new(mocks.JobAlertRepositoryMock)
+	mailer := new(mocks.MailerMock)
+	uc := alert_usecase.NewAlertUseCase(repo, mailer, baseURL)
+
+	return uc, repo, mailer
+}
+
+func setupDispatcherTest() (*alert_usecase.Dispatcher, *mocks.JobAlertRepositoryMock, *mocks.MailerMock) {
+	repo := new(mocks.JobAlertRepositoryMock)
+	mailer := new(mocks.MailerMock)
+	d := alert_usecase.NewDispatcher(repo, mailer, baseURL, policy)
+
+	return d, repo, mailer
+}
@@ -0,0 +1,148 @@
+package alert_usecase_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"gorm.io/gorm"
+)
+
+func TestSubscribeAlertUsecase(t *testing.T) {
+	request := schemas.CreateJobAlertRequest{
+		Email:     " ada@example.com ",
+		Keywords:  "  golang   backend ",
+		Remote:    boolPtr(true),
+		MinSalary: 8000,
+	}
+
+	t.Run("ShouldStoreAnUnconfirmedAlertAndSendTheConfirmationLink", func(t *testing.T) {
+		alertUsecase, alertRepo, mailerMock := setupUsecaseTest()
+
+		var stored schemas.JobAlert
+		alertRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(0).(schemas.JobAlert)
+		}).Return(nil).Once()
+		mailerMock.On("Send", mock.MatchedBy(func(msg mailer.Message) bool {
+			return strings.Contains(msg.Text, baseURL+"/alerts/confirm?token="+stored.ConfirmToken)
+		})).Return(nil).Once()
+
+		err := alertUsecase.Subscribe(request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "ada@example.com", stored.Email)
+		assert.Equal(t, "golang backend", stored.Keywords)
+		assert.Len(t, stored.ConfirmToken, 64)
+		assert.Len(t, stored.UnsubscribeToken, 64)
+		assert.NotEqual(t, stored.ConfirmToken, stored.UnsubscribeToken)
+		assert.Nil(t, stored.ConfirmedAt)
+		mailerMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldValidateTheRequest", func(t *testing.T) {
+		tests := []struct {
+			request schemas.CreateJobAlertRequest
+			message string
+		}{
+			{schemas.CreateJobAlertRequest{}, "param: email (type: string) is required"},
+			{schemas.CreateJobAlertRequest{Email: "ada"}, "email must be a valid address"},
+			{schemas.CreateJobAlertRequest{Email: "ada@example.com", MinSalary: -1}, "minSalary must not be negative"},
+		}
+
+		for _, tt := range tests {
+			alertUsecase, alertRepo, _ := setupUsecaseTest()
+
+			err := alertUsecase.Subscribe(tt.request)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.message, err.Message)
+			alertRepo.AssertNotCalled(t, "Create", mock.Anything)
+		}
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheEmailCannotBeSent", func(t *testing.T) {
+		alertUsecase, alertRepo, mailerMock := setupUsecaseTest()
+
+		alertRepo.On("Create", mock.Anything).Return(nil).Once()
+		mailerMock.On("Send", mock.Anything).Return(errors.New("connection refused")).Once()
+
+		err := alertUsecase.Subscribe(request)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "error sending confirmation email", err.Message)
+	})
+}
+
+func TestConfirmAlertUsecase(t *testing.T) {
+	t.Run("ShouldConfirmTheAlert", func(t *testing.T) {
+		alertUsecase, alertRepo, _ := setupUsecaseTest()
+		alert := schemas.JobAlert{Model: gorm.Model{ID: 1}, Email: "ada@example.com", ConfirmToken: "abc"}
+
+		alertRepo.On("FindByConfirmToken", "abc").Return(&alert, nil).Once()
+		alertRepo.On("Update", mock.MatchedBy(func(a schemas.JobAlert) bool {
+			return a.ID == 1 && a.ConfirmedAt != nil
+		})).Return(nil).Once()
+
+		confirmed, err := alertUsecase.Confirm("abc")
+
+		assert.Nil(t, err)
+		assert.True(t, confirmed.IsActive())
+		alertRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotUpdateAnAlreadyConfirmedAlert", func(t *testing.T) {
+		alertUsecase, alertRepo, _ := setupUsecaseTest()
+		confirmedAt := time.Now()
+		alert := schemas.JobAlert{Model: gorm.Model{ID: 1}, ConfirmedAt: &confirmedAt}
+
+		alertRepo.On("FindByConfirmToken", "abc").Return(&alert, nil).Once()
+
+		_, err := alertUsecase.Confirm("abc")
+
+		assert.Nil(t, err)
+		alertRepo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownToken", func(t *testing.T) {
+		alertUsecase, alertRepo, _ := setupUsecaseTest()
+
+		alertRepo.On("FindByConfirmToken", "nope").Return((*schemas.JobAlert)(nil), gorm.ErrRecordNotFound).Once()
+
+		_, err := alertUsecase.Confirm("nope")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "alert not found", err.Message)
+		assert.Equal(t, "not_found", err.Err)
+	})
+}
+
+func TestUnsubscribeAlertUsecase(t *testing.T) {
+	t.Run("ShouldUnsubscribeTheAlert", func(t *testing.T) {
+		alertUsecase, alertRepo, _ := setupUsecaseTest()
+		alert := schemas.JobAlert{Model: gorm.Model{ID: 1}, UnsubscribeToken: "xyz"}
+
+		alertRepo.On("FindByUnsubscribeToken", "xyz").Return(&alert, nil).Once()
+		alertRepo.On("Update", mock.MatchedBy(func(a schemas.JobAlert) bool {
+			return a.ID == 1 && a.UnsubscribedAt != nil
+		})).Return(nil).Once()
+
+		err := alertUsecase.Unsubscribe("xyz")
+
+		assert.Nil(t, err)
+		alertRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRequireAToken", func(t *testing.T) {
+		alertUsecase, _, _ := setupUsecaseTest()
+
+		err := alertUsecase.Unsubscribe("")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "param: token (type: string) is required", err.Message)
+	})
+}
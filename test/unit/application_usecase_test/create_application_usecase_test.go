@@ -0,0 +1,100 @@
+package application_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+func TestCreateApplicationUsecase(t *testing.T) {
+	var openingID uint = 7
+	request := schemas.CreateApplicationRequest{
+		CandidateName: "Ada Lovelace",
+		Email:         "ada@example.com",
+		Phone:         "+55 11 99999-0000",
+		CoverLetter:   "I would love to work with you.",
+	}
+
+	t.Run("ShouldSuccessfullyApplyToAnOpenOpening", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		opening := schemas.Opening{Model: gorm.Model{ID: openingID}, Status: schemas.OpeningStatusOpen}
+		application := schemas.Application{
+			OpeningID:     openingID,
+			CandidateName: request.CandidateName,
+			Email:         request.Email,
+			Phone:         request.Phone,
+			CoverLetter:   request.CoverLetter,
+			Status:        schemas.StageApplied,
+		}
+
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		applicationRepo.On("Create", mock.MatchedBy(func(a schemas.Application) bool {
+			stamped := a.StageChangedAt != nil
+			a.StageChangedAt = nil
+			return stamped && a == application
+		})).Return(nil).Once()
+
+		err := applicationUsecase.Create(openingID, request)
+
+		assert.Nil(t, err)
+		applicationRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectApplicationsToAClosedOpening", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		opening := schemas.Opening{Model: gorm.Model{ID: openingID}, Status: schemas.OpeningStatusClosed}
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+
+		err := applicationUsecase.Create(openingID, request)
+
+		assert.EqualError(t, err, "opening is closed for applications")
+		assert.Equal(t, "bad_request", err.Err)
+		applicationRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("ShouldRejectApplicationsToADeletedOpening", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		openingRepo.On("FindByID", openingID).Return((*schemas.Opening)(nil), gorm.ErrRecordNotFound).Once()
+
+		err := applicationUsecase.Create(openingID, request)
+
+		assert.EqualError(t, err, "opening not found")
+		assert.Equal(t, "not_found", err.Err)
+		applicationRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheCandidateNameIsEmpty", func(t *testing.T) {
+		applicationUsecase, _, openingRepo, _ := setupUsecaseTest()
+
+		err := applicationUsecase.Create(openingID, schemas.CreateApplicationRequest{Email: "ada@example.com"})
+
+		assert.EqualError(t, err, "param: candidateName (type: string) is required")
+		openingRepo.AssertNotCalled(t, "FindByID", openingID)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheEmailIsInvalid", func(t *testing.T) {
+		applicationUsecase, _, _, _ := setupUsecaseTest()
+
+		err := applicationUsecase.Create(openingID, schemas.CreateApplicationRequest{CandidateName: "Ada", Email: "not-an-email"})
+
+		assert.EqualError(t, err, "email must be a valid address")
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheDBFails", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		opening := schemas.Opening{Model: gorm.Model{ID: openingID}}
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		applicationRepo.On("Create", mock.Anything).Return(gorm.ErrRegistered).Once()
+
+		err := applicationUsecase.Create(openingID, request)
+
+		assert.EqualError(t, err, "error creating application")
+	})
+}
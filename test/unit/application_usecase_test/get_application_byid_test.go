@@ -0,0 +1,35 @@
+package application_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"gorm.io/gorm"
+)
+
+func TestGetApplicationByIDUsecase(t *testing.T) {
+	applicationUsecase, applicationRepo, _, _ := setupUsecaseTest()
+
+	t.Run("ShouldReturnApplicationWhenFoundById", func(t *testing.T) {
+		var ID uint = 11
+		application := schemas.Application{Model: gorm.Model{ID: ID}, OpeningID: 7, CandidateName: "Ada"}
+		applicationRepo.On("FindByID", ID).Return(&application, nil).Once()
+
+		result, err := applicationUsecase.GetByID(ID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &application, result)
+	})
+
+	t.Run("ShouldReturnErrorWhenApplicationNotFound", func(t *testing.T) {
+		var ID uint = 999
+		applicationRepo.On("FindByID", ID).Return((*schemas.Application)(nil), gorm.ErrRecordNotFound).Once()
+
+		result, err := applicationUsecase.GetByID(ID)
+
+		assert.Nil(t, result)
+		assert.Equal(t, internal_error.NewNotFoundError("application not found"), err)
+	})
+}
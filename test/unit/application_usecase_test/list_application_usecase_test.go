@@ -0,0 +1,54 @@
+package application_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"gorm.io/gorm"
+)
+
+func TestListApplicationUsecase(t *testing.T) {
+	var openingID uint = 7
+
+	t.Run("ShouldReturnTheApplicationsOfTheRequestedPage", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		applications := []schemas.Application{
+			{Model: gorm.Model{ID: 11}, OpeningID: openingID, CandidateName: "Ada"},
+			{Model: gorm.Model{ID: 12}, OpeningID: openingID, CandidateName: "Grace"},
+		}
+		openingRepo.On("FindByID", openingID).Return(&schemas.Opening{Model: gorm.Model{ID: openingID}}, nil).Once()
+		applicationRepo.On("FindAllByOpeningID", openingID, 10, 10).Return(applications, nil).Once()
+
+		result, err := applicationUsecase.ListByOpening(openingID, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, applications, result)
+	})
+
+	t.Run("ShouldReturnErrorWhenNoApplicationsAreFound", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		openingRepo.On("FindByID", openingID).Return(&schemas.Opening{Model: gorm.Model{ID: openingID}}, nil).Once()
+		applicationRepo.On("FindAllByOpeningID", openingID, 10, 0).Return([]schemas.Application{}, nil).Once()
+
+		result, err := applicationUsecase.ListByOpening(openingID, 0)
+
+		assert.Nil(t, result)
+		assert.Equal(t, internal_error.NewNotFoundError("application record not found"), err)
+	})
+
+	t.Run("ShouldReturnErrorWhenTheOpeningDoesNotExist", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		openingRepo.On("FindByID", openingID).Return((*schemas.Opening)(nil), gorm.ErrRecordNotFound).Once()
+
+		result, err := applicationUsecase.ListByOpening(openingID, 1)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "opening not found")
+		applicationRepo.AssertNotCalled(t, "FindAllByOpeningID", openingID, 10, 0)
+	})
+}
@@ -0,0 +1,24 @@
+package application_usecase_test
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/application_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func setupUsecaseTest() (*application_usecase.ApplicationUseCase, *mocks.ApplicationRepositoryMock, *mocks.OpeningRepositoryMock, *mocks.PipelineRepositoryMock) {
+	repo := new(mocks.ApplicationRepositoryMock)
+	openingRepo := new(mocks.OpeningRepositoryMock)
+	pipelineRepo := new(mocks.PipelineRepositoryMock)
+	uc := application_usecase.NewApplicationUseCase(repo, openingRepo, pipelineRepo)
+
+	return uc, repo, openingRepo, pipelineRepo
+}
+
+// withDefaultPipeline makes every opening fall back to the default pipeline.
+func withDefaultPipeline(pipelineRepo *mocks.PipelineRepositoryMock) {
+	pipelineRepo.On("FindByOpeningID", mock.Anything).Return((*schemas.Pipeline)(nil), gorm.ErrRecordNotFound)
+	pipelineRepo.On("FindByCompany", mock.Anything).Return((*schemas.Pipeline)(nil), gorm.ErrRecordNotFound)
+}
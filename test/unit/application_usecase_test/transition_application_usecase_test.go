@@ -0,0 +1,100 @@
+package application_usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+func TestTransitionApplicationUsecase(t *testing.T) {
+	var applicationID uint = 5
+	opening := schemas.Opening{Model: gorm.Model{ID: 7}, Company: "Tech Corp"}
+
+	t.Run("ShouldMoveTheApplicationAndRecordTheChange", func(t *testing.T) {
+		applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+		withDefaultPipeline(pipelineRepo)
+		application := schemas.Application{Model: gorm.Model{ID: applicationID}, OpeningID: 7, Status: schemas.StageApplied}
+
+		applicationRepo.On("FindByID", applicationID).Return(&application, nil).Once()
+		openingRepo.On("FindByID", uint(7)).Return(&opening, nil).Once()
+		applicationRepo.On("UpdateStage", application, mock.MatchedBy(func(c schemas.ApplicationStageChange) bool {
+			return c.FromStage == schemas.StageApplied && c.ToStage == "interview" &&
+				c.Note == "strong profile" && time.Since(c.ChangedAt) < time.Minute
+		})).Return(nil).Once()
+
+		err := applicationUsecase.Transition(applicationID, schemas.TransitionApplicationRequest{Stage: "interview", Note: "strong profile"})
+
+		assert.Nil(t, err)
+		applicationRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectInvalidTransitions", func(t *testing.T) {
+		tests := []struct {
+			current string
+			stage   string
+			message string
+		}{
+			{schemas.StageApplied, "onsite", "stage onsite is not part of the pipeline"},
+			{"interview", "interview", "application is already in stage interview"},
+			{schemas.StageHired, "offer", "application in stage hired cannot be moved"},
+			{schemas.StageRejected, "screening", "application in stage rejected cannot be moved"},
+		}
+
+		for _, tt := range tests {
+			applicationUsecase, applicationRepo, openingRepo, pipelineRepo := setupUsecaseTest()
+			withDefaultPipeline(pipelineRepo)
+			application := schemas.Application{Model: gorm.Model{ID: applicationID}, OpeningID: 7, Status: tt.current}
+
+			applicationRepo.On("FindByID", applicationID).Return(&application, nil).Once()
+			openingRepo.On("FindByID", uint(7)).Return(&opening, nil).Once()
+
+			err := applicationUsecase.Transition(applicationID, schemas.TransitionApplicationRequest{Stage: tt.stage})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.message, err.Message)
+			applicationRepo.AssertNotCalled(t, "UpdateStage", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("ShouldRequireAStage", func(t *testing.T) {
+		applicationUsecase, applicationRepo, _, _ := setupUsecaseTest()
+
+		err := applicationUsecase.Transition(applicationID, schemas.TransitionApplicationRequest{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "param: stage (type: string) is required", err.Message)
+		applicationRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheApplicationDoesNotExist", func(t *testing.T) {
+		applicationUsecase, applicationRepo, _, _ := setupUsecaseTest()
+
+		applicationRepo.On("FindByID", applicationID).Return((*schemas.Application)(nil), gorm.ErrRecordNotFound).Once()
+
+		err := applicationUsecase.Transition(applicationID, schemas.TransitionApplicationRequest{Stage: "offer"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "application not found", err.Message)
+		assert.Equal(t, "not_found", err.Err)
+	})
+
+	t.Run("ShouldReturnTheHistory", func(t *testing.T) {
+		applicationUsecase, applicationRepo, _, _ := setupUsecaseTest()
+		history := []schemas.ApplicationStageChange{
+			{ApplicationID: applicationID, ToStage: schemas.StageApplied},
+			{ApplicationID: applicationID, FromStage: schemas.StageApplied, ToStage: "offer"},
+		}
+
+		applicationRepo.On("FindByID", applicationID).Return(&schemas.Application{}, nil).Once()
+		applicationRepo.On("FindHistory", applicationID).Return(history, nil).Once()
+
+		result, err := applicationUsecase.History(applicationID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, history, result)
+	})
+}
@@ -0,0 +1,76 @@
+package ats_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/ats"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func parseFixture(t *testing.T, format, fixture string) []schemas.Opening {
+	file, err := os.Open("../../fixtures/ats/" + fixture)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	openings, err := ats.Parse(format, file)
+	assert.NoError(t, err)
+	return openings
+}
+
+func TestATSParser(t *testing.T) {
+	t.Run("ShouldParseAGreenhouseBoard", func(t *testing.T) {
+		openings := parseFixture(t, schemas.ATSFormatGreenhouse, "greenhouse.json")
+
+		assert.Equal(t, []schemas.Opening{
+			{ExternalID: "4012345", Role: "Backend Engineer (Go)", Company: "Acme Brasil", Location: "São Paulo, SP", Link: "https://boards.greenhouse.io/acmebrasil/jobs/4012345"},
+			{ExternalID: "4012399", Role: "Site Reliability Engineer", Company: "Acme Brasil", Location: "Remote - Brazil", Remote: true, Link: "https://boards.greenhouse.io/acmebrasil/jobs/4012399"},
+			{ExternalID: "4012420", Role: "Data Analyst", Company: "Acme Brasil", Location: "Curitiba, PR (Hybrid)", Link: "https://boards.greenhouse.io/acmebrasil/jobs/4012420"},
+		}, openings)
+	})
+
+	t.Run("ShouldParseLeverPostingsWithMonthlySalaries", func(t *testing.T) {
+		openings := parseFixture(t, schemas.ATSFormatLever, "lever.json")
+
+		assert.Equal(t, []schemas.Opening{
+			{ExternalID: "5b1e3f0a-9c2d-4e7b-8a61-0f4d2c9e7a11", Role: "Frontend Engineer", Location: "Remote, Brazil", Remote: true, Link: "https://jobs.lever.co/globex/5b1e3f0a-9c2d-4e7b-8a61-0f4d2c9e7a11", Salary: 12000},
+			{ExternalID: "a7c40d52-1f8e-4b39-9d0c-6e2b5f8a3c44", Role: "Customer Success Specialist", Location: "Belo Horizonte", Link: "https://jobs.lever.co/globex/a7c40d52-1f8e-4b39-9d0c-6e2b5f8a3c44", Salary: 5500},
+			{ExternalID: "e93f6b17-20aa-4c58-b7d1-3a9e0c5d8f02", Role: "Product Designer", Location: "Rio de Janeiro", Link: "https://jobs.lever.co/globex/e93f6b17-20aa-4c58-b7d1-3a9e0c5d8f02"},
+		}, openings)
+	})
+
+	t.Run("ShouldParseAnIndeedFeed", func(t *testing.T) {
+		openings := parseFixture(t, schemas.ATSFormatIndeed, "indeed.xml")
+
+		assert.Equal(t, []schemas.Opening{
+			{ExternalID: "INI-2291", Role: "Desenvolvedor Java Pleno", Company: "Initech", Location: "Campinas, SP, BR", Link: "https://carreiras.initech.com.br/vagas/INI-2291", Salary: 8500},
+			{ExternalID: "INI-2304", Role: "QA Analyst", Company: "Initech Recrutamento", Location: "BR", Remote: true, Link: "https://carreiras.initech.com.br/vagas/INI-2304", Salary: 5000},
+			{Role: "Estagiário de Suporte", Company: "Initech", Location: "Campinas, SP, BR", Link: "https://carreiras.initech.com.br/vagas/sem-referencia", Salary: 1800},
+		}, openings)
+	})
+
+	t.Run("ShouldLeaveSalariesWithoutAMonthlyOrYearlyIntervalUnknown", func(t *testing.T) {
+		feed := `<source><job><referencenumber>1</referencenumber><salary>R$ 50 por hora</salary></job>` +
+			`<job><referencenumber>2</referencenumber><salary>A combinar</salary></job></source>`
+
+		openings, err := ats.Parse(schemas.ATSFormatIndeed, strings.NewReader(feed))
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), openings[0].Salary)
+		assert.Equal(t, int64(0), openings[1].Salary)
+	})
+
+	t.Run("ShouldReturnAnErrorForAMalformedFeed", func(t *testing.T) {
+		_, err := ats.Parse(schemas.ATSFormatGreenhouse, strings.NewReader(`{"jobs": [`))
+
+		assert.ErrorContains(t, err, "invalid Greenhouse feed")
+	})
+
+	t.Run("ShouldRejectAnUnknownFormat", func(t *testing.T) {
+		_, err := ats.Parse("workday", strings.NewReader(`[]`))
+
+		assert.EqualError(t, err, `unknown ATS format "workday", must be one of: greenhouse, lever, indeed`)
+	})
+}
@@ -0,0 +1,104 @@
+package ats_usecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/ats_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+const fixtures = "../../fixtures/ats/"
+
+func setupSyncerTest(t *testing.T, sources ...schemas.ATSSource) (*ats_usecase.Syncer, *mocks.OpeningUseCaseMock, *mocks.ATSServerMock) {
+	server := mocks.NewATSServerMock()
+	t.Cleanup(server.Close)
+
+	for i := range sources {
+		sources[i].URL = server.URL + sources[i].URL
+	}
+	openings := new(mocks.OpeningUseCaseMock)
+	syncer := ats_usecase.NewSyncer(openings, schemas.ATSPolicy{Sources: sources, Interval: time.Hour, Timeout: 5 * time.Second})
+	return syncer, openings, server
+}
+
+func TestATSSyncer(t *testing.T) {
+	lever := schemas.ATSSource{Name: "globex", Format: schemas.ATSFormatLever, URL: "/v0/postings/globex", Company: "Globex"}
+	indeed := schemas.ATSSource{Name: "initech", Format: schemas.ATSFormatIndeed, URL: "/feeds/indeed.xml", Company: "Initech"}
+
+	t.Run("ShouldSyncTheJobsOfAFeedFillingInTheCompany", func(t *testing.T) {
+		syncer, openings, server := setupSyncerTest(t)
+		server.Serve("/v0/postings/globex", fixtures+"lever.json")
+		source := lever
+		source.URL = server.URL + source.URL
+		report := &schemas.ATSSyncReport{Source: "globex", Fetched: 3, Created: 3}
+		openings.On("Sync", "globex", mock.MatchedBy(func(jobs []schemas.Opening) bool {
+			return len(jobs) == 3 && jobs[0].Company == "Globex" && jobs[0].Salary == 12000
+		})).Return(report, (*internal_error.InternalError)(nil)).Once()
+
+		got, err := syncer.Sync(context.Background(), source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, report, got)
+		assert.Equal(t, []string{"application/json"}, server.Accepts)
+		openings.AssertExpectations(t)
+	})
+
+	t.Run("ShouldLeaveOpeningsAloneWhenTheFeedIsUnavailable", func(t *testing.T) {
+		syncer, openings, server := setupSyncerTest(t)
+		server.Fail("/v0/postings/globex", http.StatusServiceUnavailable)
+		source := lever
+		source.URL = server.URL + source.URL
+
+		_, err := syncer.Sync(context.Background(), source)
+
+		assert.EqualError(t, err, "feed responded with status 503")
+		openings.AssertNotCalled(t, "Sync", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldLeaveOpeningsAloneWhenTheFeedIsMalformed", func(t *testing.T) {
+		syncer, openings, server := setupSyncerTest(t)
+		server.Serve("/v0/postings/globex", fixtures+"indeed.xml")
+		source := lever
+		source.URL = server.URL + source.URL
+
+		_, err := syncer.Sync(context.Background(), source)
+
+		assert.ErrorContains(t, err, "invalid Lever feed")
+		openings.AssertNotCalled(t, "Sync", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldSyncTheOtherSourcesWhenOneFails", func(t *testing.T) {
+		syncer, openings, server := setupSyncerTest(t, lever, indeed)
+		server.Fail("/v0/postings/globex", http.StatusInternalServerError)
+		server.Serve("/feeds/indeed.xml", fixtures+"indeed.xml")
+		openings.On("Sync", "initech", mock.Anything).
+			Return(&schemas.ATSSyncReport{Source: "initech"}, (*internal_error.InternalError)(nil)).Once()
+
+		err := syncer.SyncAll(context.Background())
+
+		assert.EqualError(t, err, "ATS source globex: feed responded with status 500")
+		assert.Equal(t, "application/xml, text/xml", server.Accepts[1])
+		openings.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnTheErrorOfTheSync", func(t *testing.T) {
+		syncer, openings, server := setupSyncerTest(t)
+		server.Serve("/feeds/indeed.xml", fixtures+"indeed.xml")
+		source := indeed
+		source.URL = server.URL + source.URL
+		openings.On("Sync", "initech", mock.Anything).
+			Return((*schemas.ATSSyncReport)(nil), internal_error.NewInternalServerError("error syncing openings")).Once()
+
+		report, err := syncer.Sync(context.Background(), source)
+
+		assert.Nil(t, report)
+		assert.EqualError(t, err, "error syncing openings")
+	})
+}
@@ -0,0 +1,125 @@
+package candidate_usecase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+func resumeOf(fileName, content string) schemas.ResumeUpload {
+	return schemas.ResumeUpload{
+		FileName: fileName,
+		Size:     int64(len(content)),
+		Content:  strings.NewReader(content),
+	}
+}
+
+func TestCreateCandidateUsecase(t *testing.T) {
+	var openingID uint = 7
+	request := schemas.CreateCandidateProfileRequest{
+		Name:  "Ada Lovelace",
+		Email: "ada@example.com",
+	}
+	pdf := "%PDF-1.7\n1 0 obj\n<<>>\nendobj\n"
+	docx := "PK\x03\x04\x14\x00\x06\x00word/document.xml"
+
+	t.Run("ShouldStoreAPDFResumeAndCreateTheProfile", func(t *testing.T) {
+		candidateUsecase, candidateRepo, openingRepo, blobs := setupUsecaseTest()
+		openingRepo.On("FindByID", openingID).Return(&schemas.Opening{Model: gorm.Model{ID: openingID}}, nil).Once()
+		blobs.On("Put", mock.AnythingOfType("string"), int64(len(pdf)), schemas.ResumeContentTypePDF).Return(nil).Once()
+		candidateRepo.On("Create", mock.MatchedBy(func(p schemas.CandidateProfile) bool {
+			return p.OpeningID == openingID &&
+				p.Name == request.Name &&
+				p.ResumeFileName == "cv.pdf" &&
+				p.ResumeContentType == schemas.ResumeContentTypePDF &&
+				strings.HasPrefix(p.ResumeKey, "resumes/") &&
+				strings.HasSuffix(p.ResumeKey, ".pdf")
+		})).Return(nil).Once()
+
+		err := candidateUsecase.Create(openingID, request, resumeOf("cv.pdf", pdf))
+
+		assert.Nil(t, err)
+		candidateRepo.AssertExpectations(t)
+		blobs.AssertExpectations(t)
+		for _, content := range blobs.Stored {
+			assert.Equal(t, pdf, string(content), "the sniffed bytes must be stored too")
+		}
+	})
+
+	t.Run("ShouldAcceptADOCXResume", func(t *testing.T) {
+		candidateUsecase, candidateRepo, openingRepo, blobs := setupUsecaseTest()
+		openingRepo.On("FindByID", openingID).Return(&schemas.Opening{Model: gorm.Model{ID: openingID}}, nil).Once()
+		blobs.On("Put", mock.AnythingOfType("string"), int64(len(docx)), schemas.ResumeContentTypeDOCX).Return(nil).Once()
+		candidateRepo.On("Create", mock.Anything).Return(nil).Once()
+
+		err := candidateUsecase.Create(openingID, request, resumeOf("CV.DOCX", docx))
+
+		assert.Nil(t, err)
+		blobs.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectFilesThatAreNotPDFOrDOCX", func(t *testing.T) {
+		candidateUsecase, candidateRepo, openingRepo, blobs := setupUsecaseTest()
+		openingRepo.On("FindByID", openingID).Return(&schemas.Opening{Model: gorm.Model{ID: openingID}}, nil).Once()
+
+		err := candidateUsecase.Create(openingID, request, resumeOf("cv.pdf", "<html>not a pdf</html>"))
+
+		assert.EqualError(t, err, "resume must be a PDF or DOCX file")
+		blobs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+		candidateRepo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("ShouldRejectResumesOverTheSizeLimit", func(t *testing.T) {
+		candidateUsecase, _, openingRepo, blobs := setupUsecaseTest()
+
+		err := candidateUsecase.Create(openingID, request, resumeOf("cv.pdf", pdf+strings.Repeat("x", 1024)))
+
+		assert.EqualError(t, err, "resume must be at most 1024 bytes")
+		openingRepo.AssertNotCalled(t, "FindByID", openingID)
+		blobs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheResumeIsMissing", func(t *testing.T) {
+		candidateUsecase, _, _, _ := setupUsecaseTest()
+
+		err := candidateUsecase.Create(openingID, request, schemas.ResumeUpload{})
+
+		assert.EqualError(t, err, "param: resume (type: file) is required")
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheNameIsEmpty", func(t *testing.T) {
+		candidateUsecase, _, _, _ := setupUsecaseTest()
+
+		err := candidateUsecase.Create(openingID, schemas.CreateCandidateProfileRequest{Email: "ada@example.com"}, resumeOf("cv.pdf", pdf))
+
+		assert.EqualError(t, err, "param: name (type: string) is required")
+	})
+
+	t.Run("ShouldRejectProfilesForAClosedOpening", func(t *testing.T) {
+		candidateUsecase, _, openingRepo, blobs := setupUsecaseTest()
+		opening := schemas.Opening{Model: gorm.Model{ID: openingID}, Status: schemas.OpeningStatusClosed}
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+
+		err := candidateUsecase.Create(openingID, request, resumeOf("cv.pdf", pdf))
+
+		assert.EqualError(t, err, "opening is closed for applications")
+		blobs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldRemoveTheStoredResumeIfTheDBFails", func(t *testing.T) {
+		candidateUsecase, candidateRepo, openingRepo, blobs := setupUsecaseTest()
+		openingRepo.On("FindByID", openingID).Return(&schemas.Opening{Model: gorm.Model{ID: openingID}}, nil).Once()
+		blobs.On("Put", mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return(nil).Once()
+		blobs.On("Delete", mock.AnythingOfType("string")).Return(nil).Once()
+		candidateRepo.On("Create", mock.Anything).Return(gorm.ErrRegistered).Once()
+
+		err := candidateUsecase.Create(openingID, request, resumeOf("cv.pdf", pdf))
+
+		assert.EqualError(t, err, "error creating candidate profile")
+		blobs.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,38 @@
+package candidate_usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/candidate_usecase"
+	"gorm.io/gorm"
+)
+
+func TestResumeURLUsecase(t *testing.T) {
+	t.Run("ShouldSignAnExpiringURLForTheResume", func(t *testing.T) {
+		candidateUsecase, candidateRepo, _, blobs := setupUsecaseTest()
+		profile := schemas.CandidateProfile{Model: gorm.Model{ID: 3}, ResumeKey: "resumes/abc.pdf"}
+		candidateRepo.On("FindByID", uint(3)).Return(&profile, nil).Once()
+		blobs.On("SignedURL", "resumes/abc.pdf", candidate_usecase.DefaultResumeLimits.URLExpiry).Return("https://files/abc.pdf?signature=x", nil).Once()
+
+		result, err := candidateUsecase.ResumeURL(3)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "https://files/abc.pdf?signature=x", result.URL)
+		assert.WithinDuration(t, time.Now().Add(candidate_usecase.DefaultResumeLimits.URLExpiry), result.ExpiresAt, time.Minute)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheProfileIsNotFound", func(t *testing.T) {
+		candidateUsecase, candidateRepo, _, blobs := setupUsecaseTest()
+		candidateRepo.On("FindByID", uint(9)).Return((*schemas.CandidateProfile)(nil), gorm.ErrRecordNotFound).Once()
+
+		result, err := candidateUsecase.ResumeURL(9)
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "candidate profile not found")
+		blobs.AssertNotCalled(t, "SignedURL", mock.Anything, mock.Anything)
+	})
+}
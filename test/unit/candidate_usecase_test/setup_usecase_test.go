@@ -0,0 +1,18 @@
+package candidate_usecase_test
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/candidate_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func setupUsecaseTest() (*candidate_usecase.CandidateUseCase, *mocks.CandidateProfileRepositoryMock, *mocks.OpeningRepositoryMock, *mocks.BlobStorageMock) {
+	repo := new(mocks.CandidateProfileRepositoryMock)
+	openingRepo := new(mocks.OpeningRepositoryMock)
+	blobs := new(mocks.BlobStorageMock)
+	uc := candidate_usecase.NewCandidateUseCase(repo, openingRepo, blobs, candidate_usecase.ResumeLimits{
+		MaxSize:   1024,
+		URLExpiry: candidate_usecase.DefaultResumeLimits.URLExpiry,
+	})
+
+	return uc, repo, openingRepo, blobs
+}
@@ -0,0 +1,131 @@
+package dedup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/dedup"
+	"gorm.io/gorm"
+)
+
+func opening(id uint, role, company, location, link string) schemas.Opening {
+	return schemas.Opening{Model: gorm.Model{ID: id}, Role: role, Company: company, Location: location, Link: link}
+}
+
+func TestLinkKey(t *testing.T) {
+	t.Run("ShouldIgnoreSchemeWWWFragmentTrailingSlashAndTrackingParameters", func(t *testing.T) {
+		key := dedup.LinkKey("https://jobs.example.com/acme/42?gh_jid=42")
+
+		assert.Equal(t, key, dedup.LinkKey(" http://WWW.jobs.example.com/acme/42/?utm_source=linkedin&gh_jid=42#apply "))
+		assert.Equal(t, key, dedup.LinkKey("https://jobs.example.com/acme/42?ref=newsletter&gh_jid=42"))
+	})
+
+	t.Run("ShouldKeepTheQueryThatIdentifiesThePage", func(t *testing.T) {
+		assert.NotEqual(t, dedup.LinkKey("https://example.com/jobs?id=1"), dedup.LinkKey("https://example.com/jobs?id=2"))
+	})
+
+	t.Run("ShouldSortTheQuery", func(t *testing.T) {
+		assert.Equal(t, dedup.LinkKey("https://example.com/jobs?a=1&b=2"), dedup.LinkKey("https://example.com/jobs?b=2&a=1"))
+	})
+}
+
+func TestCompanyKey(t *testing.T) {
+	t.Run("ShouldIgnoreCaseAccentsPunctuationAndLegalForms", func(t *testing.T) {
+		assert.Equal(t, "acme solucoes", dedup.CompanyKey("ACME Soluções Ltda."))
+		assert.Equal(t, "acme solucoes", dedup.CompanyKey("Acme Solucoes S.A."))
+		assert.Equal(t, "globex", dedup.CompanyKey("Globex, Inc."))
+		assert.Equal(t, "acme", dedup.CompanyKey("ACME Incorporated"))
+	})
+}
+
+func TestCompanyBlock(t *testing.T) {
+	t.Run("ShouldPutDifferentSpellingsOfACompanyInTheSameBlock", func(t *testing.T) {
+		for _, company := range []string{"Acme Inc", "ACME Incorporated", "Acmee", "Acme Soluções Ltda."} {
+			assert.Equal(t, "acm", dedup.CompanyBlock(company), company)
+		}
+	})
+
+	t.Run("ShouldKeepAShortNameWhole", func(t *testing.T) {
+		assert.Equal(t, "3m", dedup.CompanyBlock("3M Co."))
+		assert.Empty(t, dedup.CompanyBlock("Ltda."))
+	})
+}
+
+func TestScore(t *testing.T) {
+	t.Run("ShouldScoreTheSameLinkAsADuplicate", func(t *testing.T) {
+		a := opening(1, "Go Developer", "Acme", "Remote", "https://example.com/jobs/1")
+		b := opening(2, "Backend Engineer", "Other", "Lisbon", "http://www.example.com/jobs/1/")
+
+		assert.Equal(t, 1.0, dedup.Score(a, b))
+	})
+
+	t.Run("ShouldMatchTheSameRoleWrittenDifferently", func(t *testing.T) {
+		a := opening(1, "Senior Go Developer", "Acme Ltda.", "São Paulo, SP", "https://a.example.com/1")
+		b := opening(2, "Go Developer Sr.", "ACME", "Sao Paulo - SP", "https://b.example.com/9")
+
+		assert.Equal(t, 1.0, dedup.Score(a, b))
+	})
+
+	t.Run("ShouldTellDifferentRolesOfTheSameCompanyApart", func(t *testing.T) {
+		a := opening(1, "Go Developer", "Acme", "Remote", "https://example.com/1")
+		b := opening(2, "Java Developer", "Acme", "Remote", "https://example.com/2")
+
+		assert.Less(t, dedup.Score(a, b), schemas.DefaultDuplicateThreshold)
+	})
+
+	t.Run("ShouldTellTheSameRoleInAnotherLocationApart", func(t *testing.T) {
+		a := opening(1, "Go Developer", "Acme", "Remote", "https://example.com/1")
+		b := opening(2, "Go Developer", "Acme", "Lisbon", "https://example.com/2")
+
+		assert.Less(t, dedup.Score(a, b), schemas.DefaultDuplicateThreshold)
+	})
+}
+
+func TestClusters(t *testing.T) {
+	t.Run("ShouldGroupDuplicatesByLinkSimilarityAndFlag", func(t *testing.T) {
+		flagged := opening(6, "Data Engineer", "Initech", "Campinas", "https://initech.example.com/9")
+		original := uint(5)
+		flagged.DuplicateOf = &original
+		openings := []schemas.Opening{
+			opening(1, "Go Developer", "Acme", "Remote", "https://example.com/1"),
+			opening(2, "Java Developer", "Acme", "Remote", "https://example.com/2"),
+			opening(3, "Golang Developer", "Globex", "Rio", "https://example.com/1?utm_source=x"),
+			opening(4, "Go Developer", "ACME Inc.", "Remote", "https://boards.example.com/acme/4"),
+			opening(5, "Analista de Dados", "Initech", "Campinas", "https://initech.example.com/5"),
+			flagged,
+		}
+
+		clusters := dedup.Clusters(openings, schemas.DefaultDuplicateThreshold)
+
+		ids := make([][]uint, len(clusters))
+		for i, cluster := range clusters {
+			for _, o := range cluster {
+				ids[i] = append(ids[i], o.ID)
+			}
+		}
+		assert.Equal(t, [][]uint{{1, 3, 4}, {5, 6}}, ids)
+	})
+
+	t.Run("ShouldGroupDuplicatesWhoseCompanyHasATypo", func(t *testing.T) {
+		openings := []schemas.Opening{
+			opening(1, "Go Developer", "Acme Inc", "Remote", "https://example.com/1"),
+			opening(2, "Go Developer", "Acmee", "Remote", "https://boards.example.com/2"),
+			opening(3, "Go Developer", "ACME Incorporated", "Remote", "https://jobs.example.com/3"),
+		}
+
+		clusters := dedup.Clusters(openings, schemas.DefaultDuplicateThreshold)
+
+		assert.Len(t, clusters, 1)
+		assert.Len(t, clusters[0], 3)
+	})
+
+	t.Run("ShouldReturnNoClustersWithoutDuplicates", func(t *testing.T) {
+		openings := []schemas.Opening{
+			opening(1, "Go Developer", "Acme", "Remote", "https://example.com/1"),
+			opening(2, "Designer", "Acme", "Remote", "https://example.com/2"),
+		}
+
+		assert.Empty(t, dedup.Clusters(openings, schemas.DefaultDuplicateThreshold))
+	})
+}
@@ -0,0 +1,39 @@
+package digest_usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+)
+
+func TestDigestSchedule(t *testing.T) {
+	// 2026-10-14 is a Wednesday.
+	wednesdayMorning := time.Date(2026, 10, 14, 7, 30, 0, 0, time.UTC)
+	wednesdayNoon := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	t.Run("ShouldScheduleDailyDigestsAtTheSendHour", func(t *testing.T) {
+		assert.Equal(t, time.Date(2026, 10, 14, 8, 0, 0, 0, time.UTC), schedule.Next(schemas.DigestDaily, wednesdayMorning))
+		assert.Equal(t, time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC), schedule.Next(schemas.DigestDaily, wednesdayNoon))
+	})
+
+	t.Run("ShouldNotScheduleAtTheSameInstant", func(t *testing.T) {
+		sendTime := time.Date(2026, 10, 14, 8, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC), schedule.Next(schemas.DigestDaily, sendTime))
+	})
+
+	t.Run("ShouldScheduleWeeklyDigestsOnTheWeekday", func(t *testing.T) {
+		assert.Equal(t, time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), schedule.Next(schemas.DigestWeekly, wednesdayMorning))
+	})
+
+	t.Run("ShouldUseTheScheduleTimezone", func(t *testing.T) {
+		saoPaulo := time.FixedZone("BRT", -3*60*60)
+		local := schemas.DigestSchedule{Hour: 8, Weekday: time.Monday, Location: saoPaulo}
+
+		next := local.Next(schemas.DigestDaily, wednesdayNoon)
+
+		assert.Equal(t, time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC), next.UTC())
+	})
+}
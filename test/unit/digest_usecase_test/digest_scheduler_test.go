@@ -0,0 +1,156 @@
+package digest_usecase_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"gorm.io/gorm"
+)
+
+func TestDigestScheduler(t *testing.T) {
+	confirmedAt := time.Date(2026, 10, 13, 15, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2026, 10, 14, 8, 0, 0, 0, time.UTC)
+	now := periodEnd.Add(30 * time.Second)
+	nextRun := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC)
+
+	newSubscription := func() schemas.DigestSubscription {
+		lastPeriodEnd, next := confirmedAt, periodEnd
+		return schemas.DigestSubscription{
+			Model:            gorm.Model{ID: 4},
+			Email:            "ada@example.com",
+			Cadence:          schemas.DigestDaily,
+			UnsubscribeToken: "unsub",
+			ConfirmedAt:      &confirmedAt,
+			LastPeriodEnd:    &lastPeriodEnd,
+			NextRunAt:        &next,
+		}
+	}
+	pending := schemas.DigestDelivery{
+		SubscriptionID: 4,
+		PeriodStart:    confirmedAt,
+		PeriodEnd:      periodEnd,
+		Status:         schemas.DigestDeliveryPending,
+	}
+	openings := []schemas.Opening{
+		{Role: "Go Developer", Company: "Tech Corp", Location: "Lisbon", Link: "https://example.com/go", Salary: 7000},
+		{Role: "SRE <Platform>", Company: "Infra Inc", Location: "Remote", Remote: true, Link: "https://example.com/sre", Salary: 9000},
+	}
+
+	t.Run("ShouldSendTheDigestAndMoveToTheNextPeriod", func(t *testing.T) {
+		scheduler, digestRepo, openingRepo, mailerMock := setupSchedulerTest()
+		started := pending
+		started.ID = 10
+
+		digestRepo.On("FindDue", now).Return([]schemas.DigestSubscription{newSubscription()}, nil).Once()
+		digestRepo.On("StartDelivery", pending).Return(&started, nil).Once()
+		openingRepo.On("FindCreatedBetween", confirmedAt, periodEnd).Return(openings, nil).Once()
+		mailerMock.On("Send", mock.MatchedBy(func(msg mailer.Message) bool {
+			return msg.Subject == "Your daily digest: 2 new openings" &&
+				strings.Contains(msg.Text, "* Go Developer at Tech Corp") &&
+				strings.Contains(msg.Text, "Apply: https://example.com/sre") &&
+				strings.Contains(msg.HTML, "SRE &lt;Platform&gt;") &&
+				strings.Contains(msg.HTML, baseURL+"/digests/unsubscribe?token=unsub") &&
+				msg.Headers["Message-ID"] == "<digest-4-10@go-opportunities>"
+		})).Return(nil).Once()
+		digestRepo.On("CompleteDelivery",
+			mock.MatchedBy(func(d schemas.DigestDelivery) bool {
+				return d.ID == 10 && d.Status == schemas.DigestDeliverySent && d.Openings == 2 && d.Attempts == 1 && d.SentAt != nil
+			}),
+			mock.MatchedBy(func(s schemas.DigestSubscription) bool {
+				return s.LastPeriodEnd.Equal(periodEnd) && s.NextRunAt.Equal(nextRun)
+			}),
+		).Return(nil).Once()
+
+		err := scheduler.RunDue(now)
+
+		assert.NoError(t, err)
+		mailerMock.AssertExpectations(t)
+		digestRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotEmailAnEmptyPeriod", func(t *testing.T) {
+		scheduler, digestRepo, openingRepo, mailerMock := setupSchedulerTest()
+
+		digestRepo.On("FindDue", now).Return([]schemas.DigestSubscription{newSubscription()}, nil).Once()
+		started := pending
+		digestRepo.On("StartDelivery", pending).Return(&started, nil).Once()
+		openingRepo.On("FindCreatedBetween", confirmedAt, periodEnd).Return([]schemas.Opening{}, nil).Once()
+		digestRepo.On("CompleteDelivery",
+			mock.MatchedBy(func(d schemas.DigestDelivery) bool { return d.Status == schemas.DigestDeliveryEmpty }),
+			mock.MatchedBy(func(s schemas.DigestSubscription) bool { return s.LastPeriodEnd.Equal(periodEnd) }),
+		).Return(nil).Once()
+
+		err := scheduler.RunDue(now)
+
+		assert.NoError(t, err)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything)
+	})
+
+	t.Run("ShouldNotResendAPeriodAlreadyDelivered", func(t *testing.T) {
+		scheduler, digestRepo, openingRepo, mailerMock := setupSchedulerTest()
+		sentAt := periodEnd
+		sent := pending
+		sent.Status = schemas.DigestDeliverySent
+		sent.SentAt = &sentAt
+
+		digestRepo.On("FindDue", now).Return([]schemas.DigestSubscription{newSubscription()}, nil).Once()
+		digestRepo.On("StartDelivery", pending).Return(&sent, nil).Once()
+		digestRepo.On("CompleteDelivery", sent, mock.MatchedBy(func(s schemas.DigestSubscription) bool {
+			return s.LastPeriodEnd.Equal(periodEnd) && s.NextRunAt.Equal(nextRun)
+		})).Return(nil).Once()
+
+		err := scheduler.RunDue(now)
+
+		assert.NoError(t, err)
+		openingRepo.AssertNotCalled(t, "FindCreatedBetween", mock.Anything, mock.Anything)
+		mailerMock.AssertNotCalled(t, "Send", mock.Anything)
+	})
+
+	t.Run("ShouldRetryTheSamePeriodWhenSendingFails", func(t *testing.T) {
+		scheduler, digestRepo, openingRepo, mailerMock := setupSchedulerTest()
+
+		digestRepo.On("FindDue", now).Return([]schemas.DigestSubscription{newSubscription()}, nil).Once()
+		started := pending
+		digestRepo.On("StartDelivery", pending).Return(&started, nil).Once()
+		openingRepo.On("FindCreatedBetween", confirmedAt, periodEnd).Return(openings, nil).Once()
+		mailerMock.On("Send", mock.Anything).Return(errors.New("421 try again later")).Once()
+		digestRepo.On("UpdateDelivery", mock.MatchedBy(func(d schemas.DigestDelivery) bool {
+			return d.Status == schemas.DigestDeliveryPending && d.Attempts == 1 && d.LastError == "421 try again later"
+		})).Return(nil).Once()
+
+		err := scheduler.RunDue(now)
+
+		assert.EqualError(t, err, "digest subscription 4: 421 try again later")
+		digestRepo.AssertNotCalled(t, "CompleteDelivery", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldCarryTheOpeningsOverAfterTheLastAttempt", func(t *testing.T) {
+		scheduler, digestRepo, openingRepo, mailerMock := setupSchedulerTest()
+		retried := pending
+		retried.Attempts = 4
+
+		digestRepo.On("FindDue", now).Return([]schemas.DigestSubscription{newSubscription()}, nil).Once()
+		digestRepo.On("StartDelivery", pending).Return(&retried, nil).Once()
+		openingRepo.On("FindCreatedBetween", confirmedAt, periodEnd).Return(openings, nil).Once()
+		mailerMock.On("Send", mock.Anything).Return(errors.New("550 mailbox unavailable")).Once()
+		digestRepo.On("CompleteDelivery",
+			mock.MatchedBy(func(d schemas.DigestDelivery) bool {
+				return d.Status == schemas.DigestDeliveryFailed && d.Attempts == 5
+			}),
+			mock.MatchedBy(func(s schemas.DigestSubscription) bool {
+				return s.LastPeriodEnd.Equal(confirmedAt) && s.NextRunAt.Equal(nextRun)
+			}),
+		).Return(nil).Once()
+
+		err := scheduler.RunDue(now)
+
+		assert.Error(t, err)
+		digestRepo.AssertExpectations(t)
+	})
+}
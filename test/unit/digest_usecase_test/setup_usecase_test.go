@@ -0,0 +1,30 @@
+package digest_usecase_test
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/digest_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+const baseURL = "https://jobs.example.com/api/v1"
+
+var schedule = schemas.DigestSchedule{Hour: 8, Weekday: time.Monday, Location: time.UTC}
+
+func setupUsecaseTest() (*digest_usecase.DigestUseCase, *mocks.DigestRepositoryMock, *mocks.MailerMock) {
+	repo := new(mocks.DigestRepositoryMock)
+	mailer := new(mocks.MailerMock)
+	uc := digest_usecase.NewDigestUseCase(repo, mailer, baseURL, schedule)
+
+	return uc, repo, mailer
+}
+
+func setupSchedulerTest() (*digest_usecase.Scheduler, *mocks.DigestRepositoryMock, *mocks.OpeningRepositoryMock, *mocks.MailerMock) {
+	repo := new(mocks.DigestRepositoryMock)
+	openingRepo := new(mocks.OpeningRepositoryMock)
+	mailer := new(mocks.MailerMock)
+	s := digest_usecase.NewScheduler(repo, openingRepo, mailer, baseURL, schedule)
+
+	return s, repo, openingRepo, mailer
+}
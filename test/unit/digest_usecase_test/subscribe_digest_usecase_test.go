@@ -0,0 +1,87 @@
+package digest_usecase_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"gorm.io/gorm"
+)
+
+func TestSubscribeDigestUsecase(t *testing.T) {
+	t.Run("ShouldStoreTheSubscriptionAndSendTheConfirmationLink", func(t *testing.T) {
+		digestUsecase, digestRepo, mailerMock := setupUsecaseTest()
+
+		var stored schemas.DigestSubscription
+		digestRepo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(0).(schemas.DigestSubscription)
+		}).Return(nil).Once()
+		mailerMock.On("Send", mock.MatchedBy(func(msg mailer.Message) bool {
+			return strings.Contains(msg.Text, baseURL+"/digests/confirm?token="+stored.ConfirmToken)
+		})).Return(nil).Once()
+
+		err := digestUsecase.Subscribe(schemas.CreateDigestSubscriptionRequest{Email: "ada@example.com", Cadence: schemas.DigestWeekly})
+
+		assert.Nil(t, err)
+		assert.Equal(t, schemas.DigestWeekly, stored.Cadence)
+		assert.Nil(t, stored.NextRunAt)
+		mailerMock.AssertExpectations(t)
+	})
+
+	t.Run("ShouldValidateTheRequest", func(t *testing.T) {
+		tests := []struct {
+			request schemas.CreateDigestSubscriptionRequest
+			message string
+		}{
+			{schemas.CreateDigestSubscriptionRequest{Cadence: "daily"}, "param: email (type: string) is required"},
+			{schemas.CreateDigestSubscriptionRequest{Email: "ada@", Cadence: "daily"}, "email must be a valid address"},
+			{schemas.CreateDigestSubscriptionRequest{Email: "ada@example.com"}, "param: cadence (type: string) is required"},
+			{schemas.CreateDigestSubscriptionRequest{Email: "ada@example.com", Cadence: "hourly"}, "cadence must be one of: daily, weekly"},
+		}
+
+		for _, tt := range tests {
+			digestUsecase, digestRepo, _ := setupUsecaseTest()
+
+			err := digestUsecase.Subscribe(tt.request)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.message, err.Message)
+			digestRepo.AssertNotCalled(t, "Create", mock.Anything)
+		}
+	})
+}
+
+func TestConfirmDigestUsecase(t *testing.T) {
+	t.Run("ShouldScheduleTheFirstDigest", func(t *testing.T) {
+		digestUsecase, digestRepo, _ := setupUsecaseTest()
+		subscription := schemas.DigestSubscription{Model: gorm.Model{ID: 1}, Cadence: schemas.DigestDaily}
+
+		digestRepo.On("FindByConfirmToken", "abc").Return(&subscription, nil).Once()
+		digestRepo.On("Update", mock.MatchedBy(func(s schemas.DigestSubscription) bool {
+			return s.ConfirmedAt != nil && s.LastPeriodEnd != nil && s.NextRunAt != nil &&
+				s.NextRunAt.After(*s.ConfirmedAt) && s.NextRunAt.Sub(*s.ConfirmedAt) <= 24*time.Hour &&
+				s.NextRunAt.Hour() == 8
+		})).Return(nil).Once()
+
+		confirmed, err := digestUsecase.Confirm("abc")
+
+		assert.Nil(t, err)
+		assert.NotNil(t, confirmed.NextRunAt)
+		digestRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownToken", func(t *testing.T) {
+		digestUsecase, digestRepo, _ := setupUsecaseTest()
+
+		digestRepo.On("FindByConfirmToken", "nope").Return((*schemas.DigestSubscription)(nil), gorm.ErrRecordNotFound).Once()
+
+		_, err := digestUsecase.Confirm("nope")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "digest subscription not found", err.Message)
+	})
+}
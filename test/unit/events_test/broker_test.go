@@ -0,0 +1,97 @@
+package events_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+)
+
+func TestBroker(t *testing.T) {
+	event := func(i int) schemas.OpeningEvent {
+		return schemas.OpeningEvent{ID: fmt.Sprintf("evt-%d", i), Type: schemas.OpeningCreatedEvent}
+	}
+
+	ids := func(events []schemas.OpeningEvent) []string {
+		var ids []string
+		for _, e := range events {
+			ids = append(ids, e.ID)
+		}
+		return ids
+	}
+
+	t.Run("ShouldSendPublishedEventsToSubscribers", func(t *testing.T) {
+		broker := events.NewBroker(10)
+		sub, replay, ok := broker.Subscribe("")
+		defer broker.Unsubscribe(sub)
+
+		assert.True(t, ok)
+		assert.Empty(t, replay)
+
+		assert.NoError(t, broker.Publish(event(1)))
+		assert.Equal(t, "evt-1", (<-sub.Events).ID)
+	})
+
+	t.Run("ShouldReplayTheEventsAfterTheLastEventID", func(t *testing.T) {
+		broker := events.NewBroker(10)
+		for i := 1; i <= 4; i++ {
+			broker.Publish(event(i))
+		}
+
+		sub, replay, ok := broker.Subscribe("evt-2")
+		defer broker.Unsubscribe(sub)
+
+		assert.True(t, ok)
+		assert.Equal(t, []string{"evt-3", "evt-4"}, ids(replay))
+	})
+
+	t.Run("ShouldReportAGapWhenTheLastEventIDIsNoLongerBuffered", func(t *testing.T) {
+		broker := events.NewBroker(3)
+		for i := 1; i <= 5; i++ {
+			broker.Publish(event(i))
+		}
+
+		sub, replay, ok := broker.Subscribe("evt-1")
+		defer broker.Unsubscribe(sub)
+		assert.False(t, ok)
+		assert.Empty(t, replay)
+
+		sub, replay, ok = broker.Subscribe("evt-3")
+		defer broker.Unsubscribe(sub)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"evt-4", "evt-5"}, ids(replay))
+	})
+
+	t.Run("ShouldSendARepublishedEventOnce", func(t *testing.T) {
+		broker := events.NewBroker(10)
+		sub, _, _ := broker.Subscribe("")
+		defer broker.Unsubscribe(sub)
+
+		broker.Publish(event(1))
+		broker.Publish(event(1))
+		broker.Publish(event(2))
+
+		assert.Equal(t, "evt-1", (<-sub.Events).ID)
+		assert.Equal(t, "evt-2", (<-sub.Events).ID)
+	})
+
+	t.Run("ShouldDropASubscriberThatFallsBehind", func(t *testing.T) {
+		broker := events.NewBroker(10)
+		sub, _, _ := broker.Subscribe("")
+
+		for i := 0; i < 100; i++ {
+			broker.Publish(event(i))
+		}
+
+		received := 0
+		for range sub.Events {
+			received++
+		}
+		assert.Less(t, received, 100)
+
+		// Unsubscribing a dropped subscriber is harmless.
+		broker.Unsubscribe(sub)
+	})
+}
@@ -0,0 +1,108 @@
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+var event = schemas.OpeningEvent{
+	ID:         "0f1e2d3c4b5a69788796a5b4c3d2e1f0",
+	Type:       schemas.OpeningCreatedEvent,
+	OccurredAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+	Opening:    schemas.Opening{Model: gorm.Model{ID: 7}, Role: "Golang Developer"},
+}
+
+func TestNATSPublisher(t *testing.T) {
+	t.Run("ShouldPublishWithTheEventIDAsDeduplicationHeader", func(t *testing.T) {
+		server := mocks.NewNATSServerMock(true)
+		defer server.Close()
+
+		publisher, err := events.NewNATSPublisher(events.NATSConfig{URL: server.URL(), SubjectPrefix: "jobs"})
+		assert.NoError(t, err)
+		defer publisher.Close()
+
+		assert.NoError(t, publisher.Publish(event))
+		assert.NoError(t, publisher.Publish(event))
+
+		payload, _ := json.Marshal(event)
+		messages := server.Messages()
+		if assert.Len(t, messages, 2) {
+			assert.Equal(t, "jobs.opening.created", messages[0].Subject)
+			assert.Equal(t, event.ID, messages[0].Headers["Nats-Msg-Id"])
+			assert.JSONEq(t, string(payload), messages[0].Data)
+		}
+		assert.Len(t, server.Connects(), 1, "the connection should be reused")
+	})
+
+	t.Run("ShouldFallBackToPUBWithoutHeaderSupport", func(t *testing.T) {
+		server := mocks.NewNATSServerMock(false)
+		defer server.Close()
+
+		publisher, _ := events.NewNATSPublisher(events.NATSConfig{URL: server.URL()})
+		defer publisher.Close()
+
+		assert.NoError(t, publisher.Publish(event))
+
+		messages := server.Messages()
+		if assert.Len(t, messages, 1) {
+			assert.Equal(t, "go-opportunities.opening.created", messages[0].Subject)
+			assert.Empty(t, messages[0].Headers)
+		}
+	})
+
+	t.Run("ShouldSendTheCredentialsOfTheURL", func(t *testing.T) {
+		server := mocks.NewNATSServerMock(true)
+		defer server.Close()
+
+		publisher, _ := events.NewNATSPublisher(events.NATSConfig{URL: "nats://relay:s3cret@" + server.URL()[len("nats://"):]})
+		defer publisher.Close()
+
+		assert.NoError(t, publisher.Publish(event))
+
+		connects := server.Connects()
+		if assert.Len(t, connects, 1) {
+			assert.Equal(t, "relay", connects[0]["user"])
+			assert.Equal(t, "s3cret", connects[0]["pass"])
+			assert.Equal(t, true, connects[0]["headers"])
+		}
+	})
+
+	t.Run("ShouldReturnTheServerErrorAndReconnect", func(t *testing.T) {
+		server := mocks.NewNATSServerMock(true)
+		defer server.Close()
+
+		publisher, _ := events.NewNATSPublisher(events.NATSConfig{URL: server.URL()})
+		defer publisher.Close()
+
+		server.Reject("Permissions Violation for Publish")
+		err := publisher.Publish(event)
+		assert.EqualError(t, err, "nats: Permissions Violation for Publish")
+
+		server.Reject("")
+		assert.NoError(t, publisher.Publish(event))
+		assert.Len(t, server.Messages(), 1)
+		assert.Len(t, server.Connects(), 2)
+	})
+
+	t.Run("ShouldFailWhenTheServerIsDown", func(t *testing.T) {
+		server := mocks.NewNATSServerMock(true)
+		server.Close()
+
+		publisher, _ := events.NewNATSPublisher(events.NATSConfig{URL: server.URL(), Timeout: time.Second})
+
+		assert.Error(t, publisher.Publish(event))
+	})
+
+	t.Run("ShouldRejectAnInvalidURL", func(t *testing.T) {
+		_, err := events.NewNATSPublisher(events.NATSConfig{URL: "http://localhost:4222"})
+
+		assert.EqualError(t, err, `invalid nats url: "http://localhost:4222"`)
+	})
+}
@@ -0,0 +1,117 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/export"
+)
+
+func TestExportWriter(t *testing.T) {
+	columns := []string{"id", "role", "remote", "createdAt"}
+	createdAt := time.Date(2026, 10, 19, 8, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+	rows := [][]interface{}{
+		{uint(1), "Go Developer, Backend", true, createdAt},
+		{uint(2), `SRE "on call" <24/7>`, false, createdAt},
+	}
+
+	write := func(t *testing.T, format string) []byte {
+		var buf bytes.Buffer
+		w, err := export.NewWriter(format, &buf, columns)
+		assert.NoError(t, err)
+		for _, row := range rows {
+			assert.NoError(t, w.WriteRow(row))
+		}
+		assert.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+
+	t.Run("ShouldWriteCSVWithAHeader", func(t *testing.T) {
+		assert.Equal(t, "id,role,remote,createdAt\n"+
+			"1,\"Go Developer, Backend\",true,2026-10-19T11:30:00Z\n"+
+			"2,\"SRE \"\"on call\"\" <24/7>\",false,2026-10-19T11:30:00Z\n", string(write(t, export.FormatCSV)))
+	})
+
+	t.Run("ShouldWriteNDJSONWithKeysInColumnOrder", func(t *testing.T) {
+		assert.Equal(t, `{"id":1,"role":"Go Developer, Backend","remote":true,"createdAt":"2026-10-19T11:30:00Z"}
+{"id":2,"role":"SRE \"on call\" <24/7>","remote":false,"createdAt":"2026-10-19T11:30:00Z"}
+`, string(write(t, export.FormatNDJSON)))
+	})
+
+	t.Run("ShouldWriteAWorkbookWithTypedCells", func(t *testing.T) {
+		file := write(t, export.FormatXLSX)
+
+		archive, err := zip.NewReader(bytes.NewReader(file), int64(len(file)))
+		assert.NoError(t, err)
+
+		var names []string
+		var sheet string
+		for _, f := range archive.File {
+			names = append(names, f.Name)
+			if f.Name == "xl/worksheets/sheet1.xml" {
+				r, _ := f.Open()
+				body, _ := io.ReadAll(r)
+				sheet = string(body)
+			}
+		}
+		assert.ElementsMatch(t, []string{
+			"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml",
+		}, names)
+		assert.Contains(t, sheet, `<row><c t="inlineStr"><is><t xml:space="preserve">id</t></is></c>`)
+		assert.Contains(t, sheet, `<row><c><v>1</v></c><c t="inlineStr"><is><t xml:space="preserve">Go Developer, Backend</t></is></c><c t="b"><v>1</v></c>`)
+		assert.Contains(t, sheet, `SRE &#34;on call&#34; &lt;24/7&gt;`)
+		assert.Contains(t, sheet, `</sheetData></worksheet>`)
+	})
+
+	t.Run("ShouldNotLetTextRunAsAFormula", func(t *testing.T) {
+		formulas := [][]interface{}{
+			{uint(3), "=HYPERLINK(\"http://evil.example\")", false, createdAt},
+			{uint(4), "+1", false, createdAt},
+			{uint(5), "-2", false, createdAt},
+			{uint(6), "@SUM(A1)", false, createdAt},
+			{uint(7), "\tcmd", false, createdAt},
+			{uint(8), "\rcmd", false, createdAt},
+			{uint(9), "C++ Developer", false, createdAt},
+		}
+
+		var csvFile bytes.Buffer
+		w, err := export.NewWriter(export.FormatCSV, &csvFile, columns)
+		assert.NoError(t, err)
+		for _, row := range formulas {
+			assert.NoError(t, w.WriteRow(row))
+		}
+		assert.NoError(t, w.Close())
+
+		records, err := csv.NewReader(&csvFile).ReadAll()
+		assert.NoError(t, err)
+		var roles []string
+		for _, record := range records[1:] {
+			roles = append(roles, record[1])
+		}
+		assert.Equal(t, []string{"'=HYPERLINK(\"http://evil.example\")", "'+1", "'-2", "'@SUM(A1)", "'\tcmd", "'\rcmd", "C++ Developer"}, roles)
+
+		var xlsxFile bytes.Buffer
+		w, err = export.NewWriter(export.FormatXLSX, &xlsxFile, columns)
+		assert.NoError(t, err)
+		assert.NoError(t, w.WriteRow(formulas[0]))
+		assert.NoError(t, w.Close())
+
+		archive, err := zip.NewReader(bytes.NewReader(xlsxFile.Bytes()), int64(xlsxFile.Len()))
+		assert.NoError(t, err)
+		sheet, _ := archive.Open("xl/worksheets/sheet1.xml")
+		body, _ := io.ReadAll(sheet)
+		assert.Contains(t, string(body), `<c t="inlineStr"><is><t xml:space="preserve">=HYPERLINK(&#34;http://evil.example&#34;)</t></is></c>`)
+		assert.NotContains(t, string(body), "<f>")
+	})
+
+	t.Run("ShouldRejectAnUnknownFormat", func(t *testing.T) {
+		_, err := export.NewWriter("pdf", io.Discard, columns)
+
+		assert.EqualError(t, err, `unknown export format "pdf"`)
+	})
+}
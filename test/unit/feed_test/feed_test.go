@@ -0,0 +1,117 @@
+package feed_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/feed"
+)
+
+func TestFeedWriters(t *testing.T) {
+	published := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	updated := time.Date(2026, 10, 2, 10, 30, 0, 0, time.UTC)
+	f := feed.Feed{
+		Title:       "Job openings",
+		Description: "The newest job openings",
+		Link:        "http://example.com/api/v1/openings",
+		FeedURL:     "http://example.com/feeds/openings.atom?remote=true",
+		Author:      "go-opportunities",
+		Updated:     updated,
+		Items: []feed.Item{{
+			ID:        "http://example.com/api/v1/openings/7",
+			Title:     "Go Developer at Acme & Co",
+			Link:      "http://acme.example/jobs/7",
+			Content:   "Acme is hiring",
+			Published: published,
+			Updated:   updated,
+		}},
+	}
+
+	t.Run("ShouldWriteRSS", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, feed.WriteRSS(&buf, f))
+
+		var doc struct {
+			Version string `xml:"version,attr"`
+			Channel struct {
+				Title         string `xml:"title"`
+				LastBuildDate string `xml:"lastBuildDate"`
+				Items         []struct {
+					Title string `xml:"title"`
+					Link  string `xml:"link"`
+					GUID  struct {
+						IsPermaLink string `xml:"isPermaLink,attr"`
+						Value       string `xml:",chardata"`
+					} `xml:"guid"`
+					PubDate string `xml:"pubDate"`
+				} `xml:"item"`
+			} `xml:"channel"`
+		}
+		assert.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+		assert.Equal(t, "2.0", doc.Version)
+		assert.Equal(t, "Fri, 02 Oct 2026 10:30:00 +0000", doc.Channel.LastBuildDate)
+		assert.Len(t, doc.Channel.Items, 1)
+		assert.Equal(t, "Go Developer at Acme & Co", doc.Channel.Items[0].Title)
+		assert.Equal(t, "false", doc.Channel.Items[0].GUID.IsPermaLink)
+		assert.Equal(t, "http://example.com/api/v1/openings/7", doc.Channel.Items[0].GUID.Value)
+		assert.Equal(t, "Thu, 01 Oct 2026 09:00:00 +0000", doc.Channel.Items[0].PubDate)
+		assert.Contains(t, buf.String(), `<atom:link href="http://example.com/feeds/openings.atom?remote=true" rel="self"`)
+	})
+
+	t.Run("ShouldWriteAtom", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, feed.WriteAtom(&buf, f))
+
+		var doc struct {
+			XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+			ID      string   `xml:"id"`
+			Updated string   `xml:"updated"`
+			Entries []struct {
+				ID        string `xml:"id"`
+				Published string `xml:"published"`
+				Updated   string `xml:"updated"`
+				Link      struct {
+					Href string `xml:"href,attr"`
+				} `xml:"link"`
+			} `xml:"entry"`
+		}
+		assert.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+		assert.Equal(t, f.FeedURL, doc.ID)
+		assert.Equal(t, "2026-10-02T10:30:00Z", doc.Updated)
+		assert.Len(t, doc.Entries, 1)
+		assert.Equal(t, "http://example.com/api/v1/openings/7", doc.Entries[0].ID)
+		assert.Equal(t, "2026-10-01T09:00:00Z", doc.Entries[0].Published)
+		assert.Equal(t, "2026-10-02T10:30:00Z", doc.Entries[0].Updated)
+		assert.Equal(t, "http://acme.example/jobs/7", doc.Entries[0].Link.Href)
+	})
+
+	t.Run("ShouldWriteJSONFeed", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, feed.WriteJSON(&buf, f))
+
+		var doc map[string]interface{}
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+		assert.Equal(t, "https://jsonfeed.org/version/1.1", doc["version"])
+		assert.Equal(t, f.FeedURL, doc["feed_url"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "go-opportunities"}}, doc["authors"])
+		assert.Equal(t, []interface{}{map[string]interface{}{
+			"id":             "http://example.com/api/v1/openings/7",
+			"url":            "http://acme.example/jobs/7",
+			"title":          "Go Developer at Acme & Co",
+			"content_text":   "Acme is hiring",
+			"date_published": "2026-10-01T09:00:00Z",
+			"date_modified":  "2026-10-02T10:30:00Z",
+		}}, doc["items"])
+	})
+
+	t.Run("ShouldListNoItemsInAnEmptyJSONFeed", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, feed.WriteJSON(&buf, feed.Feed{Title: "Job openings"}))
+
+		assert.Contains(t, buf.String(), `"items": []`)
+	})
+}
@@ -0,0 +1,185 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/graphql"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func setupSchemaTest(t *testing.T) (func(query string, variables map[string]interface{}) *gql.Result, *mocks.OpeningUseCaseMock) {
+	useCase := new(mocks.OpeningUseCaseMock)
+	schema, err := graphql.NewSchema(useCase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(query string, variables map[string]interface{}) *gql.Result {
+		return gql.Do(gql.Params{Schema: schema, RequestString: query, VariableValues: variables})
+	}
+	return run, useCase
+}
+
+func TestSchema(t *testing.T) {
+	opening := &schemas.Opening{
+		Model:       gorm.Model{ID: 7, CreatedAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+		Role:        "Go Developer",
+		Company:     "Acme",
+		Location:    "Lisbon",
+		Remote:      true,
+		Link:        "http://example.com/go",
+		Salary:      9000,
+		StageCounts: map[string]int64{"screening": 1, "applied": 3},
+	}
+
+	t.Run("ShouldReturnTheRequestedFieldsOfAnOpening", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		useCase.On("GetByID", uint(7)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		result := run(`{ opening(id: "7") { id role company { name } remote status createdAt stageCounts { stage count } } }`, nil)
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{
+			"opening": map[string]interface{}{
+				"id":        "7",
+				"role":      "Go Developer",
+				"company":   map[string]interface{}{"name": "Acme"},
+				"remote":    true,
+				"status":    "open",
+				"createdAt": "2024-05-01T10:00:00Z",
+				"stageCounts": []interface{}{
+					map[string]interface{}{"stage": "applied", "count": 3},
+					map[string]interface{}{"stage": "screening", "count": 1},
+				},
+			},
+		}, result.Data)
+	})
+
+	t.Run("ShouldReturnTheErrorCodeOfTheUsecase", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		useCase.On("GetByID", uint(9)).Return((*schemas.Opening)(nil), internal_error.NewNotFoundError("opening not found")).Once()
+
+		result := run(`{ opening(id: "9") { id } }`, nil)
+
+		if assert.Len(t, result.Errors, 1) {
+			assert.Equal(t, "opening not found", result.Errors[0].Message)
+			assert.Equal(t, "NOT_FOUND", result.Errors[0].Extensions["code"])
+		}
+	})
+
+	t.Run("ShouldRejectAnInvalidID", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+
+		result := run(`{ opening(id: "abc") { id } }`, nil)
+
+		if assert.Len(t, result.Errors, 1) {
+			assert.Equal(t, "invalid ID", result.Errors[0].Message)
+			assert.Equal(t, "BAD_REQUEST", result.Errors[0].Extensions["code"])
+		}
+		useCase.AssertNotCalled(t, "GetByID", uint(0))
+	})
+
+	t.Run("ShouldListOpeningsWithTheFilter", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		remote := true
+		filter := schemas.OpeningFilter{Query: "go", Remote: &remote, MinSalary: 5000}
+		useCase.On("ListOpenings", filter, 2).Return([]schemas.Opening{*opening}, (*internal_error.InternalError)(nil)).Once()
+
+		result := run(`query ($page: Int) { openings(filter: {q: "go", remote: true, minSalary: 5000}, page: $page) { id salary } }`, map[string]interface{}{"page": 2})
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{
+			"openings": []interface{}{map[string]interface{}{"id": "7", "salary": 9000}},
+		}, result.Data)
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnAnEmptyListWhenNothingMatches", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		useCase.On("ListOpenings", schemas.OpeningFilter{}, 1).Return([]schemas.Opening{}, internal_error.NewNotFoundError("opening record not found")).Once()
+
+		result := run(`{ openings { id } }`, nil)
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{"openings": []interface{}{}}, result.Data)
+	})
+
+	t.Run("ShouldListTheOpeningsOfTheCompany", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		other := schemas.Opening{Model: gorm.Model{ID: 8}, Role: "Go Engineer", Company: "Acme"}
+		useCase.On("GetByID", uint(7)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+		useCase.On("ListOpenings", schemas.OpeningFilter{Company: "Acme"}, 1).Return([]schemas.Opening{*opening, other}, (*internal_error.InternalError)(nil)).Once()
+
+		result := run(`{ opening(id: "7") { company { name openings { id role } } } }`, nil)
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{
+			"opening": map[string]interface{}{
+				"company": map[string]interface{}{
+					"name": "Acme",
+					"openings": []interface{}{
+						map[string]interface{}{"id": "7", "role": "Go Developer"},
+						map[string]interface{}{"id": "8", "role": "Go Engineer"},
+					},
+				},
+			},
+		}, result.Data)
+	})
+
+	t.Run("ShouldCreateAnOpening", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		remote := true
+		req := schemas.CreateOpeningRequest{Role: "Go Developer", Company: "Acme", Location: "Lisbon", Remote: &remote, Link: "http://example.com/go", Salary: 9000}
+		useCase.On("Create", req).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		result := run(`mutation {
+			createOpening(input: {role: "Go Developer", company: "Acme", location: "Lisbon", remote: true, link: "http://example.com/go", salary: 9000}) { id }
+		}`, nil)
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{"createOpening": map[string]interface{}{"id": "7"}}, result.Data)
+	})
+
+	t.Run("ShouldReturnTheValidationErrorOfCreate", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		useCase.On("Create", mock.Anything).Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("salary must be at least 3k")).Once()
+
+		result := run(`mutation {
+			createOpening(input: {role: "Go Developer", company: "Acme", location: "Lisbon", remote: true, link: "http://example.com/go", salary: 10}) { id }
+		}`, nil)
+
+		if assert.Len(t, result.Errors, 1) {
+			assert.Equal(t, "salary must be at least 3k", result.Errors[0].Message)
+			assert.Equal(t, "BAD_REQUEST", result.Errors[0].Extensions["code"])
+		}
+	})
+
+	t.Run("ShouldUpdateAnOpeningAndReturnIt", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		useCase.On("Update", uint(7), (*schemas.Precondition)(nil)).Return((*internal_error.InternalError)(nil)).Once()
+		useCase.On("GetByID", uint(7)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		result := run(`mutation { updateOpening(id: "7", input: {salary: 9000, remote: true}) { id salary } }`, nil)
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{"updateOpening": map[string]interface{}{"id": "7", "salary": 9000}}, result.Data)
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldDeleteAnOpening", func(t *testing.T) {
+		run, useCase := setupSchemaTest(t)
+		useCase.On("DeleteByID", uint(7), (*schemas.Precondition)(nil)).Return((*internal_error.InternalError)(nil)).Once()
+
+		result := run(`mutation { deleteOpening(id: "7") }`, nil)
+
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, map[string]interface{}{"deleteOpening": true}, result.Data)
+	})
+}
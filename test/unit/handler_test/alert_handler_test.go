@@ -0,0 +1,72 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestAlertHandler(t *testing.T) {
+	alertReq := schemas.CreateJobAlertRequest{Email: "ada@example.com", Keywords: "golang"}
+
+	t.Run("ShouldSubscribe", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.AlertUseCaseMock)
+		handler := handler.NewAlertHandler(mockUseCase)
+		router.POST("/alerts", handler.Subscribe)
+
+		mockUseCase.On("Subscribe", alertReq).Return((*internal_error.InternalError)(nil)).Once()
+
+		reqJsonBody, _ := json.Marshal(alertReq)
+		req, _ := http.NewRequest("POST", "/alerts", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), "check your inbox to confirm it")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldConfirm", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.AlertUseCaseMock)
+		handler := handler.NewAlertHandler(mockUseCase)
+		router.GET("/alerts/confirm", handler.Confirm)
+
+		mockUseCase.On("Confirm", "abc").Return(&schemas.JobAlert{Email: "ada@example.com"}, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/alerts/confirm?token=abc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "confirm-alert successfully")
+		assert.NotContains(t, w.Body.String(), "ConfirmToken")
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownUnsubscribeToken", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.AlertUseCaseMock)
+		handler := handler.NewAlertHandler(mockUseCase)
+		router.POST("/alerts/unsubscribe", handler.Unsubscribe)
+
+		mockUseCase.On("Unsubscribe", "nope").Return(internal_error.NewNotFoundError("alert not found")).Once()
+
+		req, _ := http.NewRequest("POST", "/alerts/unsubscribe?token=nope", bytes.NewBufferString("List-Unsubscribe=One-Click"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "alert not found")
+	})
+}
@@ -0,0 +1,139 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestCreateApplicationHandler(t *testing.T) {
+	applicationReq := schemas.CreateApplicationRequest{
+		CandidateName: "Ada Lovelace",
+		Email:         "ada@example.com",
+	}
+
+	t.Run("ShouldSuccessfullyApplyToAnOpening", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.POST("/openings/:id/applications", handler.Create)
+
+		mockUseCase.On("Create", uint(7), applicationReq).Return((*internal_error.InternalError)(nil)).Once()
+
+		reqJsonBody, _ := json.Marshal(applicationReq)
+		req, _ := http.NewRequest("POST", "/openings/7/applications", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), "application of Ada Lovelace created successfully")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheOpeningIsClosed", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.POST("/openings/:id/applications", handler.Create)
+
+		mockUseCase.On("Create", uint(7), applicationReq).Return(internal_error.NewBadRequestError("opening is closed for applications")).Once()
+
+		reqJsonBody, _ := json.Marshal(applicationReq)
+		req, _ := http.NewRequest("POST", "/openings/7/applications", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "opening is closed for applications")
+	})
+
+	t.Run("ShouldReturnBadRequestWhenIDIsInvalid", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.POST("/openings/:id/applications", handler.Create)
+
+		req, _ := http.NewRequest("POST", "/openings/invalid/applications", bytes.NewBuffer([]byte("{}")))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid ID")
+		mockUseCase.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListApplicationHandler(t *testing.T) {
+	t.Run("ShouldListTheApplicationsOfAnOpening", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.GET("/openings/:id/applications", handler.List)
+
+		applications := []schemas.Application{{Model: gorm.Model{ID: 1}, OpeningID: 7, CandidateName: "Ada"}}
+		mockUseCase.On("ListByOpening", uint(7), 2).Return(applications, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/openings/7/applications?page=2", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var resp struct {
+			Message string                `json:"message"`
+			Data    []schemas.Application `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, err)
+		assert.Equal(t, "list-applications successfully", resp.Message)
+		assert.Len(t, resp.Data, 1)
+	})
+
+	t.Run("ShouldReturnBadRequestWhenPageIsInvalid", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.GET("/openings/:id/applications", handler.List)
+
+		req, _ := http.NewRequest("GET", "/openings/7/applications?page=x", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid page number")
+	})
+}
+
+func TestShowApplicationHandler(t *testing.T) {
+	t.Run("ShouldReturnNotFoundWhenApplicationDoesNotExist", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.GET("/applications/:id", handler.ShowApplication)
+
+		mockUseCase.On("GetByID", uint(9)).Return((*schemas.Application)(nil), internal_error.NewNotFoundError("application not found")).Once()
+
+		req, _ := http.NewRequest("GET", "/applications/9", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "application not found")
+	})
+}
@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/config/rest_err"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestBulkOpeningHandler(t *testing.T) {
+	sendBulk := func(mockUseCase *mocks.OpeningUseCaseMock, body string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.POST("/openings/bulk", handler.Bulk)
+
+		req, _ := http.NewRequest("POST", "/openings/bulk", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldReturnAResultPerOperation", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Bulk", mock.MatchedBy(func(req schemas.BulkOpeningRequest) bool {
+			return req.Mode == schemas.BulkModeBestEffort && len(req.Operations) == 4
+		})).Return([]opening_usecase.BulkResult{
+			{ID: 9},
+			{Err: internal_error.NewBadRequestError("salary must be at least 3k", internal_error.Cause{Field: "salary", Message: "salary must be at least 3k"})},
+			{ID: 4},
+			{ID: 5, Err: internal_error.NewNotFoundError("opening not found")},
+		}, (*internal_error.InternalError)(nil)).Once()
+
+		w := sendBulk(mockUseCase, `{"mode":"best_effort","operations":[
+			{"op":"create","opening":{"role":"Go"}},
+			{"op":"create","opening":{"salary":100}},
+			{"op":"update","id":4,"opening":{"role":"Go"}},
+			{"op":"delete","id":5}
+		]}`)
+
+		var resp handler.BulkOpeningResponse
+		json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "bulk-openings successfully", resp.Message)
+		assert.Equal(t, 2, resp.Data.Applied)
+		assert.Equal(t, 2, resp.Data.Failed)
+		assert.Equal(t, []handler.BulkOpeningResult{
+			{Index: 0, Op: "create", ID: 9, Status: http.StatusCreated},
+			{Index: 1, Op: "create", Status: http.StatusBadRequest, Message: "salary must be at least 3k", Causes: []rest_err.Causes{
+				{Field: "salary", Message: "salary must be at least 3k"},
+			}},
+			{Index: 2, Op: "update", ID: 4, Status: http.StatusOK},
+			{Index: 3, Op: "delete", ID: 5, Status: http.StatusNotFound, Message: "opening not found"},
+		}, resp.Data.Results)
+	})
+
+	t.Run("ShouldMarkRolledBackOperations", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Bulk", mock.Anything).Return([]opening_usecase.BulkResult{
+			{ID: 9, RolledBack: true},
+			{Err: internal_error.NewBadRequestError("param: link (type: string) is required")},
+		}, (*internal_error.InternalError)(nil)).Once()
+
+		w := sendBulk(mockUseCase, `{"operations":[{"op":"create"},{"op":"create"}]}`)
+
+		var resp handler.BulkOpeningResponse
+		json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, 0, resp.Data.Applied)
+		assert.Equal(t, handler.BulkOpeningResult{
+			Index: 0, Op: "create", ID: 9, Status: http.StatusFailedDependency, Message: "not applied because another operation failed",
+		}, resp.Data.Results[0])
+	})
+
+	t.Run("ShouldReturnTheUsecaseError", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Bulk", mock.Anything).
+			Return([]opening_usecase.BulkResult(nil), internal_error.NewBadRequestError("mode must be one of: transactional, best_effort")).Once()
+
+		w := sendBulk(mockUseCase, `{"mode":"atomic","operations":[{"op":"create"}]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "mode must be one of: transactional, best_effort")
+	})
+
+	t.Run("ShouldRejectAMalformedBody", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+
+		w := sendBulk(mockUseCase, `[{"op":"create"}]`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertNotCalled(t, "Bulk", mock.Anything)
+	})
+}
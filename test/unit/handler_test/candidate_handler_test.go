@@ -0,0 +1,114 @@
+package handler_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func candidateForm(t *testing.T, fields map[string]string, fileName string, content []byte) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for k, v := range fields {
+		writer.WriteField(k, v)
+	}
+	if fileName != "" {
+		part, err := writer.CreateFormFile("resume", fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(content)
+	}
+	writer.Close()
+
+	return body, writer.FormDataContentType()
+}
+
+func TestCreateCandidateHandler(t *testing.T) {
+	fields := map[string]string{"name": "Ada Lovelace", "email": "ada@example.com"}
+	request := schemas.CreateCandidateProfileRequest{Name: "Ada Lovelace", Email: "ada@example.com"}
+
+	t.Run("ShouldUploadTheResume", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.CandidateUseCaseMock)
+		handler := handler.NewCandidateHandler(mockUseCase, 1024)
+		router.POST("/openings/:id/candidates", handler.Create)
+
+		mockUseCase.On("Create", uint(7), request, "cv.pdf").Return((*internal_error.InternalError)(nil)).Once()
+
+		body, contentType := candidateForm(t, fields, "cv.pdf", []byte("%PDF-1.7"))
+		req, _ := http.NewRequest("POST", "/openings/7/candidates", body)
+		req.Header.Set("Content-Type", contentType)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), "candidate profile of Ada Lovelace created successfully")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnTheUsecaseValidationError", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.CandidateUseCaseMock)
+		handler := handler.NewCandidateHandler(mockUseCase, 1024)
+		router.POST("/openings/:id/candidates", handler.Create)
+
+		mockUseCase.On("Create", uint(7), request, "").Return(internal_error.NewBadRequestError("param: resume (type: file) is required")).Once()
+
+		body, contentType := candidateForm(t, fields, "", nil)
+		req, _ := http.NewRequest("POST", "/openings/7/candidates", body)
+		req.Header.Set("Content-Type", contentType)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "param: resume (type: file) is required")
+	})
+
+	t.Run("ShouldRejectBodiesOverTheUploadLimit", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.CandidateUseCaseMock)
+		handler := handler.NewCandidateHandler(mockUseCase, 1024)
+		router.POST("/openings/:id/candidates", handler.Create)
+
+		body, contentType := candidateForm(t, fields, "cv.pdf", bytes.Repeat([]byte("x"), 2<<20))
+		req, _ := http.NewRequest("POST", "/openings/7/candidates", body)
+		req.Header.Set("Content-Type", contentType)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		mockUseCase.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestResumeURLHandler(t *testing.T) {
+	t.Run("ShouldReturnTheSignedURL", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.CandidateUseCaseMock)
+		handler := handler.NewCandidateHandler(mockUseCase, 1024)
+		router.GET("/candidates/:id/resume", handler.ResumeURL)
+
+		signed := schemas.ResumeURLResponse{URL: "http://localhost/files/resumes/a.pdf?signature=x"}
+		mockUseCase.On("ResumeURL", uint(3)).Return(&signed, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/candidates/3/resume", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "signature=x")
+	})
+}
@@ -30,7 +30,7 @@ func TestCreateOpeningHandler(t *testing.T) {
 			Salary:   50000,
 		}
 
-		mockUseCase.On("Create", openingReq).Return((*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("Create", openingReq).Return(&schemas.Opening{}, (*internal_error.InternalError)(nil)).Once()
 
 		reqJsonBody, _ := json.Marshal(openingReq)
 		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
@@ -60,7 +60,7 @@ func TestCreateOpeningHandler(t *testing.T) {
 			Salary:   50000,
 		}
 		expectedErr := "param: role (type: string) is required"
-		mockUseCase.On("Create", openingReq).Return(internal_error.NewBadRequestError("param: role (type: string) is required")).Once()
+		mockUseCase.On("Create", openingReq).Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("param: role (type: string) is required")).Once()
 
 		reqJsonBody, _ := json.Marshal(openingReq)
 		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
@@ -92,7 +92,7 @@ func TestCreateOpeningHandler(t *testing.T) {
 		}
 
 		expectedErr := "param: company (type: string) is required"
-		mockUseCase.On("Create", openingReq).Return(internal_error.NewBadRequestError("param: company (type: string) is required")).Once()
+		mockUseCase.On("Create", openingReq).Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("param: company (type: string) is required")).Once()
 
 		reqJsonBody, _ := json.Marshal(openingReq)
 		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
@@ -124,7 +124,7 @@ func TestCreateOpeningHandler(t *testing.T) {
 		}
 
 		expectedErr := "param: location (type: string) is required"
-		mockUseCase.On("Create", openingReq).Return(internal_error.NewBadRequestError("param: location (type: string) is required")).Once()
+		mockUseCase.On("Create", openingReq).Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("param: location (type: string) is required")).Once()
 
 		reqJsonBody, _ := json.Marshal(openingReq)
 		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
@@ -156,7 +156,7 @@ func TestCreateOpeningHandler(t *testing.T) {
 		}
 
 		expectedErr := "param: link (type: string) is required"
-		mockUseCase.On("Create", openingReq).Return(internal_error.NewBadRequestError("param: link (type: string) is required")).Once()
+		mockUseCase.On("Create", openingReq).Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("param: link (type: string) is required")).Once()
 
 		reqJsonBody, _ := json.Marshal(openingReq)
 		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
@@ -171,6 +171,77 @@ func TestCreateOpeningHandler(t *testing.T) {
 		mockUseCase.AssertExpectations(t)
 	})
 
+	t.Run("ShouldReturnTheCauseOfAnInvalidLink", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.POST("/openings", handler.Create)
+
+		remote := true
+		openingReq := schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Tech Corp",
+			Location: "São Paulo",
+			Link:     "javascript:alert(1)",
+			Remote:   &remote,
+			Salary:   50000,
+		}
+
+		message := "link must be an absolute http or https URL"
+		mockUseCase.On("Create", openingReq).
+			Return((*schemas.Opening)(nil), internal_error.NewBadRequestError(message, internal_error.Cause{Field: "link", Message: message})).Once()
+
+		reqJsonBody, _ := json.Marshal(openingReq)
+		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{
+			"message": "link must be an absolute http or https URL",
+			"err": "bad_request",
+			"errorCode": 400,
+			"causes": [{"field": "link", "message": "link must be an absolute http or https URL"}]
+		}`, w.Body.String())
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnEveryInvalidFieldAsACause", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.POST("/openings", handler.Create)
+
+		openingReq := schemas.CreateOpeningRequest{Role: "Go Developer"}
+		mockUseCase.On("Create", openingReq).
+			Return((*schemas.Opening)(nil), internal_error.NewBadRequestError(
+				"param: company (type: string) is required; salary must be at least 3k",
+				internal_error.Cause{Field: "company", Message: "param: company (type: string) is required"},
+				internal_error.Cause{Field: "salary", Message: "salary must be at least 3k"},
+			)).Once()
+
+		reqJsonBody, _ := json.Marshal(openingReq)
+		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{
+			"message": "param: company (type: string) is required; salary must be at least 3k",
+			"err": "bad_request",
+			"errorCode": 400,
+			"causes": [
+				{"field": "company", "message": "param: company (type: string) is required"},
+				{"field": "salary", "message": "salary must be at least 3k"}
+			]
+		}`, w.Body.String())
+		mockUseCase.AssertExpectations(t)
+	})
+
 	t.Run("ShouldReturnAnErrorIfTheRemoteFieldIsMissing", func(t *testing.T) {
 		router := setupRouter()
 		mockUseCase := new(mocks.OpeningUseCaseMock)
@@ -186,7 +257,7 @@ func TestCreateOpeningHandler(t *testing.T) {
 		}
 
 		expectedErr := "param: remote (type: bool) is required"
-		mockUseCase.On("Create", openingReq).Return(internal_error.NewBadRequestError("param: remote (type: bool) is required")).Once()
+		mockUseCase.On("Create", openingReq).Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("param: remote (type: bool) is required")).Once()
 
 		reqJsonBody, _ := json.Marshal(openingReq)
 		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
@@ -216,7 +287,7 @@ func TestCreateOpeningHandler(t *testing.T) {
 		}
 
 		expectedErr := "salary must be at least 3k"
-		mockUseCase.On("Create", openingReq).Return(internal_error.NewBadRequestError("salary must be at least 3k")).Once()
+		mockUseCase.On("Create", openingReq).Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("salary must be at least 3k")).Once()
 
 		reqJsonBody, _ := json.Marshal(openingReq)
 		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(reqJsonBody))
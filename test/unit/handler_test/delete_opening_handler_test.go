@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
 	"github.com/valdir-alves3000/go-opportunities/internal/handler"
 	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
 	"github.com/valdir-alves3000/go-opportunities/test/mocks"
@@ -21,7 +22,7 @@ func TestDeleteOpeningHandler(t *testing.T) {
 		router.DELETE("/openings/:id", handler.Delete)
 
 		ID := 3000
-		mockUseCase.On("DeleteByID", uint(ID)).Return((*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("DeleteByID", uint(ID), (*schemas.Precondition)(nil)).Return((*internal_error.InternalError)(nil)).Once()
 
 		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/openings/%d", ID), nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -46,7 +47,7 @@ func TestDeleteOpeningHandler(t *testing.T) {
 		router.DELETE("/openings/:id", handler.Delete)
 
 		ID := 999
-		mockUseCase.On("DeleteByID", uint(ID)).Return(internal_error.NewNotFoundError("opening not found")).Once()
+		mockUseCase.On("DeleteByID", uint(ID), (*schemas.Precondition)(nil)).Return(internal_error.NewNotFoundError("opening not found")).Once()
 
 		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/openings/%d", ID), nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -97,7 +98,7 @@ func TestDeleteOpeningHandler(t *testing.T) {
 
 		ID := 999
 		errDB := internal_error.NewInternalServerError("error deleting opening")
-		mockUseCase.On("DeleteByID", uint(ID)).Return(errDB).Once()
+		mockUseCase.On("DeleteByID", uint(ID), (*schemas.Precondition)(nil)).Return(errDB).Once()
 
 		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/openings/%d", ID), nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -115,7 +116,7 @@ func TestDeleteOpeningHandler(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, "error deleting opening", response.Message)
 
-		mockUseCase.AssertCalled(t, "DeleteByID", uint(ID))
+		mockUseCase.AssertCalled(t, "DeleteByID", uint(ID), (*schemas.Precondition)(nil))
 		mockUseCase.AssertExpectations(t)
 	})
 }
@@ -0,0 +1,88 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestDigestHandler(t *testing.T) {
+	digestReq := schemas.CreateDigestSubscriptionRequest{Email: "ada@example.com", Cadence: schemas.DigestDaily}
+
+	t.Run("ShouldSubscribe", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.DigestUseCaseMock)
+		handler := handler.NewDigestHandler(mockUseCase)
+		router.POST("/digests", handler.Subscribe)
+
+		mockUseCase.On("Subscribe", digestReq).Return((*internal_error.InternalError)(nil)).Once()
+
+		reqJsonBody, _ := json.Marshal(digestReq)
+		req, _ := http.NewRequest("POST", "/digests", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), "digest subscription created successfully")
+	})
+
+	t.Run("ShouldReturnTheValidationError", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.DigestUseCaseMock)
+		handler := handler.NewDigestHandler(mockUseCase)
+		router.POST("/digests", handler.Subscribe)
+
+		invalid := schemas.CreateDigestSubscriptionRequest{Email: "ada@example.com", Cadence: "hourly"}
+		mockUseCase.On("Subscribe", invalid).Return(internal_error.NewBadRequestError("cadence must be one of: daily, weekly")).Once()
+
+		reqJsonBody, _ := json.Marshal(invalid)
+		req, _ := http.NewRequest("POST", "/digests", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "cadence must be one of: daily, weekly")
+	})
+
+	t.Run("ShouldConfirm", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.DigestUseCaseMock)
+		handler := handler.NewDigestHandler(mockUseCase)
+		router.GET("/digests/confirm", handler.Confirm)
+
+		mockUseCase.On("Confirm", "abc").Return(&schemas.DigestSubscription{Email: "ada@example.com"}, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/digests/confirm?token=abc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "confirm-digest successfully")
+	})
+
+	t.Run("ShouldUnsubscribe", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.DigestUseCaseMock)
+		handler := handler.NewDigestHandler(mockUseCase)
+		router.GET("/digests/unsubscribe", handler.Unsubscribe)
+
+		mockUseCase.On("Unsubscribe", "xyz").Return((*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/digests/unsubscribe?token=xyz", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "unsubscribe-digest successfully")
+	})
+}
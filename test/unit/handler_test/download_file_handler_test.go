@@ -0,0 +1,47 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
+)
+
+func TestDownloadFileHandler(t *testing.T) {
+	blobs, err := storage.NewLocalStorage(t.TempDir(), "", []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	blobs.Put("resumes/cv.pdf", strings.NewReader("%PDF-1.7"), 8, "application/pdf")
+
+	router := setupRouter()
+	router.GET("/files/*key", handler.NewFileHandler(blobs).Download)
+
+	t.Run("ShouldServeTheFileForAValidSignature", func(t *testing.T) {
+		signed, _ := blobs.SignedURL("resumes/cv.pdf", time.Minute)
+
+		req, _ := http.NewRequest("GET", signed, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "%PDF-1.7", w.Body.String())
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `filename="cv.pdf"`)
+	})
+
+	t.Run("ShouldRejectATamperedSignature", func(t *testing.T) {
+		signed, _ := blobs.SignedURL("resumes/cv.pdf", time.Minute)
+
+		req, _ := http.NewRequest("GET", signed+"0", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
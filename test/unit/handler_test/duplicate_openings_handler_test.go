@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestDuplicateOpeningsHandler(t *testing.T) {
+	openingReq := schemas.CreateOpeningRequest{
+		Role:     "Go Developer",
+		Company:  "Tech Corp",
+		Location: "Remote",
+		Link:     "http://example.com/go",
+		Remote:   new(bool),
+		Salary:   50000,
+	}
+
+	create := func(mockUseCase *mocks.OpeningUseCaseMock) *httptest.ResponseRecorder {
+		router := setupRouter()
+		router.POST("/openings", handler.NewOpeningHandler(mockUseCase).Create)
+
+		body, _ := json.Marshal(openingReq)
+		req, _ := http.NewRequest("POST", "/openings", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldAnswerConflictPointingToTheDuplicatedOpening", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Create", openingReq).
+			Return(&schemas.Opening{Model: gorm.Model{ID: 12}}, internal_error.NewConflictError("opening looks like a duplicate of opening 12")).Once()
+
+		w := create(mockUseCase)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.JSONEq(t, `{"message":"opening looks like a duplicate of opening 12","err":"conflict","errorCode":409,"duplicateOf":12}`, w.Body.String())
+	})
+
+	t.Run("ShouldReportTheFlagOfACreatedDuplicate", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		duplicateOf := uint(12)
+		mockUseCase.On("Create", openingReq).
+			Return(&schemas.Opening{Model: gorm.Model{ID: 13}, DuplicateOf: &duplicateOf}, (*internal_error.InternalError)(nil)).Once()
+
+		w := create(mockUseCase)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{"message":"opening Go Developer created successfully","duplicateOf":12}`, w.Body.String())
+	})
+
+	t.Run("ShouldListTheDuplicateClusters", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		router.GET("/admin/openings/duplicates", handler.NewOpeningHandler(mockUseCase).ListDuplicates)
+		mockUseCase.On("DuplicateClusters").Return([]schemas.DuplicateCluster{
+			{Openings: []schemas.Opening{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 4}}}},
+		}, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/admin/openings/duplicates", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var resp struct {
+			Message string                     `json:"message"`
+			Data    []schemas.DuplicateCluster `json:"data"`
+		}
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "list-duplicate-clusters successfully", resp.Message)
+		assert.Len(t, resp.Data, 1)
+		assert.Equal(t, uint(4), resp.Data[0].Openings[1].ID)
+	})
+
+	t.Run("ShouldReturnAnErrorWhenTheClustersCannotBeListed", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		router.GET("/admin/openings/duplicates", handler.NewOpeningHandler(mockUseCase).ListDuplicates)
+		mockUseCase.On("DuplicateClusters").
+			Return([]schemas.DuplicateCluster(nil), internal_error.NewInternalServerError("error finding openings")).Once()
+
+		req, _ := http.NewRequest("GET", "/admin/openings/duplicates", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
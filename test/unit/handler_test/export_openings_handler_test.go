@@ -0,0 +1,109 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestExportOpeningsHandler(t *testing.T) {
+	export := func(mockUseCase *mocks.OpeningUseCaseMock, query string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.GET("/openings/export", handler.Export)
+
+		req, _ := http.NewRequest("GET", "/openings/export"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	openings := [][]schemas.Opening{
+		{{Model: gorm.Model{ID: 1}, Role: "Go Developer", Salary: 9000}},
+		{{Model: gorm.Model{ID: 2}, Role: "SRE", Salary: 12000, Status: schemas.OpeningStatusClosed}},
+	}
+
+	t.Run("ShouldStreamTheSelectedColumnsAsCSV", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Export", schemas.OpeningFilter{Company: "Acme"}).
+			Return(openings, (*internal_error.InternalError)(nil)).Once()
+
+		w := export(mockUseCase, "?company=Acme&columns=id,role,%20salary,status")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Regexp(t, `^attachment; filename=openings-\d{4}-\d{2}-\d{2}\.csv$`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "id,role,salary,status\n1,Go Developer,9000,open\n2,SRE,12000,closed\n", w.Body.String())
+	})
+
+	t.Run("ShouldExportNDJSON", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Export", schemas.OpeningFilter{}).
+			Return(openings[:1], (*internal_error.InternalError)(nil)).Once()
+
+		w := export(mockUseCase, "?format=ndjson&columns=id,role")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+		assert.Equal(t, `{"id":1,"role":"Go Developer"}`+"\n", w.Body.String())
+	})
+
+	t.Run("ShouldWriteOnlyTheHeaderWhenNothingMatches", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Export", schemas.OpeningFilter{}).
+			Return([][]schemas.Opening(nil), (*internal_error.InternalError)(nil)).Once()
+
+		w := export(mockUseCase, "?columns=id")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "id\n", w.Body.String())
+	})
+
+	t.Run("ShouldReturnTheErrorWhenNothingWasWritten", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Export", schemas.OpeningFilter{}).
+			Return([][]schemas.Opening(nil), internal_error.NewInternalServerError("error finding openings")).Once()
+
+		w := export(mockUseCase, "")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), "error finding openings")
+	})
+
+	t.Run("ShouldRejectAnUnknownFormat", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+
+		w := export(mockUseCase, "?format=pdf")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "format must be one of: csv, ndjson, xlsx")
+		mockUseCase.AssertNotCalled(t, "Export", mock.Anything)
+	})
+
+	t.Run("ShouldRejectAnUnknownColumn", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+
+		w := export(mockUseCase, "?columns=role,owner")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `unknown column \"owner\"`)
+		mockUseCase.AssertNotCalled(t, "Export", mock.Anything)
+	})
+
+	t.Run("ShouldRejectAnInvalidFilter", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+
+		w := export(mockUseCase, "?remote=maybe")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid remote value")
+	})
+}
@@ -0,0 +1,117 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/graphql"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestGraphQLHandler(t *testing.T) {
+	setupGraphQL := func(t *testing.T, graphiQL bool) (*gin.Engine, *mocks.OpeningUseCaseMock) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		schema, err := graphql.NewSchema(mockUseCase)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		handler := handler.NewGraphQLHandler(schema, graphiQL, "/graphql")
+		router.POST("/graphql", handler.Query)
+		router.GET("/graphql", handler.Get)
+		return router, mockUseCase
+	}
+
+	opening := &schemas.Opening{Model: gorm.Model{ID: 3}, Role: "Go Developer", Company: "Acme"}
+
+	t.Run("ShouldRunAQuerySentWithPOST", func(t *testing.T) {
+		router, mockUseCase := setupGraphQL(t, false)
+		mockUseCase.On("GetByID", uint(3)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		body, _ := json.Marshal(handler.GraphQLRequest{
+			Query:     `query Show($id: ID!) { opening(id: $id) { role } }`,
+			Variables: map[string]interface{}{"id": "3"},
+		})
+		req, _ := http.NewRequest("POST", "/graphql", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":{"opening":{"role":"Go Developer"}}}`, w.Body.String())
+	})
+
+	t.Run("ShouldRequireAQuery", func(t *testing.T) {
+		router, _ := setupGraphQL(t, false)
+
+		req, _ := http.NewRequest("POST", "/graphql", bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "param: query (type: string) is required")
+	})
+
+	t.Run("ShouldRunAQuerySentWithGET", func(t *testing.T) {
+		router, mockUseCase := setupGraphQL(t, false)
+		mockUseCase.On("GetByID", uint(3)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		query := url.Values{"query": {`query ($id: ID!) { opening(id: $id) { company { name } } }`}, "variables": {`{"id":"3"}`}}
+		req, _ := http.NewRequest("GET", "/graphql?"+query.Encode(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data":{"opening":{"company":{"name":"Acme"}}}}`, w.Body.String())
+	})
+
+	t.Run("ShouldNotRunMutationsSentWithGET", func(t *testing.T) {
+		router, mockUseCase := setupGraphQL(t, false)
+
+		query := url.Values{"query": {`mutation { deleteOpening(id: "3") }`}}
+		req, _ := http.NewRequest("GET", "/graphql?"+query.Encode(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		mockUseCase.AssertNotCalled(t, "DeleteByID", uint(3), mock.Anything)
+	})
+
+	t.Run("ShouldServeGraphiQLToBrowsersWhenEnabled", func(t *testing.T) {
+		router, _ := setupGraphQL(t, true)
+
+		req, _ := http.NewRequest("GET", "/graphql", nil)
+		req.Header.Set("Accept", "text/html,application/xhtml+xml")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+		assert.Contains(t, w.Body.String(), `url: "/graphql"`)
+	})
+
+	t.Run("ShouldNotServeGraphiQLWhenDisabled", func(t *testing.T) {
+		router, _ := setupGraphQL(t, false)
+
+		req, _ := http.NewRequest("GET", "/graphql", nil)
+		req.Header.Set("Accept", "text/html")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.NotContains(t, w.Body.String(), "GraphiQL")
+	})
+}
@@ -0,0 +1,141 @@
+package handler_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	setup := func() (*gin.Engine, *mocks.IdempotencyUseCaseMock, *int) {
+		router := setupRouter()
+		useCase := new(mocks.IdempotencyUseCaseMock)
+		useCase.On("Hold", mock.Anything).Return(func() {}).Maybe()
+		calls := new(int)
+		router.Use(handler.Idempotency(useCase, 64))
+		router.POST("/things", func(c *gin.Context) {
+			*calls++
+			var body map[string]interface{}
+			c.ShouldBindJSON(&body)
+			c.Header("ETag", `"1"`)
+			c.Header("Location", "/things/1")
+			c.Header("X-Request-Id", "abc")
+			c.JSON(http.StatusCreated, gin.H{"echo": body["name"]})
+		})
+		router.GET("/things", func(c *gin.Context) {
+			*calls++
+			c.Status(http.StatusOK)
+		})
+		return router, useCase, calls
+	}
+
+	send := func(router *gin.Engine, method, key, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(method, "/things", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldStoreTheResponseOfTheFirstRequest", func(t *testing.T) {
+		router, useCase, calls := setup()
+		var fingerprint string
+		useCase.On("Begin", "key-1", mock.Anything).Run(func(args mock.Arguments) {
+			fingerprint = args.String(1)
+		}).Return((*schemas.IdempotencyRecord)(nil), (*internal_error.InternalError)(nil)).Once()
+		header := map[string]string{"Content-Type": "application/json; charset=utf-8", "ETag": `"1"`, "Location": "/things/1"}
+		useCase.On("Finish", "key-1", http.StatusCreated, header, []byte(`{"echo":"go"}`)).
+			Return((*internal_error.InternalError)(nil)).Once()
+
+		w := send(router, "POST", "key-1", `{"name":"go"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, `{"echo":"go"}`, w.Body.String())
+		assert.Equal(t, 1, *calls)
+		assert.Len(t, fingerprint, 64)
+		useCase.AssertExpectations(t)
+		useCase.AssertCalled(t, "Hold", "key-1")
+	})
+
+	t.Run("ShouldFingerprintTheMethodPathAndBody", func(t *testing.T) {
+		router, useCase, _ := setup()
+		var fingerprints []string
+		useCase.On("Begin", "key-1", mock.Anything).Run(func(args mock.Arguments) {
+			fingerprints = append(fingerprints, args.String(1))
+		}).Return((*schemas.IdempotencyRecord)(nil), (*internal_error.InternalError)(nil))
+		useCase.On("Finish", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return((*internal_error.InternalError)(nil))
+
+		send(router, "POST", "key-1", `{"name":"go"}`)
+		send(router, "POST", "key-1", `{"name":"go"}`)
+		send(router, "POST", "key-1", `{"name":"rust"}`)
+
+		assert.Equal(t, fingerprints[0], fingerprints[1])
+		assert.NotEqual(t, fingerprints[0], fingerprints[2])
+	})
+
+	t.Run("ShouldReplayAStoredResponse", func(t *testing.T) {
+		router, useCase, calls := setup()
+		useCase.On("Begin", "key-1", mock.Anything).Return(&schemas.IdempotencyRecord{
+			StatusCode: http.StatusCreated,
+			Header:     map[string]string{"Content-Type": "application/json; charset=utf-8", "ETag": `"1"`, "Location": "/things/1"},
+			Body:       []byte(`{"echo":"go"}`),
+		}, (*internal_error.InternalError)(nil)).Once()
+
+		w := send(router, "POST", "key-1", `{"name":"go"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, `{"echo":"go"}`, w.Body.String())
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, `"1"`, w.Header().Get("ETag"))
+		assert.Equal(t, "/things/1", w.Header().Get("Location"))
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, 0, *calls)
+		useCase.AssertNotCalled(t, "Finish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldRejectABodyOverTheLimit", func(t *testing.T) {
+		router, useCase, calls := setup()
+
+		w := send(router, "POST", "key-1", `{"name":"`+strings.Repeat("go", 64)+`"}`)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "request body too large")
+		assert.Equal(t, 0, *calls)
+		useCase.AssertNotCalled(t, "Begin", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldReturnTheUsecaseError", func(t *testing.T) {
+		router, useCase, calls := setup()
+		useCase.On("Begin", "key-1", mock.Anything).
+			Return((*schemas.IdempotencyRecord)(nil), internal_error.NewUnprocessableEntityError("Idempotency-Key was already used for a different request")).Once()
+
+		w := send(router, "POST", "key-1", `{"name":"rust"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "Idempotency-Key was already used for a different request")
+		assert.Equal(t, 0, *calls)
+	})
+
+	t.Run("ShouldIgnoreRequestsWithoutAKeyAndOtherMethods", func(t *testing.T) {
+		router, useCase, calls := setup()
+
+		send(router, "POST", "", `{"name":"go"}`)
+		send(router, "GET", "key-1", "")
+
+		assert.Equal(t, 2, *calls)
+		useCase.AssertNotCalled(t, "Begin", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,160 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestImportOpeningsHandler(t *testing.T) {
+	file := "role,company\nGo Developer,Acme\n"
+
+	sendImport := func(mockUseCase *mocks.ImportUseCaseMock, query, contentType, body string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewImportHandler(mockUseCase, 64, "/api/v1")
+		router.POST("/openings/import", handler.Import)
+
+		req, _ := http.NewRequest("POST", "/openings/import"+query, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldReturnTheReport", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+		report := &schemas.ImportReport{
+			Result: schemas.ImportValidated,
+			DryRun: true,
+			Total:  1,
+			Rows:   []schemas.ImportRowResult{{Line: 2}},
+		}
+		mockUseCase.On("Import", file, schemas.ImportOptions{
+			Format:  schemas.ImportFormatCSV,
+			Mapping: map[string]string{"role": "Job Title"},
+			DryRun:  true,
+		}).Return(report, (*schemas.ImportJobResponse)(nil), (*internal_error.InternalError)(nil)).Once()
+
+		query := "?dryRun=true&" + url.Values{"map[role]": {"Job Title"}}.Encode()
+		w := sendImport(mockUseCase, query, "text/csv; charset=utf-8", file)
+
+		var resp handler.ImportOpeningsResponse
+		json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "import-openings successfully", resp.Message)
+		assert.Equal(t, *report, resp.Data)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAcceptAQueuedJob", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+		job := &schemas.ImportJobResponse{ID: 3, Status: schemas.ImportJobPending, Rows: 1}
+		mockUseCase.On("Import", "{}\n", schemas.ImportOptions{Format: schemas.ImportFormatNDJSON, Mapping: map[string]string{}, Async: true}).
+			Return((*schemas.ImportReport)(nil), job, (*internal_error.InternalError)(nil)).Once()
+
+		w := sendImport(mockUseCase, "?async=1", "application/x-ndjson", "{}\n")
+
+		var resp handler.ImportJobResponse
+		json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Equal(t, "/api/v1/openings/import/jobs/3", w.Header().Get("Location"))
+		assert.Equal(t, uint(3), resp.Data.ID)
+		assert.Equal(t, schemas.ImportJobPending, resp.Data.Status)
+	})
+
+	t.Run("ShouldRejectAnUnsupportedContentType", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+
+		w := sendImport(mockUseCase, "", "application/json", "[]")
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+		assert.Contains(t, w.Body.String(), "Content-Type must be text/csv or application/x-ndjson")
+		mockUseCase.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldRejectAnInvalidDryRun", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+
+		w := sendImport(mockUseCase, "?dryRun=maybe", "text/csv", file)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid dryRun value")
+	})
+
+	t.Run("ShouldRejectAFileOverTheLimit", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+
+		w := sendImport(mockUseCase, "", "text/csv", strings.Repeat("x", 65))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		mockUseCase.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldReturnTheUsecaseError", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+		mockUseCase.On("Import", file, mock.Anything).
+			Return((*schemas.ImportReport)(nil), (*schemas.ImportJobResponse)(nil), internal_error.NewBadRequestError("the file has no openings")).Once()
+
+		w := sendImport(mockUseCase, "", "text/csv", file)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "the file has no openings")
+	})
+}
+
+func TestShowImportJobHandler(t *testing.T) {
+	showJob := func(mockUseCase *mocks.ImportUseCaseMock, id string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewImportHandler(mockUseCase, 64, "/api/v1")
+		router.GET("/openings/import/jobs/:id", handler.ShowJob)
+
+		req, _ := http.NewRequest("GET", "/openings/import/jobs/"+id, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldShowTheJob", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+		mockUseCase.On("GetJob", uint(3)).
+			Return(&schemas.ImportJobResponse{ID: 3, Status: schemas.ImportJobRunning}, (*internal_error.InternalError)(nil)).Once()
+
+		w := showJob(mockUseCase, "3")
+
+		var resp handler.ImportJobResponse
+		json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "show-import-job successfully", resp.Message)
+		assert.Equal(t, schemas.ImportJobRunning, resp.Data.Status)
+	})
+
+	t.Run("ShouldReturnNotFound", func(t *testing.T) {
+		mockUseCase := new(mocks.ImportUseCaseMock)
+		mockUseCase.On("GetJob", uint(4)).
+			Return((*schemas.ImportJobResponse)(nil), internal_error.NewNotFoundError("import job not found")).Once()
+
+		w := showJob(mockUseCase, "4")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("ShouldRejectAnInvalidID", func(t *testing.T) {
+		w := showJob(new(mocks.ImportUseCaseMock), "abc")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
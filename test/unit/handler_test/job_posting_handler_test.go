@@ -0,0 +1,117 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestJobPostingHandler(t *testing.T) {
+	opening := schemas.Opening{
+		Model:    gorm.Model{ID: 7, CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+		Role:     "Go Developer",
+		Company:  "Acme",
+		Location: "Lisbon",
+		Remote:   true,
+		Link:     "http://acme.example/jobs/7",
+		Salary:   9000,
+		Version:  3,
+	}
+
+	show := func(mockUseCase *mocks.OpeningUseCaseMock, opts []handler.OpeningHandlerOption, header http.Header) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewOpeningHandler(mockUseCase, opts...)
+		router.GET("/openings/:id", handler.ShowOpening)
+
+		req, _ := http.NewRequest("GET", "/openings/7", nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldAnswerJSONLDWhenAsked", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("GetByID", uint(7)).Return(&opening, (*internal_error.InternalError)(nil)).Once()
+
+		policy := schemas.JobPostingPolicy{Validity: time.Hour, Currency: "EUR", SalaryUnit: "YEAR"}
+		w := show(mockUseCase, []handler.OpeningHandlerOption{handler.WithJobPostingPolicy(policy)}, http.Header{"Accept": {"application/ld+json"}})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/ld+json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, `"3-ld"`, w.Header().Get("ETag"))
+		assert.Equal(t, "Accept", w.Header().Get("Vary"))
+
+		var posting schemas.JobPosting
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &posting))
+		assert.Equal(t, "JobPosting", posting.Type)
+		assert.Equal(t, "Go Developer", posting.Title)
+		assert.Equal(t, "TELECOMMUTE", posting.JobLocationType)
+		assert.Equal(t, "EUR", posting.BaseSalary.Currency)
+		assert.NoError(t, posting.Validate())
+	})
+
+	t.Run("ShouldKeepAnsweringJSONByDefault", func(t *testing.T) {
+		for _, accept := range []string{"", "*/*", "application/json", "text/html"} {
+			mockUseCase := new(mocks.OpeningUseCaseMock)
+			mockUseCase.On("GetByID", uint(7)).Return(&opening, (*internal_error.InternalError)(nil)).Once()
+
+			w := show(mockUseCase, nil, http.Header{"Accept": {accept}})
+
+			assert.Equal(t, http.StatusOK, w.Code, accept)
+			assert.Regexp(t, `^"3-[0-9a-f]+"$`, w.Header().Get("ETag"), accept)
+			assert.Contains(t, w.Body.String(), "show-opening successfully", accept)
+		}
+	})
+
+	t.Run("ShouldRevalidateTheJSONLDRepresentation", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("GetByID", uint(7)).Return(&opening, (*internal_error.InternalError)(nil)).Twice()
+
+		w := show(mockUseCase, nil, http.Header{"Accept": {"application/ld+json"}, "If-None-Match": {`"3-ld"`}})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+
+		w = show(mockUseCase, nil, http.Header{"Accept": {"application/ld+json"}, "If-None-Match": {`"3"`}})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("ShouldChangeTheJSONLDTagWhenTheLinkIsPaused", func(t *testing.T) {
+		paused := opening
+		paused.LinkPaused = true
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("GetByID", uint(7)).Return(&paused, (*internal_error.InternalError)(nil)).Once()
+
+		w := show(mockUseCase, nil, http.Header{"Accept": {"application/ld+json"}, "If-None-Match": {`"3-ld"`}})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"3-ld-paused"`, w.Header().Get("ETag"))
+
+		var posting schemas.JobPosting
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &posting))
+		assert.Equal(t, paused.UpdatedAt.UTC().Format(time.RFC3339), posting.ValidThrough)
+	})
+
+	t.Run("ShouldRefuseAnOpeningMissingRequiredProperties", func(t *testing.T) {
+		incomplete := opening
+		incomplete.Location = ""
+		incomplete.Remote = false
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("GetByID", uint(7)).Return(&incomplete, (*internal_error.InternalError)(nil)).Once()
+
+		w := show(mockUseCase, nil, http.Header{"Accept": {"application/ld+json"}})
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "job posting is missing jobLocation")
+	})
+}
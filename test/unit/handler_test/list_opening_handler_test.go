@@ -45,6 +45,46 @@ func TestListOpeningUsecase(t *testing.T) {
 		mockUseCase.AssertExpectations(t)
 	})
 
+	t.Run("ShouldPassTheQueryFiltersToTheUsecase", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.GET("/openings", handler.List)
+
+		remote := true
+		filter := schemas.OpeningFilter{Query: "go", Company: "Acme", Location: "Lisbon", Remote: &remote, MinSalary: 5000, Status: "open"}
+		mockUseCase.On("ListOpenings", filter, 1).Return([]schemas.Opening{}, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/openings?page=1&q=go&company=Acme&location=Lisbon&remote=true&minSalary=5000&status=open", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnErrorWhenAFilterIsInvalid", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.GET("/openings", handler.List)
+
+		for query, message := range map[string]string{
+			"remote=maybe":    "invalid remote value",
+			"minSalary=lots":  "invalid minSalary value",
+			"status=archived": "status must be one of: open, closed",
+		} {
+			req, _ := http.NewRequest("GET", "/openings?"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+			assert.Contains(t, w.Body.String(), message, query)
+		}
+
+		mockUseCase.AssertNotCalled(t, "ListOpenings", mock.Anything, mock.Anything)
+	})
+
 	t.Run("ShouldReturnErrorWhenNoOpeningsAreFound", func(t *testing.T) {
 		router := setupRouter()
 		mockUseCase := new(mocks.OpeningUseCaseMock)
@@ -52,7 +92,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		router.GET("/openings", handler.List)
 
 		mockErr := internal_error.NewNotFoundError("opening record not found")
-		mockUseCase.On("ListOpenings", 1).Return([]schemas.Opening{}, mockErr).Once()
+		mockUseCase.On("ListOpenings", schemas.OpeningFilter{}, 1).Return([]schemas.Opening{}, mockErr).Once()
 
 		req, _ := http.NewRequest("GET", "/openings?page=1", nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -71,7 +111,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		assert.Equal(t, "opening record not found", resp.Message)
 		assert.Equal(t, resp.ErrorCode, w.Code)
 
-		mockUseCase.AssertCalled(t, "ListOpenings", 1)
+		mockUseCase.AssertCalled(t, "ListOpenings", schemas.OpeningFilter{}, 1)
 		mockUseCase.AssertExpectations(t)
 	})
 
@@ -86,7 +126,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		handler := handler.NewOpeningHandler(mockUseCase)
 		router.GET("/openings", handler.List)
 
-		mockUseCase.On("ListOpenings", 0).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("ListOpenings", schemas.OpeningFilter{}, 0).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
 
 		req, _ := http.NewRequest("GET", "/openings?page=0", nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -116,7 +156,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		assert.Equal(t, resp.Data[9].Remote, mockListOpenings[9].Remote)
 		assert.Equal(t, resp.Data[9].Salary, mockListOpenings[9].Salary)
 
-		mockUseCase.AssertCalled(t, "ListOpenings", 0)
+		mockUseCase.AssertCalled(t, "ListOpenings", schemas.OpeningFilter{}, 0)
 		mockUseCase.AssertExpectations(t)
 	})
 
@@ -131,7 +171,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		handler := handler.NewOpeningHandler(mockUseCase)
 		router.GET("/openings", handler.List)
 
-		mockUseCase.On("ListOpenings", 1).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("ListOpenings", schemas.OpeningFilter{}, 1).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
 
 		req, _ := http.NewRequest("GET", "/openings?page=1", nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -161,7 +201,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		assert.Equal(t, resp.Data[9].Remote, mockOpenings[9].Remote)
 		assert.Equal(t, resp.Data[9].Salary, mockOpenings[9].Salary)
 
-		mockUseCase.AssertCalled(t, "ListOpenings", 1)
+		mockUseCase.AssertCalled(t, "ListOpenings", schemas.OpeningFilter{}, 1)
 		mockUseCase.AssertExpectations(t)
 	})
 
@@ -178,7 +218,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		handler := handler.NewOpeningHandler(mockUseCase)
 		router.GET("/openings", handler.List)
 
-		mockUseCase.On("ListOpenings", page).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("ListOpenings", schemas.OpeningFilter{}, page).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
 
 		req, _ := http.NewRequest("GET", fmt.Sprintf("/openings?page=%d", page), nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -208,7 +248,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		assert.Equal(t, resp.Data[9].Remote, mockListOpenings[29].Remote)
 		assert.Equal(t, resp.Data[9].Salary, mockListOpenings[29].Salary)
 
-		mockUseCase.AssertCalled(t, "ListOpenings", 3)
+		mockUseCase.AssertCalled(t, "ListOpenings", schemas.OpeningFilter{}, 3)
 		mockUseCase.AssertExpectations(t)
 	})
 
@@ -224,7 +264,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		handler := handler.NewOpeningHandler(mockUseCase)
 		router.GET("/openings", handler.List)
 
-		mockUseCase.On("ListOpenings", 4).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("ListOpenings", schemas.OpeningFilter{}, 4).Return(mockOpenings, (*internal_error.InternalError)(nil)).Once()
 
 		req, _ := http.NewRequest("GET", "/openings?page=4", nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -254,7 +294,7 @@ func TestListOpeningUsecase(t *testing.T) {
 		assert.Equal(t, resp.Data[9].Remote, mockListOpenings[49].Remote)
 		assert.Equal(t, resp.Data[9].Salary, mockListOpenings[49].Salary)
 
-		mockUseCase.AssertCalled(t, "ListOpenings", 4)
+		mockUseCase.AssertCalled(t, "ListOpenings", schemas.OpeningFilter{}, 4)
 		mockUseCase.AssertExpectations(t)
 	})
 }
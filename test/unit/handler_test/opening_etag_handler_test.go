@@ -0,0 +1,140 @@
+package handler_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestOpeningETagHandler(t *testing.T) {
+	setup := func(opts ...handler.OpeningHandlerOption) (*gin.Engine, *mocks.OpeningUseCaseMock) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		h := handler.NewOpeningHandler(mockUseCase, opts...)
+		router.GET("/openings/:id", h.ShowOpening)
+		router.PUT("/openings/:id", h.Update)
+		router.PATCH("/openings/:id", h.Patch)
+		router.DELETE("/openings/:id", h.Delete)
+		return router, mockUseCase
+	}
+
+	send := func(router *gin.Engine, method, contentType, body string, headers map[string]string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(method, "/openings/1", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	opening := &schemas.Opening{Model: gorm.Model{ID: 1}, Role: "Go Developer", Version: 4}
+
+	t.Run("ShouldStartTheETagWithTheVersion", func(t *testing.T) {
+		router, mockUseCase := setup()
+		mockUseCase.On("GetByID", uint(1)).Return(opening, (*internal_error.InternalError)(nil))
+
+		w := send(router, "GET", "application/json", "", map[string]string{"If-None-Match": `"3"`})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Regexp(t, `^"4-[0-9a-f]+"$`, w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), `"Version":4`)
+	})
+
+	t.Run("ShouldAnswerNotModifiedWhenIfNoneMatchListsTheETag", func(t *testing.T) {
+		router, mockUseCase := setup()
+		mockUseCase.On("GetByID", uint(1)).Return(opening, (*internal_error.InternalError)(nil))
+		etag := send(router, "GET", "application/json", "", nil).Header().Get("ETag")
+
+		for _, header := range []string{etag, `"2", W/` + etag, "*"} {
+			w := send(router, "GET", "application/json", "", map[string]string{"If-None-Match": header})
+
+			assert.Equal(t, http.StatusNotModified, w.Code, header)
+			assert.Equal(t, etag, w.Header().Get("ETag"), header)
+			assert.Empty(t, w.Body.String(), header)
+		}
+	})
+
+	t.Run("ShouldChangeTheETagWhenTheStageCountsChange", func(t *testing.T) {
+		router, mockUseCase := setup()
+		counted := *opening
+		counted.StageCounts = map[string]int64{schemas.StageApplied: 1}
+		mockUseCase.On("GetByID", uint(1)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("GetByID", uint(1)).Return(&counted, (*internal_error.InternalError)(nil)).Once()
+		etag := send(router, "GET", "application/json", "", nil).Header().Get("ETag")
+
+		w := send(router, "GET", "application/json", "", map[string]string{"If-None-Match": etag})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Regexp(t, `^"4-[0-9a-f]+"$`, w.Header().Get("ETag"))
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("ShouldPassIfMatchToTheUsecase", func(t *testing.T) {
+		router, mockUseCase := setup()
+		precondition := &schemas.Precondition{Versions: []uint{2, 7}}
+		mockUseCase.On("Update", uint(1), precondition).Return((*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("DeleteByID", uint(1), precondition).Return((*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("Patch", uint(1), schemas.MergePatchMediaType, []byte(`{}`), precondition).Return(opening, (*internal_error.InternalError)(nil)).Once()
+		headers := map[string]string{"If-Match": `"2-9a3f", W/"5", "7"`}
+
+		assert.Equal(t, http.StatusOK, send(router, "PUT", "application/json", `{"role":"Go"}`, headers).Code)
+		assert.Equal(t, http.StatusOK, send(router, "DELETE", "application/json", "", headers).Code)
+
+		w := send(router, "PATCH", schemas.MergePatchMediaType, `{}`, headers)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Regexp(t, `^"4-[0-9a-f]+"$`, w.Header().Get("ETag"))
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldTreatAWildcardIfMatchAsUnconditional", func(t *testing.T) {
+		router, mockUseCase := setup(handler.WithIfMatchRequired(true))
+		mockUseCase.On("DeleteByID", uint(1), (*schemas.Precondition)(nil)).Return((*internal_error.InternalError)(nil)).Once()
+
+		w := send(router, "DELETE", "application/json", "", map[string]string{"If-Match": "*"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRequireIfMatchWhenConfigured", func(t *testing.T) {
+		router, mockUseCase := setup(handler.WithIfMatchRequired(true))
+
+		for _, w := range []*httptest.ResponseRecorder{
+			send(router, "PUT", "application/json", `{"role":"Go"}`, nil),
+			send(router, "PATCH", schemas.MergePatchMediaType, `{}`, nil),
+			send(router, "DELETE", "application/json", "", nil),
+		} {
+			assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+			assert.Contains(t, w.Body.String(), "If-Match header is required")
+		}
+		mockUseCase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockUseCase.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockUseCase.AssertNotCalled(t, "DeleteByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldMapVersionErrors", func(t *testing.T) {
+		router, mockUseCase := setup()
+		mockUseCase.On("Update", uint(1), mock.Anything).
+			Return(internal_error.NewPreconditionFailedError("opening version does not match")).Once()
+		mockUseCase.On("DeleteByID", uint(1), mock.Anything).
+			Return(internal_error.NewConflictError("opening was changed by another request, reload it and try again")).Once()
+
+		w := send(router, "PUT", "application/json", `{"role":"Go"}`, map[string]string{"If-Match": `"1"`})
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+
+		w = send(router, "DELETE", "application/json", "", nil)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
@@ -0,0 +1,118 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestOpeningFeedHandler(t *testing.T) {
+	updated := time.Date(2026, 10, 2, 10, 30, 0, 0, time.UTC)
+	openings := []schemas.Opening{
+		{Model: gorm.Model{ID: 2, CreatedAt: updated, UpdatedAt: updated}, Role: "SRE", Company: "Acme", Version: 1},
+		{Model: gorm.Model{ID: 1, CreatedAt: updated.Add(-time.Hour), UpdatedAt: updated.Add(-time.Hour)}, Role: "Go Developer", Company: "Acme", Version: 3},
+	}
+
+	getFeed := func(mockUseCase *mocks.OpeningUseCaseMock, path string, header http.Header) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewFeedHandler(mockUseCase, "http://example.com/api/v1/")
+		router.GET("/feeds/openings.rss", handler.RSS)
+		router.GET("/feeds/openings.atom", handler.Atom)
+		router.GET("/feeds/openings.json", handler.JSON)
+
+		req, _ := http.NewRequest("GET", path, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldServeEachFormatWithCachingHeaders", func(t *testing.T) {
+		for path, contentType := range map[string]string{
+			"/feeds/openings.rss":  "application/rss+xml; charset=utf-8",
+			"/feeds/openings.atom": "application/atom+xml; charset=utf-8",
+			"/feeds/openings.json": "application/feed+json; charset=utf-8",
+		} {
+			mockUseCase := new(mocks.OpeningUseCaseMock)
+			mockUseCase.On("Latest", schemas.OpeningFilter{Company: "Acme"}, 50).
+				Return(openings, (*internal_error.InternalError)(nil)).Once()
+
+			w := getFeed(mockUseCase, path+"?company=Acme", nil)
+
+			assert.Equal(t, http.StatusOK, w.Code, path)
+			assert.Equal(t, contentType, w.Header().Get("Content-Type"), path)
+			assert.NotEmpty(t, w.Header().Get("ETag"), path)
+			assert.Equal(t, "Fri, 02 Oct 2026 10:30:00 GMT", w.Header().Get("Last-Modified"), path)
+			assert.Contains(t, w.Body.String(), "http://example.com/api/v1/openings/2", path)
+			assert.Contains(t, w.Body.String(), "http://example.com/feeds/openings.", path)
+		}
+	})
+
+	t.Run("ShouldAnswerNotModifiedToAMatchingETag", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Latest", schemas.OpeningFilter{}, 50).
+			Return(openings, (*internal_error.InternalError)(nil)).Twice()
+
+		etag := getFeed(mockUseCase, "/feeds/openings.rss", nil).Header().Get("ETag")
+		w := getFeed(mockUseCase, "/feeds/openings.rss", http.Header{"If-None-Match": {etag}})
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("ShouldChangeTheETagWhenAnOpeningLeavesTheFeed", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Latest", schemas.OpeningFilter{}, 50).
+			Return(openings, (*internal_error.InternalError)(nil)).Once()
+		mockUseCase.On("Latest", schemas.OpeningFilter{}, 50).
+			Return(openings[:1], (*internal_error.InternalError)(nil)).Once()
+
+		etag := getFeed(mockUseCase, "/feeds/openings.atom", nil).Header().Get("ETag")
+		w := getFeed(mockUseCase, "/feeds/openings.atom", http.Header{"If-None-Match": {etag}})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("ShouldHonorIfModifiedSince", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Latest", schemas.OpeningFilter{}, 50).
+			Return(openings, (*internal_error.InternalError)(nil)).Twice()
+
+		w := getFeed(mockUseCase, "/feeds/openings.json", http.Header{"If-Modified-Since": {"Fri, 02 Oct 2026 10:30:00 GMT"}})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+
+		w = getFeed(mockUseCase, "/feeds/openings.json", http.Header{"If-Modified-Since": {"Fri, 02 Oct 2026 10:29:59 GMT"}})
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("ShouldRejectAnInvalidFilter", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+
+		w := getFeed(mockUseCase, "/feeds/openings.rss?minSalary=lots", nil)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockUseCase.AssertNotCalled(t, "Latest", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldReturnTheUsecaseError", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Latest", schemas.OpeningFilter{}, 50).
+			Return([]schemas.Opening(nil), internal_error.NewInternalServerError("error finding openings")).Once()
+
+		w := getFeed(mockUseCase, "/feeds/openings.rss", nil)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
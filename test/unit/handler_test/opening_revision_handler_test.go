@@ -0,0 +1,120 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestShowOpeningRevisionHandler(t *testing.T) {
+	t.Run("ShouldSuccessfullyGetARevision", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.GET("/openings/:id/revisions/:rev", handler.ShowRevision)
+
+		revision := schemas.OpeningRevisionResponse{
+			OpeningID: 1,
+			Revision:  2,
+			Opening:   schemas.Opening{Role: "Go Developer", Salary: 50000},
+		}
+		mockUseCase.On("GetRevision", uint(1), uint(2)).Return(&revision, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/openings/1/revisions/2", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var resp struct {
+			Message string                          `json:"message"`
+			Data    schemas.OpeningRevisionResponse `json:"data"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, err)
+		assert.Equal(t, "show-opening-revision successfully", resp.Message)
+		assert.Equal(t, revision.Opening.Role, resp.Data.Opening.Role)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnBadRequestWhenRevisionIsInvalid", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.GET("/openings/:id/revisions/:rev", handler.ShowRevision)
+
+		req, _ := http.NewRequest("GET", "/openings/1/revisions/0", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid revision")
+		mockUseCase.AssertNotCalled(t, "GetRevision", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldReturnNotFoundWhenRevisionDoesNotExist", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.GET("/openings/:id/revisions/:rev", handler.ShowRevision)
+
+		mockUseCase.On("GetRevision", uint(1), uint(5)).Return((*schemas.OpeningRevisionResponse)(nil), internal_error.NewNotFoundError("revision not found")).Once()
+
+		req, _ := http.NewRequest("GET", "/openings/1/revisions/5", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "revision not found")
+	})
+}
+
+func TestRestoreOpeningRevisionHandler(t *testing.T) {
+	t.Run("ShouldRestoreTheRevision", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.POST("/openings/:id/revisions/:rev/restore", handler.RestoreRevision)
+
+		mockUseCase.On("RestoreRevision", uint(3), uint(1)).Return((*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("POST", "/openings/3/revisions/1/restore", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var resp struct {
+			Message string `json:"message"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("opening with id: %d restored to revision %d successfully", 3, 1), resp.Message)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFoundWhenOpeningDoesNotExist", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.POST("/openings/:id/revisions/:rev/restore", handler.RestoreRevision)
+
+		mockUseCase.On("RestoreRevision", uint(3), uint(1)).Return(internal_error.NewNotFoundError("opening not found")).Once()
+
+		req, _ := http.NewRequest("POST", "/openings/3/revisions/1/restore", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "opening not found")
+	})
+}
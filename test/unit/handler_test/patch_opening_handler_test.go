@@ -0,0 +1,85 @@
+package handler_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestPatchOpeningHandler(t *testing.T) {
+	sendPatch := func(mockUseCase *mocks.OpeningUseCaseMock, path, contentType, body string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewOpeningHandler(mockUseCase)
+		router.PATCH("/openings/:id", handler.Patch)
+
+		req, _ := http.NewRequest("PATCH", path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldPassTheMergePatchToTheUsecase", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		patch := `{"link":null}`
+		opening := &schemas.Opening{Model: gorm.Model{ID: 1}, Role: "Go Developer"}
+		mockUseCase.On("Patch", uint(1), schemas.MergePatchMediaType, []byte(patch), (*schemas.Precondition)(nil)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		w := sendPatch(mockUseCase, "/openings/1", "application/merge-patch+json; charset=utf-8", patch)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "opening with id: 1 updated successfully")
+		assert.Contains(t, w.Body.String(), `"Role":"Go Developer"`)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldPassTheJSONPatchToTheUsecase", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		patch := `[{"op":"replace","path":"/salary","value":5000}]`
+		mockUseCase.On("Patch", uint(2), schemas.JSONPatchMediaType, []byte(patch), (*schemas.Precondition)(nil)).Return(&schemas.Opening{}, (*internal_error.InternalError)(nil)).Once()
+
+		w := sendPatch(mockUseCase, "/openings/2", schemas.JSONPatchMediaType, patch)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectOtherContentTypes", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+
+		w := sendPatch(mockUseCase, "/openings/1", "application/json", `{"role":"Go"}`)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+		assert.Equal(t, "application/merge-patch+json, application/json-patch+json", w.Header().Get("Accept-Patch"))
+		mockUseCase.AssertNotCalled(t, "Patch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheIDIsInvalid", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+
+		w := sendPatch(mockUseCase, "/openings/abc", schemas.MergePatchMediaType, `{}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid ID")
+	})
+
+	t.Run("ShouldReturnTheUsecaseError", func(t *testing.T) {
+		mockUseCase := new(mocks.OpeningUseCaseMock)
+		mockUseCase.On("Patch", uint(1), schemas.MergePatchMediaType, []byte(`{"role":null}`), (*schemas.Precondition)(nil)).
+			Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("param: role (type: string) is required")).Once()
+
+		w := sendPatch(mockUseCase, "/openings/1", schemas.MergePatchMediaType, `{"role":null}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "param: role (type: string) is required")
+	})
+}
@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestPipelineHandler(t *testing.T) {
+	pipelineReq := schemas.DefinePipelineRequest{Stages: []string{"applied", "interview", "hired"}}
+
+	t.Run("ShouldShowTheOpeningPipeline", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.PipelineUseCaseMock)
+		handler := handler.NewPipelineHandler(mockUseCase)
+		router.GET("/openings/:id/pipeline", handler.ShowOpeningPipeline)
+
+		pipeline := &schemas.PipelineResponse{OpeningID: 3, Source: schemas.PipelineSourceDefault, Stages: schemas.DefaultPipelineStages}
+		mockUseCase.On("GetForOpening", uint(3)).Return(pipeline, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/openings/3/pipeline", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "show-pipeline successfully")
+		assert.Contains(t, w.Body.String(), `"source":"default"`)
+	})
+
+	t.Run("ShouldDefineTheOpeningPipeline", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.PipelineUseCaseMock)
+		handler := handler.NewPipelineHandler(mockUseCase)
+		router.PUT("/openings/:id/pipeline", handler.DefineOpeningPipeline)
+
+		mockUseCase.On("DefineForOpening", uint(3), pipelineReq).Return((*internal_error.InternalError)(nil)).Once()
+
+		reqJsonBody, _ := json.Marshal(pipelineReq)
+		req, _ := http.NewRequest("PUT", "/openings/3/pipeline", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "pipeline of opening with id: 3 defined successfully")
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnTheValidationErrorOfTheCompanyPipeline", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.PipelineUseCaseMock)
+		handler := handler.NewPipelineHandler(mockUseCase)
+		router.PUT("/companies/:company/pipeline", handler.DefineCompanyPipeline)
+
+		mockUseCase.On("DefineForCompany", "acme", pipelineReq).Return(internal_error.NewBadRequestError("duplicated stage: applied")).Once()
+
+		reqJsonBody, _ := json.Marshal(pipelineReq)
+		req, _ := http.NewRequest("PUT", "/companies/acme/pipeline", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "duplicated stage: applied")
+	})
+
+	t.Run("ShouldReturnBadRequestWhenIDIsInvalid", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.PipelineUseCaseMock)
+		handler := handler.NewPipelineHandler(mockUseCase)
+		router.PUT("/openings/:id/pipeline", handler.DefineOpeningPipeline)
+
+		req, _ := http.NewRequest("PUT", "/openings/invalid/pipeline", bytes.NewBuffer([]byte("{}")))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid ID")
+		mockUseCase.AssertNotCalled(t, "DefineForOpening", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTransitionApplicationHandler(t *testing.T) {
+	transitionReq := schemas.TransitionApplicationRequest{Stage: "offer", Note: "great interview"}
+
+	t.Run("ShouldMoveTheApplication", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.POST("/applications/:id/transitions", handler.Transition)
+
+		mockUseCase.On("Transition", uint(5), transitionReq).Return((*internal_error.InternalError)(nil)).Once()
+
+		reqJsonBody, _ := json.Marshal(transitionReq)
+		req, _ := http.NewRequest("POST", "/applications/5/transitions", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "application with id: 5 moved to offer successfully")
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheApplicationCannotBeMoved", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.POST("/applications/:id/transitions", handler.Transition)
+
+		mockUseCase.On("Transition", uint(5), transitionReq).Return(internal_error.NewBadRequestError("application in stage hired cannot be moved")).Once()
+
+		reqJsonBody, _ := json.Marshal(transitionReq)
+		req, _ := http.NewRequest("POST", "/applications/5/transitions", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "application in stage hired cannot be moved")
+	})
+
+	t.Run("ShouldReturnTheHistory", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.ApplicationUseCaseMock)
+		handler := handler.NewApplicationHandler(mockUseCase)
+		router.GET("/applications/:id/history", handler.History)
+
+		history := []schemas.ApplicationStageChange{{ApplicationID: 5, ToStage: schemas.StageApplied}}
+		mockUseCase.On("History", uint(5)).Return(history, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/applications/5/history", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "application-history successfully")
+	})
+}
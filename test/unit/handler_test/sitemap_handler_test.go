@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/sitemap_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestSitemapHandler(t *testing.T) {
+	file := &sitemap_usecase.File{
+		Body:         []byte("<urlset></urlset>"),
+		ETag:         `"abc"`,
+		LastModified: time.Date(2026, 10, 2, 10, 30, 0, 0, time.UTC),
+	}
+
+	get := func(mockUseCase *mocks.SitemapUseCaseMock, path string, header http.Header) *httptest.ResponseRecorder {
+		router := setupRouter()
+		handler := handler.NewSitemapHandler(mockUseCase)
+		router.GET("/sitemap.xml", handler.Index)
+		router.GET("/sitemaps/:file", handler.Page)
+		router.GET("/robots.txt", handler.Robots)
+
+		req, _ := http.NewRequest("GET", path, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ShouldServeTheIndex", func(t *testing.T) {
+		mockUseCase := new(mocks.SitemapUseCaseMock)
+		mockUseCase.On("Index").Return(file, (*internal_error.InternalError)(nil)).Once()
+
+		w := get(mockUseCase, "/sitemap.xml", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, `"abc"`, w.Header().Get("ETag"))
+		assert.Equal(t, "Fri, 02 Oct 2026 10:30:00 GMT", w.Header().Get("Last-Modified"))
+		assert.Equal(t, "<urlset></urlset>", w.Body.String())
+	})
+
+	t.Run("ShouldServeAPageByNumber", func(t *testing.T) {
+		mockUseCase := new(mocks.SitemapUseCaseMock)
+		mockUseCase.On("Page", 3).Return(file, (*internal_error.InternalError)(nil)).Once()
+
+		w := get(mockUseCase, "/sitemaps/openings-3.xml", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldAnswerNotModified", func(t *testing.T) {
+		mockUseCase := new(mocks.SitemapUseCaseMock)
+		mockUseCase.On("Index").Return(file, (*internal_error.InternalError)(nil)).Twice()
+
+		w := get(mockUseCase, "/sitemap.xml", http.Header{"If-None-Match": {`"abc"`}})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+
+		w = get(mockUseCase, "/sitemap.xml", http.Header{"If-Modified-Since": {"Fri, 02 Oct 2026 10:30:00 GMT"}})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("ShouldReturnNotFoundForOtherFiles", func(t *testing.T) {
+		for _, path := range []string{"/sitemaps/jobs-1.xml", "/sitemaps/openings-x.xml", "/sitemaps/openings-1.txt"} {
+			mockUseCase := new(mocks.SitemapUseCaseMock)
+
+			w := get(mockUseCase, path, nil)
+
+			assert.Equal(t, http.StatusNotFound, w.Code, path)
+			mockUseCase.AssertNotCalled(t, "Page", mock.Anything)
+		}
+	})
+
+	t.Run("ShouldReturnTheUsecaseError", func(t *testing.T) {
+		mockUseCase := new(mocks.SitemapUseCaseMock)
+		mockUseCase.On("Page", 9).Return((*sitemap_usecase.File)(nil), internal_error.NewNotFoundError("sitemap not found")).Once()
+
+		w := get(mockUseCase, "/sitemaps/openings-9.xml", nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "sitemap not found")
+	})
+
+	t.Run("ShouldServeRobots", func(t *testing.T) {
+		mockUseCase := new(mocks.SitemapUseCaseMock)
+		mockUseCase.On("Robots").Return([]byte("User-agent: *\n")).Once()
+
+		w := get(mockUseCase, "/robots.txt", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "User-agent: *\n", w.Body.String())
+	})
+}
@@ -0,0 +1,126 @@
+package handler_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/events"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+)
+
+func TestStreamOpeningsHandler(t *testing.T) {
+	openingEvent := func(id, company string) schemas.OpeningEvent {
+		return schemas.OpeningEvent{
+			ID:      id,
+			Type:    schemas.OpeningCreatedEvent,
+			Opening: schemas.Opening{Role: "Go Developer", Company: company, Location: "Remote"},
+		}
+	}
+
+	// startServer is closed after the streams opened in the test, which
+	// would otherwise keep it waiting.
+	startServer := func(t *testing.T, broker *events.Broker, heartbeat time.Duration) *httptest.Server {
+		router := setupRouter()
+		router.GET("/openings/stream", handler.NewOpeningStreamHandler(broker, heartbeat).Stream)
+		server := httptest.NewServer(router)
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	// open connects to the stream and returns a reader of its lines.
+	open := func(t *testing.T, url, lastEventID string) (*http.Response, *bufio.Scanner) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		t.Cleanup(cancel)
+
+		req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp, bufio.NewScanner(resp.Body)
+	}
+
+	// readUntil returns the lines read up to and including the first one
+	// starting with prefix.
+	readUntil := func(scanner *bufio.Scanner, prefix string) []string {
+		var lines []string
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+			if strings.HasPrefix(scanner.Text(), prefix) {
+				break
+			}
+		}
+		return lines
+	}
+
+	t.Run("ShouldReturnAnErrorIfTheFilterIsInvalid", func(t *testing.T) {
+		server := startServer(t, events.NewBroker(10), time.Minute)
+
+		resp, err := http.Get(server.URL + "/openings/stream?remote=maybe")
+
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("ShouldStreamTheEventsMatchingTheFilter", func(t *testing.T) {
+		broker := events.NewBroker(10)
+		server := startServer(t, broker, time.Minute)
+
+		resp, scanner := open(t, server.URL+"/openings/stream?company=acme", "")
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+		readUntil(scanner, ": connected")
+
+		broker.Publish(openingEvent("evt-1", "Other Corp"))
+		broker.Publish(openingEvent("evt-2", "Acme"))
+
+		lines := readUntil(scanner, "data:")
+		assert.Contains(t, lines, "id: evt-2")
+		assert.Contains(t, lines, "event: opening.created")
+		assert.NotContains(t, lines, "id: evt-1")
+		assert.Contains(t, lines[len(lines)-1], `"Company":"Acme"`)
+	})
+
+	t.Run("ShouldResumeAfterTheLastEventID", func(t *testing.T) {
+		broker := events.NewBroker(10)
+		broker.Publish(openingEvent("evt-1", "Acme"))
+		broker.Publish(openingEvent("evt-2", "Acme"))
+		server := startServer(t, broker, time.Minute)
+
+		_, scanner := open(t, server.URL+"/openings/stream", "evt-1")
+		lines := readUntil(scanner, "data:")
+
+		assert.Contains(t, lines, "id: evt-2")
+		assert.NotContains(t, lines, "id: evt-1")
+	})
+
+	t.Run("ShouldSendAResetWhenTheLastEventIDIsUnknown", func(t *testing.T) {
+		server := startServer(t, events.NewBroker(10), time.Minute)
+
+		_, scanner := open(t, server.URL+"/openings/stream", "evt-unknown")
+		lines := readUntil(scanner, "event:")
+
+		assert.Equal(t, "event: reset", lines[len(lines)-1])
+	})
+
+	t.Run("ShouldSendHeartbeats", func(t *testing.T) {
+		server := startServer(t, events.NewBroker(10), 10*time.Millisecond)
+
+		_, scanner := open(t, server.URL+"/openings/stream", "")
+		lines := readUntil(scanner, ": heartbeat")
+
+		assert.Equal(t, ": heartbeat", lines[len(lines)-1])
+	})
+}
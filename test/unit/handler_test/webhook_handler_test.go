@@ -0,0 +1,126 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/handler"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	webhookReq := schemas.CreateWebhookRequest{URL: "https://hooks.example.com", Secret: "s3cret", Events: []string{schemas.OpeningCreatedEvent}}
+
+	t.Run("ShouldCreateAWebhookWithoutExposingTheSecret", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.WebhookUseCaseMock)
+		handler := handler.NewWebhookHandler(mockUseCase)
+		router.POST("/webhooks", handler.Create)
+
+		webhook := &schemas.Webhook{Model: gorm.Model{ID: 1}, URL: webhookReq.URL, Secret: webhookReq.Secret, Events: schemas.OpeningCreatedEvent, Active: true}
+		mockUseCase.On("Create", webhookReq).Return(webhook, (*internal_error.InternalError)(nil)).Once()
+
+		reqJsonBody, _ := json.Marshal(webhookReq)
+		req, _ := http.NewRequest("POST", "/webhooks", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), "webhook created successfully")
+		assert.Contains(t, w.Body.String(), webhookReq.URL)
+		assert.NotContains(t, w.Body.String(), "s3cret")
+	})
+
+	t.Run("ShouldReturnTheValidationError", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.WebhookUseCaseMock)
+		handler := handler.NewWebhookHandler(mockUseCase)
+		router.POST("/webhooks", handler.Create)
+
+		invalid := schemas.CreateWebhookRequest{URL: "https://hooks.example.com"}
+		mockUseCase.On("Create", invalid).Return((*schemas.Webhook)(nil), internal_error.NewBadRequestError("param: secret (type: string) is required")).Once()
+
+		reqJsonBody, _ := json.Marshal(invalid)
+		req, _ := http.NewRequest("POST", "/webhooks", bytes.NewBuffer(reqJsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "param: secret (type: string) is required")
+	})
+
+	t.Run("ShouldDeleteAWebhook", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.WebhookUseCaseMock)
+		handler := handler.NewWebhookHandler(mockUseCase)
+		router.DELETE("/webhooks/:id", handler.Delete)
+
+		mockUseCase.On("Delete", uint(1)).Return((*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("DELETE", "/webhooks/1", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "webhook with id: 1 deleted successfully")
+	})
+
+	t.Run("ShouldListDeliveries", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.WebhookUseCaseMock)
+		handler := handler.NewWebhookHandler(mockUseCase)
+		router.GET("/webhooks/:id/deliveries", handler.Deliveries)
+
+		deliveries := []schemas.WebhookDelivery{{Model: gorm.Model{ID: 5}, WebhookID: 1, Status: schemas.WebhookDeliveryFailed}}
+		mockUseCase.On("Deliveries", uint(1)).Return(deliveries, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("GET", "/webhooks/1/deliveries", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "list-webhook-deliveries successfully")
+		assert.Contains(t, w.Body.String(), `"Status":"failed"`)
+	})
+
+	t.Run("ShouldRedeliver", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.WebhookUseCaseMock)
+		handler := handler.NewWebhookHandler(mockUseCase)
+		router.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", handler.Redeliver)
+
+		delivery := &schemas.WebhookDelivery{Model: gorm.Model{ID: 5}, WebhookID: 1, Status: schemas.WebhookDeliveryPending}
+		mockUseCase.On("Redeliver", uint(1), uint(5)).Return(delivery, (*internal_error.InternalError)(nil)).Once()
+
+		req, _ := http.NewRequest("POST", "/webhooks/1/deliveries/5/redeliver", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "redeliver-webhook successfully")
+	})
+
+	t.Run("ShouldRejectAnInvalidDeliveryID", func(t *testing.T) {
+		router := setupRouter()
+		mockUseCase := new(mocks.WebhookUseCaseMock)
+		handler := handler.NewWebhookHandler(mockUseCase)
+		router.POST("/webhooks/:id/deliveries/:deliveryId/redeliver", handler.Redeliver)
+
+		req, _ := http.NewRequest("POST", "/webhooks/1/deliveries/abc/redeliver", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid delivery ID")
+		mockUseCase.AssertNotCalled(t, "Redeliver")
+	})
+}
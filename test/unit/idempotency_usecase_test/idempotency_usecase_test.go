@@ -0,0 +1,190 @@
+package idempotency_usecase_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/idempotency_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestIdempotencyUsecaseBegin(t *testing.T) {
+	reservation := func(key, fingerprint string) interface{} {
+		return mock.MatchedBy(func(r *schemas.IdempotencyRecord) bool {
+			ttl := time.Until(r.ExpiresAt)
+			return r.Key == key && r.Fingerprint == fingerprint && ttl > 59*time.Minute && ttl <= time.Hour &&
+				time.Since(r.RenewedAt) < time.Minute
+		})
+	}
+	stored := func(fingerprint string, statusCode int) *schemas.IdempotencyRecord {
+		return &schemas.IdempotencyRecord{
+			ID:          7,
+			CreatedAt:   time.Now().Add(-5 * time.Minute),
+			RenewedAt:   time.Now().Add(-5 * time.Minute),
+			Key:         "key-1",
+			Fingerprint: fingerprint,
+			StatusCode:  statusCode,
+			Header:      map[string]string{"Content-Type": "application/json"},
+			Body:        []byte(`{"message":"created"}`),
+			ExpiresAt:   time.Now().Add(55 * time.Minute),
+		}
+	}
+
+	t.Run("ShouldLetTheFirstRequestRun", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("Reserve", reservation("key-1", "abc")).Return(true, nil).Once()
+
+		record, err := uc.Begin("key-1", "abc")
+
+		assert.Nil(t, err)
+		assert.Nil(t, record)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReplayTheResponseToTheSameRequest", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("Reserve", mock.Anything).Return(false, nil).Once()
+		repo.On("FindByKey", "key-1").Return(stored("abc", 201), nil).Once()
+
+		record, err := uc.Begin("key-1", "abc")
+
+		assert.Nil(t, err)
+		assert.Equal(t, 201, record.StatusCode)
+		assert.Equal(t, `{"message":"created"}`, string(record.Body))
+	})
+
+	t.Run("ShouldRejectTheKeyForADifferentRequest", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("Reserve", mock.Anything).Return(false, nil).Once()
+		repo.On("FindByKey", "key-1").Return(stored("abc", 201), nil).Once()
+
+		record, err := uc.Begin("key-1", "xyz")
+
+		assert.Nil(t, record)
+		assert.Equal(t, internal_error.NewUnprocessableEntityError("Idempotency-Key was already used for a different request"), err)
+	})
+
+	t.Run("ShouldReportARequestThatIsStillRunning", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("Reserve", mock.Anything).Return(false, nil).Once()
+		pending := stored("abc", 0)
+		pending.RenewedAt = time.Now().Add(-time.Second)
+		repo.On("FindByKey", "key-1").Return(pending, nil).Once()
+
+		record, err := uc.Begin("key-1", "abc")
+
+		assert.Nil(t, record)
+		assert.Equal(t, "conflict", err.Err)
+	})
+
+	t.Run("ShouldNotTakeALongRequestThatKeepsRenewingItsKeyAsAbandoned", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("Reserve", mock.Anything).Return(false, nil).Once()
+		pending := stored("abc", 0)
+		pending.CreatedAt = time.Now().Add(-time.Hour)
+		pending.RenewedAt = time.Now().Add(-10 * time.Second)
+		repo.On("FindByKey", "key-1").Return(pending, nil).Once()
+
+		record, err := uc.Begin("key-1", "abc")
+
+		assert.Nil(t, record)
+		assert.Equal(t, "conflict", err.Err)
+		repo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+
+	t.Run("ShouldReuseExpiredAndAbandonedKeys", func(t *testing.T) {
+		expired := stored("xyz", 201)
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+		abandoned := stored("abc", 0)
+
+		for name, record := range map[string]*schemas.IdempotencyRecord{"expired": expired, "abandoned": abandoned} {
+			uc, repo := setupUsecaseTest()
+			repo.On("Reserve", reservation("key-1", "abc")).Return(false, nil).Once()
+			repo.On("FindByKey", "key-1").Return(record, nil).Once()
+			repo.On("Delete", uint(7)).Return(nil).Once()
+			repo.On("Reserve", reservation("key-1", "abc")).Return(true, nil).Once()
+
+			replay, err := uc.Begin("key-1", "abc")
+
+			assert.Nil(t, err, name)
+			assert.Nil(t, replay, name)
+			repo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("ShouldRejectLongKeys", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		_, err := uc.Begin(strings.Repeat("k", 256), "abc")
+
+		assert.Equal(t, internal_error.NewBadRequestError("Idempotency-Key must be at most 255 characters"), err)
+		repo.AssertNotCalled(t, "Reserve", mock.Anything)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheDBFails", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("Reserve", mock.Anything).Return(false, errors.New("disk full")).Once()
+
+		_, err := uc.Begin("key-1", "abc")
+
+		assert.Equal(t, internal_error.NewInternalServerError("error storing idempotency key"), err)
+	})
+}
+
+func TestIdempotencyUsecaseFinish(t *testing.T) {
+	t.Run("ShouldStoreTheResponse", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("FindByKey", "key-1").Return(&schemas.IdempotencyRecord{ID: 7, Key: "key-1", Fingerprint: "abc"}, nil).Once()
+		repo.On("Update", schemas.IdempotencyRecord{
+			ID:          7,
+			Key:         "key-1",
+			Fingerprint: "abc",
+			StatusCode:  201,
+			Header:      map[string]string{"Content-Type": "application/json", "Location": "/jobs/1"},
+			Body:        []byte(`{}`),
+		}).Return(nil).Once()
+
+		err := uc.Finish("key-1", 201, map[string]string{"Content-Type": "application/json", "Location": "/jobs/1"}, []byte(`{}`))
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReleaseTheKeyAfterAServerError", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("FindByKey", "key-1").Return(&schemas.IdempotencyRecord{ID: 7, Key: "key-1"}, nil).Once()
+		repo.On("Delete", uint(7)).Return(nil).Once()
+
+		err := uc.Finish("key-1", 500, map[string]string{"Content-Type": "application/json"}, []byte(`{}`))
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+}
+
+func TestIdempotencyUsecaseHold(t *testing.T) {
+	t.Run("ShouldRenewTheKeyUntilReleased", func(t *testing.T) {
+		repo := new(mocks.IdempotencyRepositoryMock)
+		uc := idempotency_usecase.NewIdempotencyUseCase(repo, schemas.IdempotencyPolicy{TTL: time.Hour, PendingTimeout: 30 * time.Millisecond})
+		renewed := make(chan struct{}, 10)
+		repo.On("Renew", "key-1", mock.Anything).Run(func(mock.Arguments) {
+			renewed <- struct{}{}
+		}).Return(nil)
+
+		release := uc.Hold("key-1")
+		<-renewed
+		<-renewed
+		release()
+		calls := len(repo.Calls)
+		time.Sleep(50 * time.Millisecond)
+
+		assert.Len(t, repo.Calls, calls)
+	})
+}
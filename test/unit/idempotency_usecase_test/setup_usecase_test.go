@@ -0,0 +1,18 @@
+package idempotency_usecase_test
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/idempotency_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+var policy = schemas.IdempotencyPolicy{TTL: time.Hour}
+
+func setupUsecaseTest() (*idempotency_usecase.IdempotencyUseCase, *mocks.IdempotencyRepositoryMock) {
+	repo := new(mocks.IdempotencyRepositoryMock)
+	uc := idempotency_usecase.NewIdempotencyUseCase(repo, policy)
+
+	return uc, repo
+}
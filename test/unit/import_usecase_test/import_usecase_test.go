@@ -0,0 +1,239 @@
+package import_usecase_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"gorm.io/gorm"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestImportUsecase(t *testing.T) {
+	goDeveloper := schemas.CreateOpeningRequest{
+		Role:     "Go Developer",
+		Company:  "Acme",
+		Location: "Lisbon",
+		Remote:   boolPtr(true),
+		Link:     "http://example.com/go",
+		Salary:   9000,
+	}
+	csvOptions := schemas.ImportOptions{Format: schemas.ImportFormatCSV}
+
+	t.Run("ShouldImportACSVFile", func(t *testing.T) {
+		uc, openings, _ := setupUsecaseTest()
+		openings.On("Import", []schemas.CreateOpeningRequest{goDeveloper}, false).
+			Return([]opening_usecase.BulkResult{{ID: 5}}, (*internal_error.InternalError)(nil)).Once()
+
+		file := "Role,Company,Location,Remote,Link,Salary\nGo Developer,Acme,Lisbon,yes,http://example.com/go,9000\n"
+		report, job, err := uc.Import(strings.NewReader(file), csvOptions)
+
+		assert.Nil(t, err)
+		assert.Nil(t, job)
+		assert.Equal(t, &schemas.ImportReport{
+			Result: schemas.ImportImported,
+			Total:  1,
+			Rows:   []schemas.ImportRowResult{{Line: 2, ID: 5}},
+		}, report)
+	})
+
+	t.Run("ShouldReadFieldsFromMappedColumns", func(t *testing.T) {
+		uc, openings, _ := setupUsecaseTest()
+		openings.On("Import", []schemas.CreateOpeningRequest{goDeveloper}, true).
+			Return([]opening_usecase.BulkResult{{RolledBack: true}}, (*internal_error.InternalError)(nil)).Once()
+
+		file := "Job Title;Employer\n"
+		_, _, err := uc.Import(strings.NewReader(file), schemas.ImportOptions{
+			Format:  schemas.ImportFormatCSV,
+			Mapping: map[string]string{"role": "Job Title"},
+		})
+		assert.Equal(t, internal_error.NewBadRequestError(`column "Job Title" for field role not found in the header`), err)
+
+		file = "Job Title,company,location,remote,link,salary\nGo Developer,Acme,Lisbon,true,http://example.com/go,9000\n"
+		report, _, err := uc.Import(strings.NewReader(file), schemas.ImportOptions{
+			Format:  schemas.ImportFormatCSV,
+			Mapping: map[string]string{"role": "Job Title"},
+			DryRun:  true,
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &schemas.ImportReport{
+			Result: schemas.ImportValidated,
+			DryRun: true,
+			Total:  1,
+			Rows:   []schemas.ImportRowResult{{Line: 2}},
+		}, report)
+	})
+
+	t.Run("ShouldRejectAnUnknownMappedField", func(t *testing.T) {
+		uc, openings, _ := setupUsecaseTest()
+
+		_, _, err := uc.Import(strings.NewReader("role\n"), schemas.ImportOptions{
+			Format:  schemas.ImportFormatCSV,
+			Mapping: map[string]string{"title": "Role"},
+		})
+
+		assert.Equal(t, "bad_request", err.Err)
+		assert.Contains(t, err.Message, `unknown field "title" in map`)
+		openings.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldValidateTheReadableRowsWhenOthersCannotBeRead", func(t *testing.T) {
+		uc, openings, _ := setupUsecaseTest()
+		invalid := goDeveloper
+		invalid.Salary = 100
+		openings.On("Import", []schemas.CreateOpeningRequest{goDeveloper, invalid}, true).
+			Return([]opening_usecase.BulkResult{
+				{RolledBack: true},
+				{Err: internal_error.NewBadRequestError("salary must be at least 3k", internal_error.Cause{Field: "salary", Message: "salary must be at least 3k"})},
+			}, (*internal_error.InternalError)(nil)).Once()
+
+		file := `{"role":"Go Developer","company":"Acme","location":"Lisbon","remote":true,"link":"http://example.com/go","salary":9000}
+
+{"role":
+{"role":"Go Developer","company":"Acme","location":"Lisbon","remote":true,"link":"http://example.com/go","salary":100}
+`
+		report, _, err := uc.Import(strings.NewReader(file), schemas.ImportOptions{Format: schemas.ImportFormatNDJSON})
+
+		assert.Nil(t, err)
+		assert.Equal(t, schemas.ImportRejected, report.Result)
+		assert.Equal(t, 3, report.Total)
+		assert.Equal(t, 2, report.Invalid)
+		assert.Equal(t, schemas.ImportRowResult{Line: 1}, report.Rows[0])
+		assert.Equal(t, 3, report.Rows[1].Line)
+		assert.Contains(t, report.Rows[1].Error, "invalid JSON")
+		assert.Equal(t, schemas.ImportRowResult{
+			Line:   4,
+			Error:  "salary must be at least 3k",
+			Causes: []schemas.ImportRowCause{{Field: "salary", Message: "salary must be at least 3k"}},
+		}, report.Rows[2])
+		openings.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReportCellsThatAreNotValues", func(t *testing.T) {
+		uc, openings, _ := setupUsecaseTest()
+
+		file := "role,company,location,remote,link,salary\nGo Developer,Acme,Lisbon,maybe,http://example.com/go,9000\nGo Developer,Acme,Lisbon,no,http://example.com/go,9k\n"
+		report, _, err := uc.Import(strings.NewReader(file), csvOptions)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &schemas.ImportReport{
+			Result:  schemas.ImportRejected,
+			Total:   2,
+			Invalid: 2,
+			Rows: []schemas.ImportRowResult{
+				{Line: 2, Error: `remote must be true or false, got "maybe"`},
+				{Line: 3, Error: `salary must be a whole number, got "9k"`},
+			},
+		}, report)
+		openings.AssertNotCalled(t, "Import", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldRejectAFileWithoutOpenings", func(t *testing.T) {
+		uc, _, _ := setupUsecaseTest()
+
+		_, _, err := uc.Import(strings.NewReader("role,company,location,remote,link,salary\n"), csvOptions)
+
+		assert.Equal(t, internal_error.NewBadRequestError("the file has no openings"), err)
+	})
+
+	t.Run("ShouldRunLargeFilesAsAJob", func(t *testing.T) {
+		uc, openings, jobs := setupUsecaseTest()
+		rows := strings.Repeat("Go Developer,Acme,Lisbon,true,http://example.com/go,9000\n", asyncRows+1)
+		all := make([]schemas.CreateOpeningRequest, asyncRows+1)
+		results := make([]opening_usecase.BulkResult, asyncRows+1)
+		for i := range all {
+			all[i] = goDeveloper
+			results[i] = opening_usecase.BulkResult{ID: uint(i + 1)}
+		}
+
+		jobs.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(0).(*schemas.ImportJob).ID = 3
+		}).Return(nil).Once()
+		jobs.On("Update", mock.MatchedBy(func(job schemas.ImportJob) bool {
+			return job.ID == 3 && job.Status == schemas.ImportJobRunning
+		})).Return(nil).Once()
+		jobs.On("Update", mock.MatchedBy(func(job schemas.ImportJob) bool {
+			return job.ID == 3 && job.Status == schemas.ImportJobCompleted && job.CompletedAt != nil &&
+				strings.Contains(job.Report, `"result":"imported"`)
+		})).Return(nil).Once()
+		openings.On("Import", all, false).Return(results, (*internal_error.InternalError)(nil)).Once()
+
+		report, job, err := uc.Import(strings.NewReader("role,company,location,remote,link,salary\n"+rows), csvOptions)
+		uc.Wait()
+
+		assert.Nil(t, err)
+		assert.Nil(t, report)
+		assert.Equal(t, uint(3), job.ID)
+		assert.Equal(t, schemas.ImportJobPending, job.Status)
+		assert.Equal(t, asyncRows+1, job.Rows)
+		jobs.AssertExpectations(t)
+		openings.AssertExpectations(t)
+	})
+
+	t.Run("ShouldMarkTheJobFailedWhenTheImportErrors", func(t *testing.T) {
+		uc, openings, jobs := setupUsecaseTest()
+		jobs.On("Create", mock.Anything).Return(nil).Once()
+		jobs.On("Update", mock.MatchedBy(func(job schemas.ImportJob) bool {
+			return job.Status == schemas.ImportJobRunning
+		})).Return(nil).Once()
+		jobs.On("Update", mock.MatchedBy(func(job schemas.ImportJob) bool {
+			return job.Status == schemas.ImportJobFailed && job.Error == "error running bulk operations"
+		})).Return(nil).Once()
+		openings.On("Import", mock.Anything, false).
+			Return([]opening_usecase.BulkResult(nil), internal_error.NewInternalServerError("error running bulk operations")).Once()
+
+		file := "role,company,location,remote,link,salary\nGo Developer,Acme,Lisbon,true,http://example.com/go,9000\n"
+		_, job, err := uc.Import(strings.NewReader(file), schemas.ImportOptions{Format: schemas.ImportFormatCSV, Async: true})
+		uc.Wait()
+
+		assert.Nil(t, err)
+		assert.NotNil(t, job)
+		jobs.AssertExpectations(t)
+	})
+
+	t.Run("ShouldShowAJobWithItsReport", func(t *testing.T) {
+		uc, _, jobs := setupUsecaseTest()
+		jobs.On("FindByID", uint(3)).Return(&schemas.ImportJob{
+			Model:  gorm.Model{ID: 3},
+			Status: schemas.ImportJobCompleted,
+			Rows:   1,
+			Report: `{"result":"imported","dryRun":false,"total":1,"invalid":0,"rows":[{"line":2,"id":5}]}`,
+		}, nil).Once()
+		jobs.On("FindByID", uint(4)).Return((*schemas.ImportJob)(nil), gorm.ErrRecordNotFound).Once()
+		jobs.On("FindByID", uint(5)).Return((*schemas.ImportJob)(nil), errors.New("db down")).Once()
+
+		job, err := uc.GetJob(3)
+		assert.Nil(t, err)
+		assert.Equal(t, &schemas.ImportReport{
+			Result: schemas.ImportImported,
+			Total:  1,
+			Rows:   []schemas.ImportRowResult{{Line: 2, ID: 5}},
+		}, job.Report)
+
+		_, err = uc.GetJob(4)
+		assert.Equal(t, internal_error.NewNotFoundError("import job not found"), err)
+
+		_, err = uc.GetJob(5)
+		assert.Equal(t, "internal_server_error", err.Err)
+	})
+
+	t.Run("ShouldFailTheJobsInterruptedByARestart", func(t *testing.T) {
+		uc, _, jobs := setupUsecaseTest()
+		jobs.On("FailUnfinished", schemas.ImportJobInterrupted, mock.AnythingOfType("time.Time")).Return(int64(2), nil).Once()
+
+		assert.NoError(t, uc.FailInterrupted())
+		jobs.AssertExpectations(t)
+
+		jobs.On("FailUnfinished", schemas.ImportJobInterrupted, mock.AnythingOfType("time.Time")).Return(int64(0), errors.New("db down")).Once()
+		assert.EqualError(t, uc.FailInterrupted(), "db down")
+	})
+}
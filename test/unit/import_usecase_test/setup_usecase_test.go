@@ -0,0 +1,16 @@
+package import_usecase_test
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/import_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+const asyncRows = 3
+
+func setupUsecaseTest() (*import_usecase.ImportUseCase, *mocks.OpeningUseCaseMock, *mocks.ImportJobRepositoryMock) {
+	openings := new(mocks.OpeningUseCaseMock)
+	jobs := new(mocks.ImportJobRepositoryMock)
+	uc := import_usecase.NewImportUseCase(openings, jobs, asyncRows)
+
+	return uc, openings, jobs
+}
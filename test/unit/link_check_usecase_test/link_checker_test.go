@@ -0,0 +1,176 @@
+package link_check_usecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/link_check_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+var policy = schemas.LinkCheckPolicy{
+	Enabled:      true,
+	Interval:     24 * time.Hour,
+	PollInterval: time.Minute,
+	HostDelay:    50 * time.Millisecond,
+	Timeout:      5 * time.Second,
+	MaxFailures:  3,
+	// The tests check links on the loopback interface.
+	AllowPrivateHosts: true,
+}
+
+func setupCheckerTest(t *testing.T) (*link_check_usecase.Checker, *mocks.OpeningRepositoryMock, *mocks.OpeningUseCaseMock, *mocks.LinkServerMock) {
+	server := mocks.NewLinkServerMock()
+	t.Cleanup(server.Close)
+
+	repo := new(mocks.OpeningRepositoryMock)
+	openings := new(mocks.OpeningUseCaseMock)
+	return link_check_usecase.NewChecker(repo, openings, policy), repo, openings, server
+}
+
+func TestLinkChecker(t *testing.T) {
+	t.Run("ShouldRecordAHealthyLink", func(t *testing.T) {
+		checker, _, _, server := setupCheckerTest(t)
+
+		check := checker.Check(context.Background(), server.URL+"/jobs/1")
+
+		assert.Equal(t, 200, check.Status)
+		assert.Empty(t, check.Redirect)
+		assert.Empty(t, check.Error)
+		assert.True(t, check.Healthy())
+		requests := server.Received()
+		assert.Len(t, requests, 1)
+		assert.Equal(t, http.MethodHead, requests[0].Method)
+		assert.Equal(t, "go-opportunities-link-checker", requests[0].UserAgent)
+	})
+
+	t.Run("ShouldFallBackToGETWhenHEADIsRejected", func(t *testing.T) {
+		checker, _, _, server := setupCheckerTest(t)
+		server.RejectHead("/jobs/1")
+
+		check := checker.Check(context.Background(), server.URL+"/jobs/1")
+
+		assert.Equal(t, 200, check.Status)
+		requests := server.Received()
+		assert.Len(t, requests, 2)
+		assert.Equal(t, http.MethodGet, requests[1].Method)
+	})
+
+	t.Run("ShouldRecordWhereTheLinkRedirects", func(t *testing.T) {
+		checker, _, _, server := setupCheckerTest(t)
+		server.Redirect("/jobs/old", "/jobs/2")
+
+		check := checker.Check(context.Background(), server.URL+"/jobs/old")
+
+		assert.Equal(t, 200, check.Status)
+		assert.Equal(t, server.URL+"/jobs/2", check.Redirect)
+	})
+
+	t.Run("ShouldRecordABrokenLink", func(t *testing.T) {
+		checker, _, _, server := setupCheckerTest(t)
+		server.Respond("/jobs/1", http.StatusNotFound)
+
+		check := checker.Check(context.Background(), server.URL+"/jobs/1")
+
+		assert.Equal(t, 404, check.Status)
+		assert.Equal(t, "404 Not Found", check.Error)
+		assert.False(t, check.Healthy())
+	})
+
+	t.Run("ShouldRecordAnUnreachableLink", func(t *testing.T) {
+		checker, _, _, server := setupCheckerTest(t)
+		link := server.URL + "/jobs/1"
+		server.Close()
+
+		check := checker.Check(context.Background(), link)
+
+		assert.Equal(t, 0, check.Status)
+		assert.NotEmpty(t, check.Error)
+		assert.False(t, check.Healthy())
+	})
+
+	t.Run("ShouldNotRequestAnInternalAddress", func(t *testing.T) {
+		server := mocks.NewLinkServerMock()
+		defer server.Close()
+		strict := policy
+		strict.AllowPrivateHosts = false
+		checker := link_check_usecase.NewChecker(new(mocks.OpeningRepositoryMock), new(mocks.OpeningUseCaseMock), strict)
+
+		check := checker.Check(context.Background(), server.URL+"/jobs/1")
+
+		assert.Equal(t, 0, check.Status)
+		assert.Empty(t, check.Redirect)
+		assert.Equal(t, "destination address is not allowed", check.Error)
+		assert.Empty(t, server.Received())
+	})
+
+	t.Run("ShouldCheckTheDueLinksSpacingRequestsToTheSameHost", func(t *testing.T) {
+		checker, repo, openings, server := setupCheckerTest(t)
+		other := mocks.NewLinkServerMock()
+		defer other.Close()
+		server.Respond("/jobs/2", http.StatusGone)
+		now := time.Now()
+		due := []schemas.Opening{
+			{Model: gorm.Model{ID: 1}, Link: server.URL + "/jobs/1"},
+			{Model: gorm.Model{ID: 2}, Link: server.URL + "/jobs/2"},
+			{Model: gorm.Model{ID: 3}, Link: other.URL + "/jobs/3"},
+			{Model: gorm.Model{ID: 4}, Link: server.URL + "/jobs/4"},
+		}
+		repo.On("FindLinksDue", now.Add(-24*time.Hour), 100).Return(due, nil).Once()
+		openings.On("RecordLinkCheck", mock.Anything, mock.Anything, 3).Return(&schemas.Opening{}, (*internal_error.InternalError)(nil))
+
+		err := checker.CheckDue(context.Background(), now)
+
+		assert.NoError(t, err)
+		openings.AssertNumberOfCalls(t, "RecordLinkCheck", 4)
+		openings.AssertCalled(t, "RecordLinkCheck", uint(2), mock.MatchedBy(func(c schemas.LinkCheck) bool {
+			return c.URL == server.URL+"/jobs/2" && c.Status == http.StatusGone
+		}), 3)
+
+		// The broken link is requested twice, with HEAD and then GET. Server
+		// timestamps lag a little behind the checker, hence the margin.
+		requests := server.Received()
+		assert.Len(t, requests, 4)
+		for i := 1; i < len(requests); i++ {
+			if requests[i].Path != requests[i-1].Path {
+				assert.GreaterOrEqual(t, requests[i].At.Sub(requests[i-1].At), policy.HostDelay*4/5)
+			}
+		}
+		assert.Len(t, other.Received(), 1)
+	})
+
+	t.Run("ShouldCarryOnWhenACheckCannotBeRecorded", func(t *testing.T) {
+		checker, repo, openings, server := setupCheckerTest(t)
+		due := []schemas.Opening{
+			{Model: gorm.Model{ID: 1}, Link: server.URL + "/jobs/1"},
+			{Model: gorm.Model{ID: 2}, Link: server.URL + "/jobs/2"},
+		}
+		repo.On("FindLinksDue", mock.Anything, 100).Return(due, nil).Once()
+		openings.On("RecordLinkCheck", uint(1), mock.Anything, 3).
+			Return((*schemas.Opening)(nil), internal_error.NewInternalServerError("error recording link check")).Once()
+		openings.On("RecordLinkCheck", uint(2), mock.Anything, 3).
+			Return(&schemas.Opening{}, (*internal_error.InternalError)(nil)).Once()
+
+		err := checker.CheckDue(context.Background(), time.Now())
+
+		assert.EqualError(t, err, "opening 1: error recording link check")
+		openings.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotRunWhenDisabled", func(t *testing.T) {
+		repo := new(mocks.OpeningRepositoryMock)
+		disabled := policy
+		disabled.Enabled = false
+
+		link_check_usecase.NewChecker(repo, new(mocks.OpeningUseCaseMock), disabled).Run(context.Background())
+
+		repo.AssertNotCalled(t, "FindLinksDue", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,92 @@
+package mailer_test
+
+import (
+	"mime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/mailer"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestSMTPMailer(t *testing.T) {
+	server := mocks.NewSMTPServerMock()
+	defer server.Close()
+
+	smtpMailer, err := mailer.NewSMTPMailer(mailer.SMTPConfig{
+		Host: server.Host(),
+		Port: server.Port(),
+		From: "Go Opportunities <no-reply@example.com>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("ShouldSendAPlainTextMessage", func(t *testing.T) {
+		server.Reset()
+		longLine := strings.Repeat("opening ", 20)
+
+		err := smtpMailer.Send(mailer.Message{
+			To:      []string{"ada@example.com"},
+			Subject: "Vagas para você",
+			Text:    longLine + "\nhttps://example.com/?token=abc",
+			Headers: map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe>"},
+		})
+
+		assert.NoError(t, err)
+		messages := server.WaitForMessages(1, time.Second)
+		if !assert.Len(t, messages, 1) {
+			return
+		}
+		assert.Equal(t, "no-reply@example.com", messages[0].From)
+		assert.Equal(t, []string{"ada@example.com"}, messages[0].To)
+
+		parsed, err := messages[0].Parse()
+		assert.NoError(t, err)
+		subject, _ := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		assert.Equal(t, "Vagas para você", subject)
+		assert.Equal(t, "<https://example.com/unsubscribe>", parsed.Header.Get("List-Unsubscribe"))
+		assert.NotEmpty(t, parsed.Header.Get("Message-Id"))
+
+		text, err := messages[0].Part("text/plain")
+		assert.NoError(t, err)
+		assert.Equal(t, longLine+"\nhttps://example.com/?token=abc\n", text)
+	})
+
+	t.Run("ShouldSendHTMLAsAnAlternativePart", func(t *testing.T) {
+		server.Reset()
+
+		err := smtpMailer.Send(mailer.Message{
+			To:      []string{"ada@example.com", "grace@example.com"},
+			Subject: "Digest",
+			Text:    "plain version",
+			HTML:    "<p>html version</p>",
+		})
+
+		assert.NoError(t, err)
+		messages := server.WaitForMessages(1, time.Second)
+		if !assert.Len(t, messages, 1) {
+			return
+		}
+		assert.Equal(t, []string{"ada@example.com", "grace@example.com"}, messages[0].To)
+
+		text, _ := messages[0].Part("text/plain")
+		html, _ := messages[0].Part("text/html")
+		assert.Equal(t, "plain version", text)
+		assert.Equal(t, "<p>html version</p>", html)
+	})
+
+	t.Run("ShouldRequireARecipient", func(t *testing.T) {
+		err := smtpMailer.Send(mailer.Message{Subject: "Nobody"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("ShouldRejectAnInvalidFromAddress", func(t *testing.T) {
+		_, err := mailer.NewSMTPMailer(mailer.SMTPConfig{Host: "localhost", From: "not an address"})
+
+		assert.Error(t, err)
+	})
+}
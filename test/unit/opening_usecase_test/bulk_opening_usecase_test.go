@@ -0,0 +1,145 @@
+package opening_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestBulkOpeningUsecase(t *testing.T) {
+	remote := true
+	valid := schemas.UpdateOpeningRequest{
+		Role:     "Go Developer",
+		Company:  "Acme",
+		Location: "Lisbon",
+		Remote:   &remote,
+		Link:     "http://example.com/go",
+		Salary:   9000,
+	}
+	invalid := valid
+	invalid.Salary = 100
+
+	operations := []schemas.BulkOpeningOperation{
+		{Op: schemas.BulkOpCreate, Opening: valid},
+		{Op: schemas.BulkOpCreate, Opening: invalid},
+		{Op: schemas.BulkOpDelete, ID: 4},
+	}
+	stored := &schemas.Opening{Model: gorm.Model{ID: 4}, Version: 2}
+
+	setup := func() (*opening_usecase.OpeningUseCase, *mocks.OpeningRepositoryMock, *mocks.NotifierMock) {
+		repo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		return opening_usecase.NewOpeningUseCase(repo, opening_usecase.WithNotifier(notifier)), repo, notifier
+	}
+
+	t.Run("ShouldRollBackTheBatchWhenAnOperationFails", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(0).(*schemas.Opening).ID = 9
+		}).Return(nil).Once()
+		repo.On("FindByID", uint(4)).Return(stored, nil).Once()
+		repo.On("Delete", uint(4), uint(2)).Return(nil).Once()
+
+		results, err := uc.Bulk(schemas.BulkOpeningRequest{Operations: operations})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []opening_usecase.BulkResult{
+			{ID: 9, RolledBack: true},
+			{Err: internal_error.NewBadRequestError("salary must be at least 3k", salaryCause)},
+			{ID: 4, RolledBack: true},
+		}, results)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldApplyTheValidOperationsInBestEffortMode", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(0).(*schemas.Opening).ID = 9
+		}).Return(nil).Once()
+		repo.On("FindByID", uint(4)).Return(stored, nil).Once()
+		repo.On("Delete", uint(4), uint(2)).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		results, err := uc.Bulk(schemas.BulkOpeningRequest{Mode: schemas.BulkModeBestEffort, Operations: operations})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []opening_usecase.BulkResult{
+			{ID: 9},
+			{Err: internal_error.NewBadRequestError("salary must be at least 3k", salaryCause)},
+			{ID: 4},
+		}, results)
+		repo.AssertNotCalled(t, "Transaction")
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("ShouldCheckTheVersionOfEachOperation", func(t *testing.T) {
+		uc, repo, _ := setup()
+		repo.On("FindByID", uint(4)).Return(stored, nil).Twice()
+
+		results, err := uc.Bulk(schemas.BulkOpeningRequest{
+			Mode: schemas.BulkModeBestEffort,
+			Operations: []schemas.BulkOpeningOperation{
+				{Op: schemas.BulkOpUpdate, ID: 4, Version: 1, Opening: valid},
+				{Op: schemas.BulkOpDelete, ID: 4, Version: 1},
+			},
+		})
+
+		assert.Nil(t, err)
+		for _, result := range results {
+			assert.Equal(t, internal_error.NewPreconditionFailedError("opening version does not match"), result.Err)
+		}
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("ShouldRejectInvalidOperations", func(t *testing.T) {
+		uc, _, _ := setup()
+
+		results, err := uc.Bulk(schemas.BulkOpeningRequest{
+			Mode: schemas.BulkModeBestEffort,
+			Operations: []schemas.BulkOpeningOperation{
+				{Op: schemas.BulkOpUpdate, Opening: valid},
+				{Op: "upsert", ID: 4},
+			},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, internal_error.NewBadRequestError("param: id (type: uint) is required"), results[0].Err)
+		assert.Equal(t, internal_error.NewBadRequestError("op must be one of: create, update, delete"), results[1].Err)
+	})
+
+	t.Run("ShouldRejectInvalidBatches", func(t *testing.T) {
+		uc, _, _ := setup()
+
+		for message, req := range map[string]schemas.BulkOpeningRequest{
+			"mode must be one of: transactional, best_effort": {Mode: "atomic", Operations: operations},
+			"param: operations (type: array) is required":     {},
+			"at most 500 operations are allowed per request":  {Operations: make([]schemas.BulkOpeningOperation, 501)},
+		} {
+			results, err := uc.Bulk(req)
+
+			assert.Nil(t, results, message)
+			assert.Equal(t, internal_error.NewBadRequestError(message), err, message)
+		}
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheCommitFails", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(errors.New("database is locked")).Once()
+		repo.On("FindByID", uint(4)).Return(stored, nil).Once()
+		repo.On("Delete", uint(4), uint(2)).Return(nil).Once()
+
+		results, err := uc.Bulk(schemas.BulkOpeningRequest{Operations: operations[2:]})
+
+		assert.Nil(t, results)
+		assert.Equal(t, internal_error.NewInternalServerError("error running bulk operations"), err)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+}
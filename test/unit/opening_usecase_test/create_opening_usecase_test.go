@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
 	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
 	"gorm.io/gorm"
 )
 
@@ -31,12 +33,13 @@ func TestCreateUsecase(t *testing.T) {
 			Salary:   request.Salary,
 		}
 
-		openingRepo.On("Create", opening).Return(nil).Once()
-		err := openingUsecase.Create(request)
+		openingRepo.On("Create", &opening).Return(nil).Once()
+		created, err := openingUsecase.Create(request)
 
 		assert.Nil(t, err)
+		assert.Equal(t, &opening, created)
 
-		openingRepo.AssertCalled(t, "Create", opening)
+		openingRepo.AssertCalled(t, "Create", &opening)
 		openingRepo.AssertExpectations(t)
 	})
 
@@ -60,13 +63,13 @@ func TestCreateUsecase(t *testing.T) {
 		}
 
 		mockErr := internal_error.NewInternalServerError("error creating opening")
-		openingRepo.On("Create", opening).Return(gorm.ErrRegistered).Once()
+		openingRepo.On("Create", &opening).Return(gorm.ErrRegistered).Once()
 
-		err := openingUsecase.Create(request)
+		_, err := openingUsecase.Create(request)
 
 		assert.Error(t, err)
 		assert.EqualError(t, mockErr, err.Error())
-		openingRepo.AssertCalled(t, "Create", opening)
+		openingRepo.AssertCalled(t, "Create", &opening)
 		openingRepo.AssertExpectations(t)
 	})
 
@@ -82,7 +85,7 @@ func TestCreateUsecase(t *testing.T) {
 
 		mockErr := internal_error.NewInternalServerError("param: role (type: string) is required")
 
-		err := openingUsecase.Create(openingMockWithEmptyRole)
+		_, err := openingUsecase.Create(openingMockWithEmptyRole)
 
 		assert.Error(t, err, "expected an error when creating the opening without Role")
 		assert.EqualError(t, mockErr, err.Error(), "The error message must be specific")
@@ -102,7 +105,7 @@ func TestCreateUsecase(t *testing.T) {
 
 		mockErr := internal_error.NewInternalServerError("param: company (type: string) is required")
 
-		err := openingUsecase.Create(openingMockWithEmptyCompany)
+		_, err := openingUsecase.Create(openingMockWithEmptyCompany)
 
 		assert.Error(t, err, "expected an error when creating the opening without company")
 		assert.EqualError(t, mockErr, err.Error(), "The error message must be specific")
@@ -121,7 +124,7 @@ func TestCreateUsecase(t *testing.T) {
 		}
 		mockErr := internal_error.NewInternalServerError("param: location (type: string) is required")
 
-		err := openingUsecase.Create(openingMockWithEmptyLocation)
+		_, err := openingUsecase.Create(openingMockWithEmptyLocation)
 
 		assert.Error(t, err, "expected an error when creating the opening without company")
 		assert.EqualError(t, mockErr, err.Error(), "The error message must be specific")
@@ -140,7 +143,7 @@ func TestCreateUsecase(t *testing.T) {
 		}
 		mockErr := internal_error.NewInternalServerError("param: link (type: string) is required")
 
-		err := openingUsecase.Create(openingMockWithEmptyLink)
+		_, err := openingUsecase.Create(openingMockWithEmptyLink)
 
 		assert.Error(t, err, "expected an error when creating the opening without company")
 		assert.EqualError(t, mockErr, err.Error(), "The error message must be specific")
@@ -158,7 +161,7 @@ func TestCreateUsecase(t *testing.T) {
 		}
 		mockErr := internal_error.NewInternalServerError("param: remote (type: bool) is required")
 
-		err := openingUsecase.Create(openingMockWithoutRemote)
+		_, err := openingUsecase.Create(openingMockWithoutRemote)
 
 		assert.Error(t, err, "expected an error when creating the opening without company")
 		assert.EqualError(t, mockErr, err.Error(), "The error message must be specific")
@@ -178,10 +181,77 @@ func TestCreateUsecase(t *testing.T) {
 
 		mockErr := internal_error.NewInternalServerError("salary must be at least 3k")
 
-		err := openingUsecase.Create(openingMockWithLowSalary)
+		_, err := openingUsecase.Create(openingMockWithLowSalary)
 
 		assert.Error(t, err, "expected an error when the salary is less than 3K")
 		assert.EqualError(t, mockErr, err.Error())
 		openingRepo.AssertNotCalled(t, "Create")
 	})
+
+	t.Run("ShouldReturnEveryInvalidFieldAtOnce", func(t *testing.T) {
+		openingMockWithManyErrors := schemas.CreateOpeningRequest{
+			Company: "Tech Corp",
+			Link:    "javascript:alert(1)",
+			Salary:  2500,
+		}
+
+		_, err := openingUsecase.Create(openingMockWithManyErrors)
+
+		assert.Equal(t, internal_error.NewBadRequestError(
+			"param: role (type: string) is required; param: location (type: string) is required; "+
+				"link must be an absolute http or https URL; param: remote (type: bool) is required; salary must be at least 3k",
+			internal_error.Cause{Field: "role", Message: "param: role (type: string) is required"},
+			internal_error.Cause{Field: "location", Message: "param: location (type: string) is required"},
+			internal_error.Cause{Field: "link", Message: "link must be an absolute http or https URL"},
+			internal_error.Cause{Field: "remote", Message: "param: remote (type: bool) is required"},
+			salaryCause,
+		), err)
+		openingRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestCreateUsecaseNotifiers(t *testing.T) {
+	request := schemas.CreateOpeningRequest{
+		Role:     "Software Engineer",
+		Company:  "TechCorp",
+		Location: "Remote",
+		Remote:   boolPtr(true),
+		Link:     "https://example.com/job",
+		Salary:   5000,
+	}
+	opening := schemas.Opening{
+		Role:     request.Role,
+		Company:  request.Company,
+		Location: request.Location,
+		Remote:   *request.Remote,
+		Link:     request.Link,
+		Salary:   request.Salary,
+	}
+
+	t.Run("ShouldNotifyAfterTheOpeningIsStored", func(t *testing.T) {
+		openingRepo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		openingUsecase := opening_usecase.NewOpeningUseCase(openingRepo, opening_usecase.WithNotifier(notifier))
+
+		openingRepo.On("Create", &opening).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		_, err := openingUsecase.Create(request)
+
+		assert.Nil(t, err)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotNotifyIfTheDBFails", func(t *testing.T) {
+		openingRepo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		openingUsecase := opening_usecase.NewOpeningUseCase(openingRepo, opening_usecase.WithNotifier(notifier))
+
+		openingRepo.On("Create", &opening).Return(gorm.ErrRegistered).Once()
+
+		_, err := openingUsecase.Create(request)
+
+		assert.NotNil(t, err)
+		notifier.AssertNotCalled(t, "Notify")
+	})
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
 	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
 	"gorm.io/gorm"
 )
 
@@ -18,7 +20,7 @@ func TestDeleteOpeningByIDUsecase(t *testing.T) {
 
 		openingRepo.On("FindByID", ID).Return(&schemas.Opening{}, gorm.ErrRecordNotFound).Once()
 
-		err := openingUsecase.DeleteByID(ID)
+		err := openingUsecase.DeleteByID(ID, nil)
 
 		assert.Error(t, err)
 		assert.EqualError(t, mockErr, err.Error())
@@ -39,13 +41,13 @@ func TestDeleteOpeningByIDUsecase(t *testing.T) {
 			Salary:   30000,
 		}
 		openingRepo.On("FindByID", ID).Return(openingMock, nil).Once()
-		openingRepo.On("Delete", ID).Return(nil).Once()
+		openingRepo.On("Delete", ID, uint(0)).Return(nil).Once()
 
-		err := openingUsecase.DeleteByID(ID)
+		err := openingUsecase.DeleteByID(ID, nil)
 
 		assert.Nil(t, err)
 		openingRepo.AssertCalled(t, "FindByID", ID)
-		openingRepo.AssertCalled(t, "Delete", ID)
+		openingRepo.AssertCalled(t, "Delete", ID, uint(0))
 		openingRepo.AssertExpectations(t)
 	})
 
@@ -61,15 +63,32 @@ func TestDeleteOpeningByIDUsecase(t *testing.T) {
 			Salary:   30000,
 		}
 		openingRepo.On("FindByID", ID).Return(openingMock, nil).Once()
-		openingRepo.On("Delete", ID).Return(gorm.ErrMissingWhereClause).Once()
+		openingRepo.On("Delete", ID, uint(0)).Return(gorm.ErrMissingWhereClause).Once()
 
 		expectedErr := internal_error.NewInternalServerError("error deleting opening")
-		err := openingUsecase.DeleteByID(ID)
+		err := openingUsecase.DeleteByID(ID, nil)
 
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 		openingRepo.AssertCalled(t, "FindByID", ID)
-		openingRepo.AssertCalled(t, "Delete", ID)
+		openingRepo.AssertCalled(t, "Delete", ID, uint(0))
 		openingRepo.AssertExpectations(t)
 	})
 }
+
+func TestDeleteOpeningByIDUsecaseNotifiers(t *testing.T) {
+	t.Run("ShouldNotifyAfterTheOpeningIsDeleted", func(t *testing.T) {
+		openingRepo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		openingUsecase := opening_usecase.NewOpeningUseCase(openingRepo, opening_usecase.WithNotifier(notifier))
+
+		openingRepo.On("FindByID", uint(3000)).Return(&schemas.Opening{Model: gorm.Model{ID: 3000}}, nil).Once()
+		openingRepo.On("Delete", uint(3000), uint(0)).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		err := openingUsecase.DeleteByID(3000, nil)
+
+		assert.Nil(t, err)
+		notifier.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,145 @@
+package opening_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestDuplicateOpeningUsecase(t *testing.T) {
+	request := schemas.CreateOpeningRequest{
+		Role:     "Senior Go Developer",
+		Company:  "Acme Ltda.",
+		Location: "São Paulo",
+		Remote:   boolPtr(false),
+		Link:     "https://jobs.example.com/acme/42?utm_source=linkedin",
+		Salary:   9000,
+	}
+	existing := schemas.Opening{
+		Model:    gorm.Model{ID: 7},
+		Role:     "Go Developer Sr.",
+		Company:  "ACME",
+		Location: "Sao Paulo",
+		Link:     "https://boards.example.com/acme/7",
+	}
+	other := schemas.Opening{
+		Model:    gorm.Model{ID: 8},
+		Role:     "Designer",
+		Company:  "Acme",
+		Location: "São Paulo",
+		Link:     "https://boards.example.com/acme/8",
+	}
+
+	setup := func(mode string) (*opening_usecase.OpeningUseCase, *mocks.OpeningRepositoryMock) {
+		repo := new(mocks.OpeningRepositoryMock)
+		policy := schemas.DuplicatePolicy{Mode: mode, Threshold: schemas.DefaultDuplicateThreshold}
+		return opening_usecase.NewOpeningUseCase(repo, opening_usecase.WithDuplicatePolicy(policy)), repo
+	}
+
+	t.Run("ShouldRejectADuplicateAndReturnTheOpeningItDuplicates", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeReject)
+		repo.On("FindDuplicateCandidates", "jobs.example.com/acme/42", "acm").
+			Return([]schemas.Opening{other, existing}, nil).Once()
+
+		opening, err := uc.Create(request)
+
+		assert.Equal(t, internal_error.NewConflictError("opening looks like a duplicate of opening 7"), err)
+		assert.Equal(t, uint(7), opening.ID)
+		repo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("ShouldCreateADuplicateFlaggedWithTheOpeningItDuplicates", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeFlag)
+		repo.On("FindDuplicateCandidates", "jobs.example.com/acme/42", "acm").
+			Return([]schemas.Opening{existing}, nil).Once()
+		repo.On("Create", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.DuplicateOf != nil && *o.DuplicateOf == 7
+		})).Return(nil).Once()
+
+		opening, err := uc.Create(request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint(7), *opening.DuplicateOf)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldFlagADuplicateWhoseCompanyIsSpelledDifferently", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeFlag)
+		typo := request
+		typo.Company = "Acmee Incorporated"
+		repo.On("FindDuplicateCandidates", "jobs.example.com/acme/42", "acm").
+			Return([]schemas.Opening{existing}, nil).Once()
+		repo.On("Create", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.DuplicateOf != nil && *o.DuplicateOf == 7
+		})).Return(nil).Once()
+
+		opening, err := uc.Create(typo)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint(7), *opening.DuplicateOf)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldCreateAnOpeningThatIsNotADuplicate", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeReject)
+		repo.On("FindDuplicateCandidates", mock.Anything, mock.Anything).Return([]schemas.Opening{other}, nil).Once()
+		repo.On("Create", mock.MatchedBy(func(o *schemas.Opening) bool { return o.DuplicateOf == nil })).Return(nil).Once()
+
+		_, err := uc.Create(request)
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotLookForDuplicatesWhenTheCheckIsOff", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeOff)
+		repo.On("Create", mock.Anything).Return(nil).Once()
+
+		_, err := uc.Create(request)
+
+		assert.Nil(t, err)
+		repo.AssertNotCalled(t, "FindDuplicateCandidates", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldReturnInternalErrorWhenTheLookupFails", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeReject)
+		repo.On("FindDuplicateCandidates", mock.Anything, mock.Anything).
+			Return([]schemas.Opening(nil), errors.New("db down")).Once()
+
+		opening, err := uc.Create(request)
+
+		assert.Nil(t, opening)
+		assert.Equal(t, internal_error.NewInternalServerError("error checking for duplicate openings"), err)
+	})
+
+	t.Run("ShouldListTheClustersOfOpenOpenings", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeFlag)
+		duplicate := existing
+		duplicate.ID = 9
+		duplicate.Link = "https://boards.example.com/acme/7/?utm_medium=email"
+		repo.On("FindAfter", schemas.OpeningFilter{Status: schemas.OpeningStatusOpen}, uint(0), 500).
+			Return([]schemas.Opening{existing, other, duplicate}, nil).Once()
+
+		clusters, err := uc.DuplicateClusters()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []schemas.DuplicateCluster{{Openings: []schemas.Opening{existing, duplicate}}}, clusters)
+	})
+
+	t.Run("ShouldReturnAnEmptyListWithoutDuplicates", func(t *testing.T) {
+		uc, repo := setup(schemas.DuplicateModeFlag)
+		repo.On("FindAfter", mock.Anything, uint(0), 500).Return([]schemas.Opening{other}, nil).Once()
+
+		clusters, err := uc.DuplicateClusters()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []schemas.DuplicateCluster{}, clusters)
+	})
+}
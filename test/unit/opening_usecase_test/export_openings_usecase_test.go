@@ -0,0 +1,68 @@
+package opening_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"gorm.io/gorm"
+)
+
+func TestExportOpeningsUsecase(t *testing.T) {
+	batch := func(from, n int) []schemas.Opening {
+		openings := make([]schemas.Opening, n)
+		for i := range openings {
+			openings[i] = schemas.Opening{Model: gorm.Model{ID: uint(from + i)}}
+		}
+		return openings
+	}
+	filter := schemas.OpeningFilter{Company: "Acme"}
+
+	t.Run("ShouldReadEveryOpeningInBatches", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("FindAfter", filter, uint(0), 500).Return(batch(1, 500), nil).Once()
+		repo.On("FindAfter", filter, uint(500), 500).Return(batch(501, 2), nil).Once()
+
+		var sizes []int
+		err := uc.Export(filter, func(openings []schemas.Opening) error {
+			sizes = append(sizes, len(openings))
+			return nil
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []int{500, 2}, sizes)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldStopWhenWritingFails", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("FindAfter", filter, uint(0), 500).Return(batch(1, 500), nil).Once()
+
+		err := uc.Export(filter, func(openings []schemas.Opening) error {
+			return errors.New("client went away")
+		})
+
+		assert.Equal(t, internal_error.NewInternalServerError("error writing openings"), err)
+		repo.AssertNumberOfCalls(t, "FindAfter", 1)
+	})
+
+	t.Run("ShouldReturnInternalErrorWhenTheRepositoryFails", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("FindAfter", filter, uint(0), 500).Return([]schemas.Opening(nil), errors.New("db down")).Once()
+
+		err := uc.Export(filter, func(openings []schemas.Opening) error { return nil })
+
+		assert.Equal(t, internal_error.NewInternalServerError("error finding openings"), err)
+	})
+
+	t.Run("ShouldRejectAnInvalidFilter", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		err := uc.Export(schemas.OpeningFilter{Status: "archived"}, func(openings []schemas.Opening) error { return nil })
+
+		assert.Equal(t, "bad_request", err.Err)
+		repo.AssertNotCalled(t, "FindAfter")
+	})
+}
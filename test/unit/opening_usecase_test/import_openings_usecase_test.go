@@ -0,0 +1,73 @@
+package opening_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func TestImportOpeningsUsecase(t *testing.T) {
+	valid := schemas.CreateOpeningRequest{
+		Role:     "Go Developer",
+		Company:  "Acme",
+		Location: "Lisbon",
+		Remote:   boolPtr(true),
+		Link:     "http://example.com/go",
+		Salary:   9000,
+	}
+	invalid := valid
+	invalid.Salary = 100
+
+	setup := func() (*opening_usecase.OpeningUseCase, *mocks.OpeningRepositoryMock, *mocks.NotifierMock) {
+		repo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		return opening_usecase.NewOpeningUseCase(repo, opening_usecase.WithNotifier(notifier)), repo, notifier
+	}
+
+	t.Run("ShouldCreateEveryOpeningAndNotifyOnce", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			args.Get(0).(*schemas.Opening).ID = 7
+		}).Return(nil).Twice()
+		notifier.On("Notify").Return().Once()
+
+		results, err := uc.Import([]schemas.CreateOpeningRequest{valid, valid}, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []opening_usecase.BulkResult{{ID: 7}, {ID: 7}}, results)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRollBackEverythingWhenAnOpeningIsInvalid", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("Create", mock.Anything).Return(nil).Once()
+
+		results, err := uc.Import([]schemas.CreateOpeningRequest{valid, invalid}, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []opening_usecase.BulkResult{
+			{RolledBack: true},
+			{Err: internal_error.NewBadRequestError("salary must be at least 3k", salaryCause)},
+		}, results)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldRollBackADryRun", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("Create", mock.Anything).Return(nil).Once()
+
+		results, err := uc.Import([]schemas.CreateOpeningRequest{valid}, true)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []opening_usecase.BulkResult{{RolledBack: true}}, results)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+}
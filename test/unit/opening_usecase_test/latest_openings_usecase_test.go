@@ -0,0 +1,40 @@
+package opening_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+)
+
+func TestLatestOpeningsUsecase(t *testing.T) {
+	t.Run("ShouldReturnNoOpeningsWithoutError", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("FindLatest", schemas.OpeningFilter{Company: "Acme"}, 50).Return([]schemas.Opening{}, nil).Once()
+
+		openings, err := uc.Latest(schemas.OpeningFilter{Company: "Acme"}, 50)
+
+		assert.Nil(t, err)
+		assert.Empty(t, openings)
+	})
+
+	t.Run("ShouldReturnInternalErrorWhenTheRepositoryFails", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		repo.On("FindLatest", schemas.OpeningFilter{}, 50).Return([]schemas.Opening(nil), errors.New("db down")).Once()
+
+		_, err := uc.Latest(schemas.OpeningFilter{}, 50)
+
+		assert.Equal(t, internal_error.NewInternalServerError("error finding openings"), err)
+	})
+
+	t.Run("ShouldRejectAnInvalidFilter", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		_, err := uc.Latest(schemas.OpeningFilter{Status: "archived"}, 50)
+
+		assert.Equal(t, "bad_request", err.Err)
+		repo.AssertNotCalled(t, "FindLatest")
+	})
+}
@@ -0,0 +1,186 @@
+package opening_usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestRecordLinkCheckUsecase(t *testing.T) {
+	const link = "https://jobs.example.com/acme/42"
+	checkedAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	stored := func(failures int) *schemas.Opening {
+		return &schemas.Opening{
+			Model:        gorm.Model{ID: 42},
+			Role:         "Go Developer",
+			Link:         link,
+			Status:       schemas.OpeningStatusOpen,
+			LinkFailures: failures,
+			Version:      3,
+		}
+	}
+
+	setup := func(opening *schemas.Opening) (*opening_usecase.OpeningUseCase, *mocks.OpeningRepositoryMock, *mocks.NotifierMock) {
+		repo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		repo.On("FindByID", uint(42)).Return(opening, nil).Once()
+		return opening_usecase.NewOpeningUseCase(repo, opening_usecase.WithNotifier(notifier)), repo, notifier
+	}
+
+	t.Run("ShouldRecordAHealthyLinkAndClearThePreviousFailures", func(t *testing.T) {
+		uc, repo, notifier := setup(stored(2))
+		repo.On("RecordLinkCheck", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.LinkStatus == 200 && o.LinkRedirect == link+"/apply" && o.LinkFailures == 0 && o.LinkCheckedAt.Equal(checkedAt)
+		})).Return(nil).Once()
+
+		opening, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 200, Redirect: link + "/apply", CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		assert.True(t, opening.IsOpen())
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldCountAFailedCheck", func(t *testing.T) {
+		uc, repo, _ := setup(stored(1))
+		repo.On("RecordLinkCheck", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.LinkStatus == 404 && o.LinkError == "404 Not Found" && o.LinkFailures == 2
+		})).Return(nil).Once()
+
+		opening, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 404, Error: "404 Not Found", CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		assert.True(t, opening.IsOpen())
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("ShouldNeitherCountNorClearFailuresWhenThrottled", func(t *testing.T) {
+		uc, repo, _ := setup(stored(2))
+		repo.On("RecordLinkCheck", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.LinkStatus == 429 && o.LinkFailures == 2
+		})).Return(nil).Once()
+
+		_, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 429, CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldPauseTheLinkAfterTooManyFailuresInARow", func(t *testing.T) {
+		uc, repo, notifier := setup(stored(2))
+		repo.On("RecordLinkCheck", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.LinkPaused && o.LinkFailures == 3
+		})).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		opening, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Error: "connection refused", CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		assert.False(t, opening.IsOpen())
+		assert.Equal(t, schemas.OpeningStatusOpen, opening.Status)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("ShouldResumeAPausedLinkOnAHealthyCheck", func(t *testing.T) {
+		paused := stored(3)
+		paused.LinkPaused = true
+		uc, repo, notifier := setup(paused)
+		repo.On("RecordLinkCheck", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return !o.LinkPaused && o.LinkFailures == 0
+		})).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		opening, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 200, CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		assert.True(t, opening.IsOpen())
+		repo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("ShouldKeepAPausedLinkPausedWhileItFails", func(t *testing.T) {
+		paused := stored(3)
+		paused.LinkPaused = true
+		uc, repo, notifier := setup(paused)
+		repo.On("RecordLinkCheck", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.LinkPaused && o.LinkFailures == 4
+		})).Return(nil).Once()
+
+		opening, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 404, CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		assert.False(t, opening.IsOpen())
+		repo.AssertExpectations(t)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldNeverPauseTheLinkWithoutAMaximum", func(t *testing.T) {
+		uc, repo, _ := setup(stored(10))
+		repo.On("RecordLinkCheck", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return !o.LinkPaused
+		})).Return(nil).Once()
+
+		opening, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 500, CheckedAt: checkedAt}, 0)
+
+		assert.Nil(t, err)
+		assert.True(t, opening.IsOpen())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldDropACheckWhenTheLinkChangesMeanwhile", func(t *testing.T) {
+		changed := stored(0)
+		changed.Link = "https://jobs.example.com/acme/43"
+		changed.Version = 4
+		uc, repo, notifier := setup(stored(2))
+		repo.On("RecordLinkCheck", mock.Anything).Return(repositories.ErrLinkChanged).Once()
+		repo.On("FindByID", uint(42)).Return(changed, nil).Once()
+
+		opening, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 404, CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		assert.Equal(t, changed, opening)
+		repo.AssertExpectations(t)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldDropACheckOfAnOldLink", func(t *testing.T) {
+		uc, repo, _ := setup(stored(0))
+
+		_, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: "https://jobs.example.com/acme/41", Status: 404, CheckedAt: checkedAt}, 3)
+
+		assert.Nil(t, err)
+		repo.AssertNotCalled(t, "RecordLinkCheck", mock.Anything)
+	})
+
+	t.Run("ShouldReturnNotFoundWhenTheOpeningIsGone", func(t *testing.T) {
+		repo := new(mocks.OpeningRepositoryMock)
+		repo.On("FindByID", uint(42)).Return((*schemas.Opening)(nil), gorm.ErrRecordNotFound).Once()
+		uc := opening_usecase.NewOpeningUseCase(repo)
+
+		_, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 200, CheckedAt: checkedAt}, 3)
+
+		assert.Equal(t, internal_error.NewNotFoundError("opening not found"), err)
+	})
+
+	t.Run("ShouldReturnInternalErrorWhenTheCheckCannotBeSaved", func(t *testing.T) {
+		uc, repo, _ := setup(stored(0))
+		repo.On("RecordLinkCheck", mock.Anything).Return(errors.New("db down")).Once()
+
+		_, err := uc.RecordLinkCheck(42, schemas.LinkCheck{URL: link, Status: 200, CheckedAt: checkedAt}, 3)
+
+		assert.Equal(t, internal_error.NewInternalServerError("error recording link check"), err)
+	})
+}
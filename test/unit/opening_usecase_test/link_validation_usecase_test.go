@@ -0,0 +1,145 @@
+package opening_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestLinkValidationUsecase(t *testing.T) {
+	request := func(link string) schemas.CreateOpeningRequest {
+		return schemas.CreateOpeningRequest{
+			Role:     "Go Developer",
+			Company:  "Acme",
+			Location: "Remote",
+			Remote:   boolPtr(true),
+			Link:     link,
+			Salary:   9000,
+		}
+	}
+	stored := func() *schemas.Opening {
+		return &schemas.Opening{
+			Model:    gorm.Model{ID: 1},
+			Role:     "Go Developer",
+			Company:  "Acme",
+			Location: "Remote",
+			Remote:   true,
+			Link:     "https://jobs.example.com/acme/1",
+			Salary:   9000,
+			Status:   schemas.OpeningStatusOpen,
+		}
+	}
+	linkError := func(message string) *internal_error.InternalError {
+		return internal_error.NewBadRequestError(message, internal_error.Cause{Field: "link", Message: message})
+	}
+
+	setup := func() (*opening_usecase.OpeningUseCase, *mocks.OpeningRepositoryMock) {
+		repo := new(mocks.OpeningRepositoryMock)
+		policy := schemas.LinkPolicy{DeniedHosts: []string{"spam.example"}}
+		return opening_usecase.NewOpeningUseCase(repo, opening_usecase.WithLinkPolicy(policy)), repo
+	}
+
+	t.Run("ShouldStoreTheNormalizedLink", func(t *testing.T) {
+		uc, repo := setup()
+		repo.On("Create", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.Link == "https://jobs.example.com/acme/42?id=42"
+		})).Return(nil).Once()
+
+		opening, err := uc.Create(request("HTTPS://Jobs.Example.com/acme/42?id=42&utm_source=linkedin#apply"))
+
+		assert.Nil(t, err)
+		assert.Equal(t, "https://jobs.example.com/acme/42?id=42", opening.Link)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRejectALinkThatIsNotAnAbsoluteHTTPURL", func(t *testing.T) {
+		for _, link := range []string{"asdf", "javascript:alert(document.cookie)", "ftp://jobs.example.com/1"} {
+			uc, repo := setup()
+
+			_, err := uc.Create(request(link))
+
+			assert.Equal(t, linkError("link must be an absolute http or https URL"), err, link)
+			repo.AssertNotCalled(t, "Create", mock.Anything)
+		}
+	})
+
+	t.Run("ShouldRejectALinkToADeniedHost", func(t *testing.T) {
+		uc, repo := setup()
+
+		_, err := uc.Create(request("https://jobs.spam.example/1"))
+
+		assert.Equal(t, linkError("link points to a host that is not allowed"), err)
+		repo.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("ShouldValidateTheLinkOfAnUpdate", func(t *testing.T) {
+		uc, repo := setup()
+
+		err := uc.Update(1, schemas.UpdateOpeningRequest{Link: "javascript:void(0)", Salary: 9000}, nil)
+
+		assert.Equal(t, linkError("link must be an absolute http or https URL"), err)
+		repo.AssertNotCalled(t, "FindByID", mock.Anything)
+	})
+
+	t.Run("ShouldNormalizeTheLinkOfAnUpdate", func(t *testing.T) {
+		uc, repo := setup()
+		repo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+		repo.On("Update", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.Link == "https://jobs.example.com/acme/2"
+		})).Return(nil).Once()
+
+		err := uc.Update(1, schemas.UpdateOpeningRequest{Link: "https://jobs.example.com/acme/2?utm_medium=email", Salary: 9000}, nil)
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldValidateTheLinkOfAPatch", func(t *testing.T) {
+		uc, repo := setup()
+		repo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+
+		_, err := uc.Patch(1, schemas.MergePatchMediaType, []byte(`{"link":"https://spam.example/1"}`), nil)
+
+		assert.Equal(t, linkError("link points to a host that is not allowed"), err)
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("ShouldNotWriteAPatchThatOnlyAddsTrackingParameters", func(t *testing.T) {
+		uc, repo := setup()
+		opening := stored()
+		repo.On("FindByID", uint(1)).Return(opening, nil).Once()
+
+		result, err := uc.Patch(1, schemas.MergePatchMediaType, []byte(`{"link":"https://jobs.example.com/acme/1?utm_source=x"}`), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, opening, result)
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("ShouldSkipASyncedJobWithAnInvalidLink", func(t *testing.T) {
+		uc, repo := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("FindBySource", "acme").Return([]schemas.Opening{}, nil).Once()
+		repo.On("Create", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.ExternalID == "2" && o.Link == "https://jobs.example.com/acme/2"
+		})).Return(nil).Once()
+
+		job := func(externalID, link string) schemas.Opening {
+			return schemas.Opening{ExternalID: externalID, Role: "Go Developer", Company: "Acme", Location: "Remote", Link: link}
+		}
+		report, err := uc.Sync("acme", []schemas.Opening{
+			job("1", "/jobs/1"),
+			job("2", "https://jobs.example.com/acme/2?gclid=feed"),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 2, Created: 1, Skipped: 1}, report)
+		repo.AssertExpectations(t)
+	})
+}
@@ -15,28 +15,28 @@ func TestListOpeningUsecase(t *testing.T) {
 
 	t.Run("ShouldReturnErrorWhenNoOpeningsAreFound", func(t *testing.T) {
 		mockErr := internal_error.NewNotFoundError("opening record not found")
-		openingRepo.On("FindAll", 10, 0).Return([]schemas.Opening{}, nil).Once()
+		openingRepo.On("FindAll", schemas.OpeningFilter{}, 10, 0).Return([]schemas.Opening{}, nil).Once()
 
-		result, err := openingUsecase.ListOpenings(0)
+		result, err := openingUsecase.ListOpenings(schemas.OpeningFilter{}, 0)
 
 		assert.Error(t, err, "expected an error")
 		assert.Equal(t, mockErr, err, "The error message must be specific")
 
-		openingRepo.AssertCalled(t, "FindAll", 10, 0)
+		openingRepo.AssertCalled(t, "FindAll", schemas.OpeningFilter{}, 10, 0)
 		assert.Nil(t, result)
 	})
 
 	t.Run("ShouldReturnErrorWhenThereIsAnErrorInTheDB", func(t *testing.T) {
 		mockErr := internal_error.NewNotFoundError("opening record not found")
-		openingRepo.On("FindAll", 10, 0).Return([]schemas.Opening{}, gorm.ErrRecordNotFound).Once()
+		openingRepo.On("FindAll", schemas.OpeningFilter{}, 10, 0).Return([]schemas.Opening{}, gorm.ErrRecordNotFound).Once()
 
-		result, err := openingUsecase.ListOpenings(0)
+		result, err := openingUsecase.ListOpenings(schemas.OpeningFilter{}, 0)
 
 		assert.Error(t, err, "expected an error")
 		assert.EqualError(t, mockErr, err.Message, "The error message must be specific")
 		assert.Equal(t, mockErr, err, "The error message must be specific")
 
-		openingRepo.AssertCalled(t, "FindAll", 10, 0)
+		openingRepo.AssertCalled(t, "FindAll", schemas.OpeningFilter{}, 10, 0)
 		assert.Nil(t, result)
 	})
 
@@ -47,13 +47,13 @@ func TestListOpeningUsecase(t *testing.T) {
 			mockOpenings[i] = mockListOpenings[i]
 		}
 
-		openingRepo.On("FindAll", 10, 0).Return(mockOpenings, nil).Once()
+		openingRepo.On("FindAll", schemas.OpeningFilter{}, 10, 0).Return(mockOpenings, nil).Once()
 
-		result, err := openingUsecase.ListOpenings(0)
+		result, err := openingUsecase.ListOpenings(schemas.OpeningFilter{}, 0)
 
 		assert.Nil(t, err)
 		assert.Len(t, result, 10, "Should return exactly 10 results")
-		openingRepo.AssertCalled(t, "FindAll", 10, 0)
+		openingRepo.AssertCalled(t, "FindAll", schemas.OpeningFilter{}, 10, 0)
 		assert.Equal(t, result[0].ID, uint(1))
 		assert.Equal(t, result[9].ID, uint(10))
 		assert.Equal(t, result[0], mockOpenings[0])
@@ -66,13 +66,13 @@ func TestListOpeningUsecase(t *testing.T) {
 			mockOpenings[i] = mockListOpenings[i]
 		}
 
-		openingRepo.On("FindAll", 10, 0).Return(mockOpenings, nil).Once()
+		openingRepo.On("FindAll", schemas.OpeningFilter{}, 10, 0).Return(mockOpenings, nil).Once()
 
-		result, err := openingUsecase.ListOpenings(1)
+		result, err := openingUsecase.ListOpenings(schemas.OpeningFilter{}, 1)
 
 		assert.Nil(t, err)
 		assert.Len(t, result, 10, "Should return exactly 10 results")
-		openingRepo.AssertCalled(t, "FindAll", 10, 0)
+		openingRepo.AssertCalled(t, "FindAll", schemas.OpeningFilter{}, 10, 0)
 		assert.Equal(t, result[0].ID, uint(1))
 		assert.Equal(t, result[9].ID, uint(10))
 		assert.Equal(t, result[0], mockOpenings[0])
@@ -85,13 +85,13 @@ func TestListOpeningUsecase(t *testing.T) {
 			mockOpenings[i-20] = mockListOpenings[i]
 		}
 
-		openingRepo.On("FindAll", 10, 20).Return(mockOpenings, nil).Once()
+		openingRepo.On("FindAll", schemas.OpeningFilter{}, 10, 20).Return(mockOpenings, nil).Once()
 
-		result, err := openingUsecase.ListOpenings(3)
+		result, err := openingUsecase.ListOpenings(schemas.OpeningFilter{}, 3)
 
 		assert.Nil(t, err)
 		assert.Len(t, result, 10, "Should return exactly 10 results")
-		openingRepo.AssertCalled(t, "FindAll", 10, 20)
+		openingRepo.AssertCalled(t, "FindAll", schemas.OpeningFilter{}, 10, 20)
 		assert.Equal(t, result[0].ID, uint(21))
 		assert.Equal(t, result[9].ID, uint(30))
 		assert.Equal(t, result[0], mockOpenings[0])
@@ -104,15 +104,34 @@ func TestListOpeningUsecase(t *testing.T) {
 			mockOpenings[i-40] = mockListOpenings[i]
 		}
 
-		openingRepo.On("FindAll", 10, 40).Return(mockOpenings, nil).Once()
+		openingRepo.On("FindAll", schemas.OpeningFilter{}, 10, 40).Return(mockOpenings, nil).Once()
 
-		result, err := openingUsecase.ListOpenings(5)
+		result, err := openingUsecase.ListOpenings(schemas.OpeningFilter{}, 5)
 
 		assert.Nil(t, err)
 		assert.Len(t, result, 10, "Should return exactly 10 results")
-		openingRepo.AssertCalled(t, "FindAll", 10, 40)
+		openingRepo.AssertCalled(t, "FindAll", schemas.OpeningFilter{}, 10, 40)
 		assert.Equal(t, result[0].ID, uint(41))
 		assert.Equal(t, result[9].ID, uint(50))
 		assert.Equal(t, result[0], mockOpenings[0])
 	})
+
+	t.Run("ShouldReturnErrorWhenTheFilterIsInvalid", func(t *testing.T) {
+		result, err := openingUsecase.ListOpenings(schemas.OpeningFilter{Status: "archived"}, 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, internal_error.NewBadRequestError("status must be one of: open, closed"), err)
+	})
+
+	t.Run("ShouldPassTheFilterToTheRepository", func(t *testing.T) {
+		remote := true
+		filter := schemas.OpeningFilter{Query: "go", Company: "Acme", Remote: &remote, MinSalary: 5000, Status: schemas.OpeningStatusOpen}
+		mockOpenings := mocks.GenerateListOpenings(1)
+		openingRepo.On("FindAll", filter, 10, 0).Return(mockOpenings, nil).Once()
+
+		result, err := openingUsecase.ListOpenings(filter, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, mockOpenings, result)
+	})
 }
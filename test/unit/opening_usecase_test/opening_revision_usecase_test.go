@@ -0,0 +1,127 @@
+package opening_usecase_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"gorm.io/gorm"
+)
+
+func revisionOf(t *testing.T, opening schemas.Opening, number uint) *schemas.OpeningRevision {
+	snapshot, err := json.Marshal(opening)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &schemas.OpeningRevision{
+		OpeningID: opening.ID,
+		Revision:  number,
+		Snapshot:  string(snapshot),
+	}
+}
+
+func TestGetOpeningRevisionUsecase(t *testing.T) {
+	openingUsecase, openingRepo := setupUsecaseTest()
+	var ID uint = 3000
+
+	t.Run("ShouldReturnTheOpeningAsStoredInTheRevision", func(t *testing.T) {
+		opening := schemas.Opening{
+			Model:    gorm.Model{ID: ID},
+			Role:     "Java Developer",
+			Company:  "Tech Corp",
+			Location: "USA",
+			Link:     "https://global.com/job/usa",
+			Salary:   80000,
+		}
+		openingRepo.On("FindRevision", ID, uint(2)).Return(revisionOf(t, opening, 2), nil).Once()
+
+		result, err := openingUsecase.GetRevision(ID, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, ID, result.OpeningID)
+		assert.Equal(t, uint(2), result.Revision)
+		assert.Equal(t, opening.Role, result.Opening.Role)
+		assert.Equal(t, opening.Salary, result.Opening.Salary)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheRevisionIsNotFound", func(t *testing.T) {
+		mockErr := internal_error.NewNotFoundError("revision not found")
+		openingRepo.On("FindRevision", ID, uint(9)).Return((*schemas.OpeningRevision)(nil), gorm.ErrRecordNotFound).Once()
+
+		result, err := openingUsecase.GetRevision(ID, 9)
+
+		assert.Nil(t, result)
+		assert.Equal(t, mockErr, err)
+	})
+}
+
+func TestRestoreOpeningRevisionUsecase(t *testing.T) {
+	var ID uint = 3000
+	current := schemas.Opening{
+		Model:    gorm.Model{ID: ID},
+		Role:     "Broken Title",
+		Company:  "Tech Corp",
+		Location: "USA",
+		Link:     "https://global.com/job/usa",
+		Salary:   3000,
+	}
+	previous := schemas.Opening{
+		Model:    gorm.Model{ID: ID},
+		Role:     "Java Developer",
+		Company:  "Tech Corp",
+		Location: "USA",
+		Link:     "https://global.com/job/usa",
+		Salary:   80000,
+	}
+
+	t.Run("ShouldWriteTheRevisionSnapshotBackToTheOpening", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		expectedOpening := previous
+		expectedOpening.Model = current.Model
+
+		openingRepo.On("FindByID", ID).Return(&current, nil).Once()
+		openingRepo.On("FindRevision", ID, uint(1)).Return(revisionOf(t, previous, 1), nil).Once()
+		openingRepo.On("Update", &expectedOpening).Return(nil).Once()
+
+		err := openingUsecase.RestoreRevision(ID, 1)
+
+		assert.Nil(t, err)
+		openingRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheOpeningIsNotFound", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", ID).Return((*schemas.Opening)(nil), gorm.ErrRecordNotFound).Once()
+
+		err := openingUsecase.RestoreRevision(ID, 1)
+
+		assert.EqualError(t, err, "opening not found")
+		openingRepo.AssertNotCalled(t, "FindRevision", ID, uint(1))
+		openingRepo.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheRevisionIsNotFound", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", ID).Return(&current, nil).Once()
+		openingRepo.On("FindRevision", ID, uint(7)).Return((*schemas.OpeningRevision)(nil), gorm.ErrRecordNotFound).Once()
+
+		err := openingUsecase.RestoreRevision(ID, 7)
+
+		assert.EqualError(t, err, "revision not found")
+		openingRepo.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheDBFails", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", ID).Return(&current, nil).Once()
+		openingRepo.On("FindRevision", ID, uint(1)).Return(revisionOf(t, previous, 1), nil).Once()
+		openingRepo.On("Update", &previous).Return(gorm.ErrRegistered).Once()
+
+		err := openingUsecase.RestoreRevision(ID, 1)
+
+		assert.EqualError(t, err, "error restoring opening")
+	})
+}
@@ -0,0 +1,73 @@
+package opening_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/internal/repositories"
+	"gorm.io/gorm"
+)
+
+func TestOpeningVersionUsecase(t *testing.T) {
+	stored := func() *schemas.Opening {
+		return &schemas.Opening{
+			Model:    gorm.Model{ID: 1},
+			Role:     "Go Developer",
+			Company:  "Acme",
+			Location: "Lisbon",
+			Remote:   true,
+			Link:     "http://example.com/go",
+			Salary:   9000,
+			Status:   schemas.OpeningStatusOpen,
+			Version:  3,
+		}
+	}
+	update := schemas.UpdateOpeningRequest{Role: "Go Engineer", Salary: 9000}
+	stale := &schemas.Precondition{Versions: []uint{2}}
+	current := &schemas.Precondition{Versions: []uint{1, 3}}
+	preconditionFailed := internal_error.NewPreconditionFailedError("opening version does not match")
+
+	t.Run("ShouldRejectWritesToAnotherVersion", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Times(3)
+
+		assert.Equal(t, preconditionFailed, openingUsecase.Update(1, update, stale))
+
+		_, err := openingUsecase.Patch(1, schemas.MergePatchMediaType, []byte(`{"role":"Go Engineer"}`), stale)
+		assert.Equal(t, preconditionFailed, err)
+
+		assert.Equal(t, preconditionFailed, openingUsecase.DeleteByID(1, stale))
+
+		openingRepo.AssertNotCalled(t, "Update", mock.Anything)
+		openingRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ShouldWriteTheVersionTheOpeningWasReadAt", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Twice()
+		openingRepo.On("Update", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.Version == 3 && o.Role == "Go Engineer"
+		})).Return(nil).Once()
+		openingRepo.On("Delete", uint(1), uint(3)).Return(nil).Once()
+
+		assert.Nil(t, openingUsecase.Update(1, update, current))
+		assert.Nil(t, openingUsecase.DeleteByID(1, current))
+		openingRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReportAConflictWhenTheOpeningChangesWhileBeingWritten", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Times(3)
+		openingRepo.On("Update", mock.Anything).Return(repositories.ErrVersionConflict).Once()
+		openingRepo.On("Delete", uint(1), uint(3)).Return(repositories.ErrVersionConflict).Twice()
+
+		err := openingUsecase.Update(1, update, nil)
+		assert.Equal(t, "conflict", err.Err)
+
+		assert.Equal(t, "conflict", openingUsecase.DeleteByID(1, nil).Err)
+		assert.Equal(t, preconditionFailed, openingUsecase.DeleteByID(1, current))
+	})
+}
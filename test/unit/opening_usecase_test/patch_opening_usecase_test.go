@@ -0,0 +1,162 @@
+package opening_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestPatchOpeningUsecase(t *testing.T) {
+	stored := func() *schemas.Opening {
+		return &schemas.Opening{
+			Model:    gorm.Model{ID: 1},
+			Role:     "Go Developer",
+			Company:  "Acme",
+			Location: "Lisbon",
+			Remote:   true,
+			Link:     "http://example.com/go",
+			Salary:   9000,
+			Status:   schemas.OpeningStatusOpen,
+		}
+	}
+
+	t.Run("ShouldReturnNotFoundWhenTheOpeningDoesNotExist", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", uint(9)).Return((*schemas.Opening)(nil), gorm.ErrRecordNotFound).Once()
+
+		result, err := openingUsecase.Patch(9, schemas.MergePatchMediaType, []byte(`{"role":"Go Engineer"}`), nil)
+
+		assert.Nil(t, result)
+		assert.Equal(t, internal_error.NewNotFoundError("opening not found"), err)
+	})
+
+	t.Run("ShouldApplyAMergePatch", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+
+		expected := stored()
+		expected.Role = "Go Engineer"
+		expected.Remote = false
+		expected.Salary = 12000
+		openingRepo.On("Update", expected).Return(nil).Once()
+
+		result, err := openingUsecase.Patch(1, schemas.MergePatchMediaType, []byte(`{"role":"Go Engineer","remote":false,"salary":12000}`), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+		openingRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldApplyAJSONPatch", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+
+		expected := stored()
+		expected.Location = "Porto"
+		expected.Status = schemas.OpeningStatusClosed
+		openingRepo.On("Update", expected).Return(nil).Once()
+
+		result, err := openingUsecase.Patch(1, schemas.JSONPatchMediaType, []byte(`[
+			{"op": "test", "path": "/location", "value": "Lisbon"},
+			{"op": "replace", "path": "/location", "value": "Porto"},
+			{"op": "replace", "path": "/status", "value": "closed"}
+		]`), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, result)
+		openingRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldValidateTheFieldsClearedByTheMergePatch", func(t *testing.T) {
+		for patch, cause := range map[string]internal_error.Cause{
+			`{"company":null}`: {Field: "company", Message: "param: company (type: string) is required"},
+			`{"remote":null}`:  {Field: "remote", Message: "param: remote (type: bool) is required"},
+			`{"salary":null}`:  {Field: "salary", Message: "salary must be at least 3k"},
+			`{"link":""}`:      {Field: "link", Message: "param: link (type: string) is required"},
+		} {
+			openingUsecase, openingRepo := setupUsecaseTest()
+			openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+
+			result, err := openingUsecase.Patch(1, schemas.MergePatchMediaType, []byte(patch), nil)
+
+			assert.Nil(t, result, patch)
+			assert.Equal(t, internal_error.NewBadRequestError(cause.Message, cause), err, patch)
+			openingRepo.AssertNotCalled(t, "Update", mock.Anything)
+		}
+	})
+
+	t.Run("ShouldResetTheStatusClearedByTheMergePatch", func(t *testing.T) {
+		openingUsecase, openingRepo := setupUsecaseTest()
+		closed := stored()
+		closed.Status = schemas.OpeningStatusClosed
+		openingRepo.On("FindByID", uint(1)).Return(closed, nil).Once()
+		openingRepo.On("Update", stored()).Return(nil).Once()
+
+		result, err := openingUsecase.Patch(1, schemas.MergePatchMediaType, []byte(`{"status":null}`), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, schemas.OpeningStatusOpen, result.Status)
+	})
+
+	t.Run("ShouldRejectInvalidPatches", func(t *testing.T) {
+		for _, tc := range []struct {
+			mediaType string
+			patch     string
+			message   string
+		}{
+			{schemas.MergePatchMediaType, `{"salary":"lots"}`, "cannot unmarshal string into Go struct field OpeningDocument.salary of type int64"},
+			{schemas.MergePatchMediaType, `{"id":7}`, `unknown field "id"`},
+			{schemas.MergePatchMediaType, `{"status":"archived"}`, "status must be one of: open, closed"},
+			{schemas.MergePatchMediaType, `{"role":`, "invalid patch"},
+			{schemas.JSONPatchMediaType, `[{"op":"test","path":"/role","value":"Java Developer"}]`, "invalid patch"},
+			{schemas.JSONPatchMediaType, `[{"op":"remove","path":"/missing"}]`, "invalid patch"},
+			{"application/json", `{}`, `unsupported patch media type "application/json"`},
+		} {
+			openingUsecase, openingRepo := setupUsecaseTest()
+			openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+
+			result, err := openingUsecase.Patch(1, tc.mediaType, []byte(tc.patch), nil)
+
+			assert.Nil(t, result, tc.patch)
+			if assert.NotNil(t, err, tc.patch) {
+				assert.Equal(t, "bad_request", err.Err, tc.patch)
+				assert.Contains(t, err.Message, tc.message, tc.patch)
+			}
+			openingRepo.AssertNotCalled(t, "Update", mock.Anything)
+		}
+	})
+
+	t.Run("ShouldNotSaveAnUnchangedOpening", func(t *testing.T) {
+		openingRepo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		openingUsecase := opening_usecase.NewOpeningUseCase(openingRepo, opening_usecase.WithNotifier(notifier))
+		openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+
+		result, err := openingUsecase.Patch(1, schemas.MergePatchMediaType, []byte(`{"role":"Go Developer"}`), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, stored(), result)
+		openingRepo.AssertNotCalled(t, "Update", mock.Anything)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldNotifyAfterThePatchIsStored", func(t *testing.T) {
+		openingRepo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		openingUsecase := opening_usecase.NewOpeningUseCase(openingRepo, opening_usecase.WithNotifier(notifier))
+		openingRepo.On("FindByID", uint(1)).Return(stored(), nil).Once()
+		openingRepo.On("Update", mock.Anything).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		_, err := openingUsecase.Patch(1, schemas.MergePatchMediaType, []byte(`{"salary":10000}`), nil)
+
+		assert.Nil(t, err)
+		notifier.AssertExpectations(t)
+	})
+}
@@ -2,9 +2,12 @@ package opening_usecase_test
 
 import (
 	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
 	"github.com/valdir-alves3000/go-opportunities/test/mocks"
 )
 
+var salaryCause = internal_error.Cause{Field: "salary", Message: "salary must be at least 3k"}
+
 func boolPtr(b bool) *bool {
 	return &b
 }
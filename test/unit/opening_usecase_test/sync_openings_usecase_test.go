@@ -0,0 +1,171 @@
+package opening_usecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/opening_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestSyncOpeningsUsecase(t *testing.T) {
+	job := func(externalID, role string) schemas.Opening {
+		return schemas.Opening{
+			ExternalID: externalID,
+			Role:       role,
+			Company:    "Acme",
+			Location:   "São Paulo",
+			Link:       "https://jobs.example.com/" + externalID,
+		}
+	}
+	stored := func(id uint, externalID, role, status string) schemas.Opening {
+		opening := job(externalID, role)
+		opening.Model = gorm.Model{ID: id}
+		opening.Source = "acme"
+		opening.Status = status
+		opening.Version = 1
+		return opening
+	}
+
+	setup := func() (*opening_usecase.OpeningUseCase, *mocks.OpeningRepositoryMock, *mocks.NotifierMock) {
+		repo := new(mocks.OpeningRepositoryMock)
+		notifier := new(mocks.NotifierMock)
+		return opening_usecase.NewOpeningUseCase(repo, opening_usecase.WithNotifier(notifier)), repo, notifier
+	}
+
+	t.Run("ShouldCreateUpdateAndCloseOpeningsToMatchTheFeed", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("FindBySource", "acme").Return([]schemas.Opening{
+			stored(1, "10", "Go Developer", schemas.OpeningStatusOpen),
+			stored(2, "20", "Designer", schemas.OpeningStatusOpen),
+			stored(3, "30", "QA", schemas.OpeningStatusOpen),
+		}, nil).Once()
+		repo.On("Create", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.Source == "acme" && o.ExternalID == "40" && o.Status == schemas.OpeningStatusOpen
+		})).Return(nil).Once()
+		repo.On("Update", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.ID == 2 && o.Role == "Senior Designer" && o.Version == 1
+		})).Return(nil).Once()
+		repo.On("Update", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.ID == 3 && o.Status == schemas.OpeningStatusClosed && o.SyncClosedVersion == 2
+		})).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		report, err := uc.Sync("acme", []schemas.Opening{
+			job("10", "Go Developer"),
+			job("20", "Senior Designer"),
+			job("40", "SRE"),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 3, Created: 1, Updated: 1, Unchanged: 1, Closed: 1}, report)
+		repo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReopenAnOpeningItClosedWhenItIsListedAgain", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		closed := stored(1, "10", "Go Developer", schemas.OpeningStatusClosed)
+		closed.SyncClosedVersion = 1
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("FindBySource", "acme").Return([]schemas.Opening{closed}, nil).Once()
+		repo.On("Update", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.ID == 1 && o.Status == schemas.OpeningStatusOpen
+		})).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		report, err := uc.Sync("acme", []schemas.Opening{job("10", "Go Developer")})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, report.Updated)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldKeepOpeningsClosedByOthersClosed", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		// Closed through the API, and closed by a sync but edited since.
+		closedHere := stored(1, "10", "Go Developer", schemas.OpeningStatusClosed)
+		editedSince := stored(2, "20", "Designer", schemas.OpeningStatusClosed)
+		editedSince.SyncClosedVersion = 1
+		editedSince.Version = 2
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("FindBySource", "acme").Return([]schemas.Opening{closedHere, editedSince}, nil).Once()
+		repo.On("Update", mock.MatchedBy(func(o *schemas.Opening) bool {
+			return o.ID == 2 && o.Role == "Senior Designer" && o.Status == schemas.OpeningStatusClosed
+		})).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		report, err := uc.Sync("acme", []schemas.Opening{job("10", "Go Developer"), job("20", "Senior Designer")})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 2, Updated: 1, Unchanged: 1}, report)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldSkipInvalidRepeatedAndDeletedJobsWithoutClosingThem", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		deleted := stored(2, "20", "Designer", schemas.OpeningStatusOpen)
+		deleted.DeletedAt = gorm.DeletedAt{Valid: true}
+		lowSalary := job("10", "Go Developer")
+		lowSalary.Salary = 1800
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("FindBySource", "acme").Return([]schemas.Opening{
+			stored(1, "10", "Go Developer", schemas.OpeningStatusOpen),
+			deleted,
+		}, nil).Once()
+
+		report, err := uc.Sync("acme", []schemas.Opening{
+			lowSalary,
+			job("20", "Designer"),
+			job("", "No reference"),
+			job("30", ""),
+			job("30", "QA"),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &schemas.ATSSyncReport{Source: "acme", Fetched: 5, Skipped: 5}, report)
+		repo.AssertNotCalled(t, "Create", mock.Anything)
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldAcceptAnUnknownSalary", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("FindBySource", "acme").Return([]schemas.Opening{}, nil).Once()
+		repo.On("Create", mock.MatchedBy(func(o *schemas.Opening) bool { return o.Salary == 0 })).Return(nil).Once()
+		notifier.On("Notify").Return().Once()
+
+		report, err := uc.Sync("acme", []schemas.Opening{job("10", "Go Developer")})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, report.Created)
+	})
+
+	t.Run("ShouldReturnInternalErrorWhenTheSyncFails", func(t *testing.T) {
+		uc, repo, notifier := setup()
+		repo.On("Transaction").Return(nil).Once()
+		repo.On("FindBySource", "acme").Return([]schemas.Opening(nil), errors.New("db down")).Once()
+
+		report, err := uc.Sync("acme", []schemas.Opening{job("10", "Go Developer")})
+
+		assert.Nil(t, report)
+		assert.Equal(t, internal_error.NewInternalServerError("error syncing openings"), err)
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("ShouldRequireASource", func(t *testing.T) {
+		uc, repo, _ := setup()
+
+		_, err := uc.Sync("", nil)
+
+		assert.Equal(t, "bad_request", err.Err)
+		repo.AssertNotCalled(t, "Transaction")
+	})
+}
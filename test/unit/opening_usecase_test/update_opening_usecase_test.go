@@ -44,15 +44,15 @@ func TestUpdateOpeningUsecase(t *testing.T) {
 			Salary:   upOpeningMock.Salary,
 		}
 
-		openingRepo.On("Update", expectedOpening).Return(gorm.ErrRegistered).Once()
+		openingRepo.On("Update", &expectedOpening).Return(gorm.ErrRegistered).Once()
 		openingRepo.On("FindByID", ID).Return(&openingExist, nil).Once()
 		mockErr := internal_error.NewInternalServerError("error updating opening")
 
-		err := openingUsecase.Update(ID, upOpeningMock)
+		err := openingUsecase.Update(ID, upOpeningMock, nil)
 
 		assert.Error(t, err)
 		assert.EqualError(t, mockErr, err.Error())
-		openingRepo.AssertCalled(t, "Update", expectedOpening)
+		openingRepo.AssertCalled(t, "Update", &expectedOpening)
 		openingRepo.AssertExpectations(t)
 	})
 
@@ -77,20 +77,44 @@ func TestUpdateOpeningUsecase(t *testing.T) {
 		}
 
 		openingRepo.On("FindByID", ID).Return(&openingExist, nil).Once()
-		openingRepo.On("Update", expectedOpening).Return(nil).Once()
+		openingRepo.On("Update", &expectedOpening).Return(nil).Once()
 
-		err := openingUsecase.Update(ID, upOpeningMock)
+		err := openingUsecase.Update(ID, upOpeningMock, nil)
 
 		assert.Nil(t, err)
-		openingRepo.AssertCalled(t, "Update", expectedOpening)
+		openingRepo.AssertCalled(t, "Update", &expectedOpening)
 		openingRepo.AssertExpectations(t)
 	})
 
+	t.Run("ShouldKeepTheATSSourceOfTheOpening", func(t *testing.T) {
+		openingExist := schemas.Opening{
+			Model:      gorm.Model{ID: ID},
+			Role:       "Java Developer",
+			Company:    "Tech Corp",
+			Location:   "USA",
+			Link:       "https://global.com/job/usa",
+			Salary:     80000,
+			Source:     "techcorp",
+			ExternalID: "4012345",
+			Version:    2,
+		}
+		expectedOpening := openingExist
+		expectedOpening.Salary = 90000
+
+		openingRepo.On("FindByID", ID).Return(&openingExist, nil).Once()
+		openingRepo.On("Update", &expectedOpening).Return(nil).Once()
+
+		err := openingUsecase.Update(ID, schemas.UpdateOpeningRequest{Salary: 90000}, nil)
+
+		assert.Nil(t, err)
+		openingRepo.AssertCalled(t, "Update", &expectedOpening)
+	})
+
 	t.Run("ShouldReturnAnErrorIfTheOpeningIsNotFound", func(t *testing.T) {
 		mockErr := errors.New("opening not found")
 		openingRepo.On("FindByID", ID).Return(&schemas.Opening{}, gorm.ErrRecordNotFound).Once()
 
-		err := openingUsecase.Update(ID, upOpeningMock)
+		err := openingUsecase.Update(ID, upOpeningMock, nil)
 
 		openingRepo.AssertCalled(t, "FindByID", ID)
 		openingRepo.AssertNotCalled(t, "Update")
@@ -101,7 +125,7 @@ func TestUpdateOpeningUsecase(t *testing.T) {
 	t.Run("ShouldReturnAnErrorIfAnEmptyBodyIsRequiredForTheUpdate", func(t *testing.T) {
 		upOpening := schemas.UpdateOpeningRequest{}
 
-		err := openingUsecase.Update(ID, upOpening)
+		err := openingUsecase.Update(ID, upOpening, nil)
 
 		assert.Error(t, err, "I expected error when updating if body is empty")
 		assert.EqualError(t, err, "at least one valid field must be provided", "The error message must be specific")
@@ -112,9 +136,37 @@ func TestUpdateOpeningUsecase(t *testing.T) {
 			Salary: 2999,
 		}
 
-		err := openingUsecase.Update(ID, upOpeningMock)
+		err := openingUsecase.Update(ID, upOpeningMock, nil)
 
 		assert.Error(t, err, "I expected an error when updating a salary less than 3k")
 		assert.EqualError(t, err, "salary must be at least 3k", "The error message must be specific")
 	})
+
+	t.Run("ShouldReturnAnErrorWhenTryingToUpdateTheStatusToAnUnknownValue", func(t *testing.T) {
+		upOpeningMock := schemas.UpdateOpeningRequest{
+			Status: "archived",
+			Salary: 50000,
+		}
+
+		err := openingUsecase.Update(ID, upOpeningMock, nil)
+
+		assert.Error(t, err, "I expected an error when updating to an unknown status")
+		assert.EqualError(t, err, "status must be one of: open, closed", "The error message must be specific")
+	})
+
+	t.Run("ShouldReturnEveryInvalidFieldAtOnce", func(t *testing.T) {
+		upOpeningMock := schemas.UpdateOpeningRequest{
+			Status: "archived",
+			Link:   "asdf",
+			Salary: 2999,
+		}
+
+		err := openingUsecase.Update(ID, upOpeningMock, nil)
+
+		assert.Equal(t, []internal_error.Cause{
+			salaryCause,
+			{Field: "status", Message: "status must be one of: open, closed"},
+			{Field: "link", Message: "link must be an absolute http or https URL"},
+		}, err.Causes)
+	})
 }
@@ -0,0 +1,150 @@
+package outbox_usecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/outbox_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestOutboxRelay(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("ShouldPublishPendingEventsInOrder", func(t *testing.T) {
+		relay, repo, first, second := setupRelayTest()
+		row1, event1 := outboxEvent(1, "evt-1", now.Add(-time.Minute))
+		row2, event2 := outboxEvent(2, "evt-2", now)
+
+		repo.On("FindPending", mock.Anything).Return([]schemas.OutboxEvent{row1, row2}, nil).Once()
+		first.On("Publish", event1).Return(nil).Once()
+		second.On("Publish", event1).Return(nil).Once()
+		first.On("Publish", event2).Return(nil).Once()
+		second.On("Publish", event2).Return(nil).Once()
+		repo.On("Update", mock.MatchedBy(func(e schemas.OutboxEvent) bool {
+			return e.ID == 1 && e.PublishedAt != nil && e.PublishedAt.Equal(now)
+		})).Return(nil).Once()
+		repo.On("Update", mock.MatchedBy(func(e schemas.OutboxEvent) bool {
+			return e.ID == 2 && e.PublishedAt != nil
+		})).Return(nil).Once()
+		repo.On("DeletePublishedBefore", now.Add(-24*time.Hour)).Return(nil).Once()
+
+		err := relay.RunDue(now)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		first.AssertExpectations(t)
+		second.AssertExpectations(t)
+	})
+
+	t.Run("ShouldHoldBackLaterEventsWhileOneFails", func(t *testing.T) {
+		relay, repo, first, second := setupRelayTest()
+		row1, event1 := outboxEvent(1, "evt-1", now)
+		row2, _ := outboxEvent(2, "evt-2", now)
+
+		repo.On("FindPending", mock.Anything).Return([]schemas.OutboxEvent{row1, row2}, nil).Once()
+		first.On("Publish", event1).Return(nil).Once()
+		second.On("Publish", event1).Return(errors.New("connection refused")).Once()
+		repo.On("Update", mock.MatchedBy(func(e schemas.OutboxEvent) bool {
+			return e.ID == 1 && e.PublishedAt == nil && e.Attempts == 1 &&
+				e.LastError == "connection refused" && e.NextAttemptAt.Equal(now.Add(time.Second))
+		})).Return(nil).Once()
+
+		err := relay.RunDue(now)
+
+		assert.EqualError(t, err, "outbox event evt-1: connection refused")
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "DeletePublishedBefore", mock.Anything)
+		first.AssertNumberOfCalls(t, "Publish", 1)
+	})
+
+	t.Run("ShouldOnlyRetryThePublishersThatFailed", func(t *testing.T) {
+		relay, repo, first, second := setupRelayTest()
+		row, event := outboxEvent(1, "evt-1", now)
+
+		repo.On("FindPending", mock.Anything).Return([]schemas.OutboxEvent{row}, nil).Once()
+		first.On("Publish", event).Return(nil).Once()
+		second.On("Publish", event).Return(errors.New("timeout")).Once()
+		repo.On("Update", mock.Anything).Return(nil).Once()
+
+		assert.Error(t, relay.RunDue(now))
+
+		row.Attempts = 1
+		row.NextAttemptAt = now.Add(time.Second)
+		later := now.Add(2 * time.Second)
+		repo.On("FindPending", mock.Anything).Return([]schemas.OutboxEvent{row}, nil).Once()
+		second.On("Publish", event).Return(nil).Once()
+		repo.On("Update", mock.MatchedBy(func(e schemas.OutboxEvent) bool {
+			return e.PublishedAt != nil && e.LastError == ""
+		})).Return(nil).Once()
+		repo.On("DeletePublishedBefore", mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, relay.RunDue(later))
+		first.AssertNumberOfCalls(t, "Publish", 1)
+		second.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("ShouldDeadLetterAnEventThatKeepsFailingAndMoveOn", func(t *testing.T) {
+		repo := new(mocks.OutboxRepositoryMock)
+		publisher := new(mocks.EventPublisherMock)
+		limited := policy
+		limited.MaxAttempts = 3
+		relay := outbox_usecase.NewRelay(repo, limited, publisher)
+		row1, event1 := outboxEvent(1, "evt-1", now)
+		row1.Attempts = 2
+		row2, event2 := outboxEvent(2, "evt-2", now)
+
+		repo.On("FindPending", mock.Anything).Return([]schemas.OutboxEvent{row1, row2}, nil).Once()
+		publisher.On("Publish", event1).Return(errors.New("payload rejected")).Once()
+		publisher.On("Publish", event2).Return(nil).Once()
+		repo.On("Update", mock.MatchedBy(func(e schemas.OutboxEvent) bool {
+			return e.ID == 1 && e.Attempts == 3 && e.PublishedAt == nil &&
+				e.DeadAt != nil && e.DeadAt.Equal(now) && e.LastError == "payload rejected"
+		})).Return(nil).Once()
+		repo.On("Update", mock.MatchedBy(func(e schemas.OutboxEvent) bool {
+			return e.ID == 2 && e.PublishedAt != nil && e.DeadAt == nil
+		})).Return(nil).Once()
+		repo.On("DeletePublishedBefore", mock.Anything).Return(nil).Once()
+
+		err := relay.RunDue(now)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		publisher.AssertExpectations(t)
+	})
+
+	t.Run("ShouldWaitForTheBackoff", func(t *testing.T) {
+		relay, repo, first, _ := setupRelayTest()
+		row, _ := outboxEvent(1, "evt-1", now.Add(time.Minute))
+
+		repo.On("FindPending", mock.Anything).Return([]schemas.OutboxEvent{row}, nil).Once()
+		repo.On("DeletePublishedBefore", mock.Anything).Return(nil).Once()
+
+		err := relay.RunDue(now)
+
+		assert.NoError(t, err)
+		first.AssertNotCalled(t, "Publish", mock.Anything)
+		repo.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("ShouldReturnStorageErrors", func(t *testing.T) {
+		relay, repo, _, _ := setupRelayTest()
+
+		repo.On("FindPending", mock.Anything).Return([]schemas.OutboxEvent(nil), gorm.ErrInvalidDB).Once()
+
+		assert.ErrorIs(t, relay.RunDue(now), gorm.ErrInvalidDB)
+	})
+}
+
+func TestOutboxPolicyBackoff(t *testing.T) {
+	t.Run("ShouldDoubleTheDelayUpToTheMaximum", func(t *testing.T) {
+		assert.Equal(t, time.Second, policy.Backoff(1))
+		assert.Equal(t, 4*time.Second, policy.Backoff(3))
+		assert.Equal(t, time.Minute, policy.Backoff(10))
+	})
+}
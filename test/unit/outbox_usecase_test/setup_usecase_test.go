@@ -0,0 +1,44 @@
+package outbox_usecase_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/outbox_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+var policy = schemas.OutboxPolicy{
+	BaseDelay: time.Second,
+	MaxDelay:  time.Minute,
+	Retention: 24 * time.Hour,
+}
+
+func setupRelayTest() (*outbox_usecase.Relay, *mocks.OutboxRepositoryMock, *mocks.EventPublisherMock, *mocks.EventPublisherMock) {
+	repo := new(mocks.OutboxRepositoryMock)
+	first := new(mocks.EventPublisherMock)
+	second := new(mocks.EventPublisherMock)
+	relay := outbox_usecase.NewRelay(repo, policy, first, second)
+
+	return relay, repo, first, second
+}
+
+func outboxEvent(id uint, eventID string, nextAttemptAt time.Time) (schemas.OutboxEvent, schemas.OpeningEvent) {
+	event := schemas.OpeningEvent{
+		ID:         eventID,
+		Type:       schemas.OpeningCreatedEvent,
+		OccurredAt: nextAttemptAt.UTC(),
+		Opening:    schemas.Opening{Model: gorm.Model{ID: id}, Role: "Golang Developer"},
+	}
+	payload, _ := json.Marshal(event)
+
+	return schemas.OutboxEvent{
+		Model:         gorm.Model{ID: id},
+		EventID:       eventID,
+		Type:          event.Type,
+		Payload:       string(payload),
+		NextAttemptAt: nextAttemptAt,
+	}, event
+}
@@ -0,0 +1,99 @@
+package pipeline_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+func TestDefinePipelineUsecase(t *testing.T) {
+	var openingID uint = 3
+	opening := schemas.Opening{Model: gorm.Model{ID: openingID}, Company: "Tech Corp"}
+
+	t.Run("ShouldSaveTheOpeningPipeline", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, openingRepo, applicationRepo := setupUsecaseTest()
+		request := schemas.DefinePipelineRequest{Stages: []string{"applied", "tech-test", "hired", "rejected"}}
+
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		applicationRepo.On("FindStagesInUse", openingID, "").Return([]string{"applied"}, nil).Once()
+		pipelineRepo.On("Save", schemas.Pipeline{OpeningID: openingID, Stages: "applied,tech-test,hired,rejected"}).Return(nil).Once()
+
+		err := pipelineUsecase.DefineForOpening(openingID, request)
+
+		assert.Nil(t, err)
+		pipelineRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldSaveTheCompanyPipeline", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, _, applicationRepo := setupUsecaseTest()
+		request := schemas.DefinePipelineRequest{Stages: []string{"applied", "hired"}}
+
+		applicationRepo.On("FindStagesInUse", uint(0), "Tech Corp").Return([]string{}, nil).Once()
+		pipelineRepo.On("Save", schemas.Pipeline{Company: "Tech Corp", Stages: "applied,hired"}).Return(nil).Once()
+
+		err := pipelineUsecase.DefineForCompany(" Tech Corp ", request)
+
+		assert.Nil(t, err)
+		pipelineRepo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnAnErrorIfTheOpeningDoesNotExist", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, openingRepo, _ := setupUsecaseTest()
+
+		openingRepo.On("FindByID", openingID).Return((*schemas.Opening)(nil), gorm.ErrRecordNotFound).Once()
+
+		err := pipelineUsecase.DefineForOpening(openingID, schemas.DefinePipelineRequest{Stages: []string{"applied", "hired"}})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "opening not found", err.Message)
+		pipelineRepo.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	t.Run("ShouldRejectInvalidPipelines", func(t *testing.T) {
+		tests := []struct {
+			stages  []string
+			message string
+		}{
+			{[]string{"applied"}, "a pipeline must have at least 2 stages"},
+			{[]string{"applied", "Tech Test"}, `invalid stage name: "Tech Test"`},
+			{[]string{"applied", "offer", "applied"}, "duplicated stage: applied"},
+			{[]string{"hired", "applied"}, "a pipeline cannot start in a terminal stage"},
+		}
+
+		for _, tt := range tests {
+			pipelineUsecase, pipelineRepo, _, _ := setupUsecaseTest()
+
+			err := pipelineUsecase.DefineForCompany("Tech Corp", schemas.DefinePipelineRequest{Stages: tt.stages})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.message, err.Message)
+			assert.Equal(t, "bad_request", err.Err)
+			pipelineRepo.AssertNotCalled(t, "Save", mock.Anything)
+		}
+	})
+
+	t.Run("ShouldNotRemoveStagesInUse", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, openingRepo, applicationRepo := setupUsecaseTest()
+
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		applicationRepo.On("FindStagesInUse", openingID, "").Return([]string{"applied", "screening"}, nil).Once()
+
+		err := pipelineUsecase.DefineForOpening(openingID, schemas.DefinePipelineRequest{Stages: []string{"applied", "hired"}})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "stage screening is in use by applications and cannot be removed", err.Message)
+		pipelineRepo.AssertNotCalled(t, "Save", mock.Anything)
+	})
+
+	t.Run("ShouldRequireACompany", func(t *testing.T) {
+		pipelineUsecase, _, _, _ := setupUsecaseTest()
+
+		err := pipelineUsecase.DefineForCompany(" ", schemas.DefinePipelineRequest{Stages: []string{"applied", "hired"}})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "param: company (type: string) is required", err.Message)
+	})
+}
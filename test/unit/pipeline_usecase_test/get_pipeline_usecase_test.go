@@ -0,0 +1,70 @@
+package pipeline_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+func TestGetPipelineUsecase(t *testing.T) {
+	var openingID uint = 3
+	opening := schemas.Opening{Model: gorm.Model{ID: openingID}, Company: "Tech Corp"}
+
+	t.Run("ShouldPreferTheOpeningPipeline", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, openingRepo, _ := setupUsecaseTest()
+
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		pipelineRepo.On("FindByOpeningID", openingID).Return(&schemas.Pipeline{Stages: "applied,hired"}, nil).Once()
+
+		pipeline, err := pipelineUsecase.GetForOpening(openingID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, schemas.PipelineSourceOpening, pipeline.Source)
+		assert.Equal(t, []string{"applied", "hired"}, pipeline.Stages)
+		pipelineRepo.AssertNotCalled(t, "FindByCompany", "Tech Corp")
+	})
+
+	t.Run("ShouldFallBackToTheCompanyPipeline", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, openingRepo, _ := setupUsecaseTest()
+
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		pipelineRepo.On("FindByOpeningID", openingID).Return((*schemas.Pipeline)(nil), gorm.ErrRecordNotFound).Once()
+		pipelineRepo.On("FindByCompany", "Tech Corp").Return(&schemas.Pipeline{Stages: "applied,offer,hired"}, nil).Once()
+
+		pipeline, err := pipelineUsecase.GetForOpening(openingID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, schemas.PipelineSourceCompany, pipeline.Source)
+		assert.Equal(t, "Tech Corp", pipeline.Company)
+		assert.Equal(t, []string{"applied", "offer", "hired"}, pipeline.Stages)
+	})
+
+	t.Run("ShouldFallBackToTheDefaultPipeline", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, openingRepo, _ := setupUsecaseTest()
+
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		pipelineRepo.On("FindByOpeningID", openingID).Return((*schemas.Pipeline)(nil), gorm.ErrRecordNotFound).Once()
+		pipelineRepo.On("FindByCompany", "Tech Corp").Return((*schemas.Pipeline)(nil), gorm.ErrRecordNotFound).Once()
+
+		pipeline, err := pipelineUsecase.GetForOpening(openingID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, schemas.PipelineSourceDefault, pipeline.Source)
+		assert.Equal(t, schemas.DefaultPipelineStages, pipeline.Stages)
+	})
+
+	t.Run("ShouldCountEveryStage", func(t *testing.T) {
+		pipelineUsecase, pipelineRepo, openingRepo, applicationRepo := setupUsecaseTest()
+
+		openingRepo.On("FindByID", openingID).Return(&opening, nil).Once()
+		pipelineRepo.On("FindByOpeningID", openingID).Return(&schemas.Pipeline{Stages: "applied,interview,hired"}, nil).Once()
+		applicationRepo.On("CountByStage", openingID).Return(map[string]int64{"applied": 2, "hired": 1}, nil).Once()
+
+		counts, err := pipelineUsecase.StageCounts(openingID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"applied": 2, "interview": 0, "hired": 1}, counts)
+	})
+}
@@ -0,0 +1,15 @@
+package pipeline_usecase_test
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/pipeline_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func setupUsecaseTest() (*pipeline_usecase.PipelineUseCase, *mocks.PipelineRepositoryMock, *mocks.OpeningRepositoryMock, *mocks.ApplicationRepositoryMock) {
+	repo := new(mocks.PipelineRepositoryMock)
+	openingRepo := new(mocks.OpeningRepositoryMock)
+	applicationRepo := new(mocks.ApplicationRepositoryMock)
+	uc := pipeline_usecase.NewPipelineUseCase(repo, openingRepo, applicationRepo)
+
+	return uc, repo, openingRepo, applicationRepo
+}
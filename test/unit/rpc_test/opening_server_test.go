@@ -0,0 +1,171 @@
+package rpc_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	openingv1 "github.com/valdir-alves3000/go-opportunities/api/opening/v1"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+func TestOpeningServer(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	opening := &schemas.Opening{
+		Model:       gorm.Model{ID: 4, CreatedAt: createdAt, UpdatedAt: createdAt},
+		Role:        "Go Developer",
+		Company:     "Acme",
+		Location:    "Lisbon",
+		Remote:      true,
+		Link:        "http://example.com/go",
+		Salary:      9000,
+		StageCounts: map[string]int64{"applied": 2},
+	}
+
+	t.Run("ShouldCreateAnOpening", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		remote := true
+		useCase.On("Create", schemas.CreateOpeningRequest{
+			Role: "Go Developer", Company: "Acme", Location: "Lisbon", Remote: &remote, Link: "http://example.com/go", Salary: 9000,
+		}).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		resp, err := client.CreateOpening(ctx, &openingv1.CreateOpeningRequest{
+			Role: "Go Developer", Company: "Acme", Location: "Lisbon", Remote: proto.Bool(true), Link: "http://example.com/go", Salary: 9000,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), resp.GetId())
+		assert.Equal(t, createdAt, resp.GetCreatedAt().AsTime())
+		assert.Equal(t, "open", resp.GetStatus())
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldMapValidationErrorsToInvalidArgument", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		useCase.On("Create", schemas.CreateOpeningRequest{Role: "Go Developer"}).
+			Return((*schemas.Opening)(nil), internal_error.NewBadRequestError("param: company (type: string) is required")).Once()
+
+		_, err := client.CreateOpening(ctx, &openingv1.CreateOpeningRequest{Role: "Go Developer"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, "param: company (type: string) is required", status.Convert(err).Message())
+	})
+
+	t.Run("ShouldGetAnOpening", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		useCase.On("GetByID", uint(4)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		resp, err := client.GetOpening(ctx, &openingv1.GetOpeningRequest{Id: 4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Acme", resp.GetCompany())
+		assert.Equal(t, map[string]int64{"applied": 2}, resp.GetStageCounts())
+	})
+
+	t.Run("ShouldMapErrorKindsToStatusCodes", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		useCase.On("GetByID", uint(5)).Return((*schemas.Opening)(nil), internal_error.NewNotFoundError("opening not found")).Once()
+		useCase.On("DeleteByID", uint(6), (*schemas.Precondition)(nil)).Return(internal_error.NewInternalServerError("error deleting opening")).Once()
+
+		_, err := client.GetOpening(ctx, &openingv1.GetOpeningRequest{Id: 5})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = client.DeleteOpening(ctx, &openingv1.DeleteOpeningRequest{Id: 6})
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		_, err = client.GetOpening(ctx, &openingv1.GetOpeningRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ShouldUpdateAnOpeningAndReturnIt", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		useCase.On("Update", uint(4), (*schemas.Precondition)(nil)).Return((*internal_error.InternalError)(nil)).Once()
+		useCase.On("GetByID", uint(4)).Return(opening, (*internal_error.InternalError)(nil)).Once()
+
+		resp, err := client.UpdateOpening(ctx, &openingv1.UpdateOpeningRequest{Id: 4, Salary: 9000})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9000), resp.GetSalary())
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldDeleteAnOpening", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		useCase.On("DeleteByID", uint(4), (*schemas.Precondition)(nil)).Return((*internal_error.InternalError)(nil)).Once()
+
+		_, err := client.DeleteOpening(ctx, &openingv1.DeleteOpeningRequest{Id: 4})
+
+		assert.NoError(t, err)
+		useCase.AssertExpectations(t)
+	})
+
+	receiveAll := func(t *testing.T, stream openingv1.OpeningService_ListOpeningsClient) ([]uint64, error) {
+		var ids []uint64
+		for {
+			opening, err := stream.Recv()
+			if err == io.EOF {
+				return ids, nil
+			}
+			if err != nil {
+				return ids, err
+			}
+			ids = append(ids, opening.GetId())
+		}
+	}
+
+	t.Run("ShouldStreamEveryPageWhenNoPageIsGiven", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		openings := mocks.GenerateListOpenings(15)
+		filter := schemas.OpeningFilter{Company: "Acme", Remote: proto.Bool(true)}
+		useCase.On("ListOpenings", filter, 1).Return(openings[:10], (*internal_error.InternalError)(nil)).Once()
+		useCase.On("ListOpenings", filter, 2).Return(openings[10:], (*internal_error.InternalError)(nil)).Once()
+		useCase.On("ListOpenings", filter, 3).Return([]schemas.Opening{}, internal_error.NewNotFoundError("opening record not found")).Once()
+
+		stream, err := client.ListOpenings(ctx, &openingv1.ListOpeningsRequest{
+			Filter: &openingv1.OpeningFilter{Company: "Acme", Remote: proto.Bool(true)},
+		})
+		assert.NoError(t, err)
+
+		ids, err := receiveAll(t, stream)
+		assert.NoError(t, err)
+		assert.Len(t, ids, 15)
+		assert.Equal(t, uint64(1), ids[0])
+		assert.Equal(t, uint64(15), ids[14])
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldStreamOnlyTheRequestedPage", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		openings := mocks.GenerateListOpenings(15)
+		useCase.On("ListOpenings", schemas.OpeningFilter{}, 2).Return(openings[10:], (*internal_error.InternalError)(nil)).Once()
+
+		stream, err := client.ListOpenings(ctx, &openingv1.ListOpeningsRequest{Page: 2})
+		assert.NoError(t, err)
+
+		ids, err := receiveAll(t, stream)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{11, 12, 13, 14, 15}, ids)
+		useCase.AssertExpectations(t)
+	})
+
+	t.Run("ShouldEndTheStreamWithTheListingError", func(t *testing.T) {
+		client, useCase := setupServerTest(t)
+		useCase.On("ListOpenings", schemas.OpeningFilter{Status: "archived"}, 1).
+			Return([]schemas.Opening{}, internal_error.NewBadRequestError("status must be one of: open, closed")).Once()
+
+		stream, err := client.ListOpenings(ctx, &openingv1.ListOpeningsRequest{Filter: &openingv1.OpeningFilter{Status: "archived"}})
+		assert.NoError(t, err)
+
+		_, err = receiveAll(t, stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
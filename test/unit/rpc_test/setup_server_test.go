@@ -0,0 +1,37 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	openingv1 "github.com/valdir-alves3000/go-opportunities/api/opening/v1"
+	"github.com/valdir-alves3000/go-opportunities/internal/rpc"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// setupServerTest serves the opening service over an in-memory listener
+// and returns a client connected to it.
+func setupServerTest(t *testing.T) (openingv1.OpeningServiceClient, *mocks.OpeningUseCaseMock) {
+	useCase := new(mocks.OpeningUseCaseMock)
+	listener := bufconn.Listen(1 << 20)
+	server := rpc.NewServer(useCase)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return openingv1.NewOpeningServiceClient(conn), useCase
+}
@@ -0,0 +1,76 @@
+package safehttp_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/safehttp"
+)
+
+func TestBlocked(t *testing.T) {
+	t.Run("ShouldBlockInternalAddresses", func(t *testing.T) {
+		for _, ip := range []string{
+			"127.0.0.1", "::1", "10.1.2.3", "172.16.0.1", "192.168.1.1",
+			"169.254.169.254", "fe80::1", "fc00::1", "0.0.0.0", "::", "224.0.0.1",
+		} {
+			assert.True(t, safehttp.Blocked(net.ParseIP(ip)), ip)
+		}
+	})
+
+	t.Run("ShouldAllowPublicAddresses", func(t *testing.T) {
+		for _, ip := range []string{"8.8.8.8", "1.1.1.1", "2606:4700:4700::1111"} {
+			assert.False(t, safehttp.Blocked(net.ParseIP(ip)), ip)
+		}
+	})
+}
+
+func TestNewClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/start" {
+			http.Redirect(w, r, "/end", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Run("ShouldRefuseToConnectToABlockedAddress", func(t *testing.T) {
+		client := safehttp.NewClient(safehttp.Options{Timeout: time.Second})
+
+		_, err := client.Get(server.URL)
+
+		assert.ErrorIs(t, err, safehttp.ErrBlockedAddress)
+	})
+
+	t.Run("ShouldRefuseANameThatResolvesToABlockedAddress", func(t *testing.T) {
+		_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+		client := safehttp.NewClient(safehttp.Options{Timeout: time.Second})
+
+		_, err := client.Get("http://localhost:" + port)
+
+		assert.ErrorIs(t, err, safehttp.ErrBlockedAddress)
+	})
+
+	t.Run("ShouldReturnRedirectsUnlessToldToFollowThem", func(t *testing.T) {
+		client := safehttp.NewClient(safehttp.Options{Timeout: time.Second, AllowPrivate: true})
+
+		resp, err := client.Get(server.URL + "/start")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		resp.Body.Close()
+	})
+
+	t.Run("ShouldFollowRedirectsWhenAsked", func(t *testing.T) {
+		client := safehttp.NewClient(safehttp.Options{Timeout: time.Second, FollowRedirects: true, AllowPrivate: true})
+
+		resp, err := client.Get(server.URL + "/start")
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "/end", resp.Request.URL.Path)
+		resp.Body.Close()
+	})
+}
@@ -0,0 +1,124 @@
+package schemas_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+// requiredJobPostingProperties are the JobPosting properties search engines
+// refuse to index without.
+var requiredJobPostingProperties = []string{"@context", "@type", "title", "description", "datePosted", "hiringOrganization"}
+
+func TestJobPosting(t *testing.T) {
+	createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC)
+	opening := schemas.Opening{
+		Model:    gorm.Model{ID: 42, CreatedAt: createdAt, UpdatedAt: updatedAt},
+		Role:     "Go Developer",
+		Company:  "Acme",
+		Location: "Lisbon",
+		Remote:   true,
+		Link:     "http://acme.example/jobs/42",
+		Salary:   9000,
+		Status:   schemas.OpeningStatusOpen,
+	}
+
+	toMap := func(t *testing.T, posting schemas.JobPosting) map[string]interface{} {
+		body, err := json.Marshal(posting)
+		assert.NoError(t, err)
+		var doc map[string]interface{}
+		assert.NoError(t, json.Unmarshal(body, &doc))
+		return doc
+	}
+
+	t.Run("ShouldMapAnOpeningWithEveryRequiredProperty", func(t *testing.T) {
+		posting := schemas.NewJobPosting(&opening, schemas.DefaultJobPostingPolicy)
+		doc := toMap(t, posting)
+
+		assert.NoError(t, posting.Validate())
+		for _, property := range requiredJobPostingProperties {
+			assert.NotEmpty(t, doc[property], property)
+		}
+		assert.Equal(t, "https://schema.org/", doc["@context"])
+		assert.Equal(t, "JobPosting", doc["@type"])
+		assert.Equal(t, "Go Developer", doc["title"])
+		assert.Equal(t, "2026-10-01", doc["datePosted"])
+		assert.Equal(t, "2026-11-04T12:00:00Z", doc["validThrough"])
+		assert.Equal(t, "TELECOMMUTE", doc["jobLocationType"])
+		assert.Equal(t, "http://acme.example/jobs/42", doc["url"])
+		assert.Equal(t, map[string]interface{}{"@type": "Organization", "name": "Acme"}, doc["hiringOrganization"])
+		assert.Equal(t, map[string]interface{}{"@type": "PropertyValue", "name": "Acme", "value": "42"}, doc["identifier"])
+		assert.Equal(t, map[string]interface{}{
+			"@type":   "Place",
+			"address": map[string]interface{}{"@type": "PostalAddress", "addressLocality": "Lisbon"},
+		}, doc["jobLocation"])
+		assert.Equal(t, map[string]interface{}{
+			"@type":    "MonetaryAmount",
+			"currency": "BRL",
+			"value":    map[string]interface{}{"@type": "QuantitativeValue", "value": float64(9000), "unitText": "MONTH"},
+		}, doc["baseSalary"])
+	})
+
+	t.Run("ShouldUseThePolicyForSalaryAndValidity", func(t *testing.T) {
+		posting := schemas.NewJobPosting(&opening, schemas.JobPostingPolicy{Validity: 24 * time.Hour, Currency: "EUR", SalaryUnit: "YEAR"})
+
+		assert.Equal(t, "2026-10-06T12:00:00Z", posting.ValidThrough)
+		assert.Equal(t, "EUR", posting.BaseSalary.Currency)
+		assert.Equal(t, "YEAR", posting.BaseSalary.Value.UnitText)
+	})
+
+	t.Run("ShouldLeaveOutTelecommuteForOnSiteOpenings", func(t *testing.T) {
+		onSite := opening
+		onSite.Remote = false
+
+		doc := toMap(t, schemas.NewJobPosting(&onSite, schemas.DefaultJobPostingPolicy))
+
+		assert.NotContains(t, doc, "jobLocationType")
+		assert.Contains(t, doc, "jobLocation")
+	})
+
+	t.Run("ShouldEndAClosedOpeningWhenItWasClosed", func(t *testing.T) {
+		closed := opening
+		closed.Status = schemas.OpeningStatusClosed
+
+		posting := schemas.NewJobPosting(&closed, schemas.DefaultJobPostingPolicy)
+
+		assert.Equal(t, "2026-10-05T12:00:00Z", posting.ValidThrough)
+	})
+
+	t.Run("ShouldAcceptARemoteOpeningWithoutLocation", func(t *testing.T) {
+		remote := opening
+		remote.Location = ""
+
+		posting := schemas.NewJobPosting(&remote, schemas.DefaultJobPostingPolicy)
+
+		assert.Nil(t, posting.JobLocation)
+		assert.NoError(t, posting.Validate())
+	})
+
+	t.Run("ShouldReportMissingRequiredProperties", func(t *testing.T) {
+		incomplete := opening
+		incomplete.Role = ""
+		incomplete.Company = " "
+		incomplete.Location = ""
+		incomplete.Remote = false
+
+		err := schemas.NewJobPosting(&incomplete, schemas.DefaultJobPostingPolicy).Validate()
+
+		assert.EqualError(t, err, "job posting is missing title, hiringOrganization, jobLocation")
+	})
+
+	t.Run("ShouldLeaveOutTheSalaryWhenItIsUnknown", func(t *testing.T) {
+		unpaid := opening
+		unpaid.Salary = 0
+
+		doc := toMap(t, schemas.NewJobPosting(&unpaid, schemas.DefaultJobPostingPolicy))
+
+		assert.NotContains(t, doc, "baseSalary")
+	})
+}
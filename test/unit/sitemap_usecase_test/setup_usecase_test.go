@@ -0,0 +1,13 @@
+package sitemap_usecase_test
+
+import (
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/sitemap_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+func setupUsecaseTest(pageSize int) (*sitemap_usecase.SitemapUseCase, *mocks.OpeningRepositoryMock) {
+	repo := new(mocks.OpeningRepositoryMock)
+	uc := sitemap_usecase.NewSitemapUseCase(repo, "https://jobs.example/api/v1/", pageSize)
+
+	return uc, repo
+}
@@ -0,0 +1,138 @@
+package sitemap_usecase_test
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/internal_error"
+	"gorm.io/gorm"
+)
+
+type urlSet struct {
+	URLs []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+type sitemapIndex struct {
+	Sitemaps []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"sitemap"`
+}
+
+func TestSitemapUsecase(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	openings := []schemas.Opening{
+		{Model: gorm.Model{ID: 1, UpdatedAt: day}},
+		{Model: gorm.Model{ID: 2, UpdatedAt: day.Add(48 * time.Hour)}},
+		{Model: gorm.Model{ID: 5, UpdatedAt: day.Add(24 * time.Hour)}},
+	}
+
+	t.Run("ShouldSplitTheOpeningsIntoPages", func(t *testing.T) {
+		uc, repo := setupUsecaseTest(2)
+		repo.On("FindAfter", schemas.OpeningFilter{}, uint(0), 1000).Return(openings, nil).Once()
+
+		index, err := uc.Index()
+		assert.Nil(t, err)
+
+		var doc sitemapIndex
+		assert.NoError(t, xml.Unmarshal(index.Body, &doc))
+		assert.Len(t, doc.Sitemaps, 2)
+		assert.Equal(t, "https://jobs.example/sitemaps/openings-1.xml", doc.Sitemaps[0].Loc)
+		assert.Equal(t, "2026-10-03T00:00:00Z", doc.Sitemaps[0].LastMod)
+		assert.Equal(t, "https://jobs.example/sitemaps/openings-2.xml", doc.Sitemaps[1].Loc)
+		assert.Equal(t, "2026-10-02T00:00:00Z", doc.Sitemaps[1].LastMod)
+		assert.Equal(t, day.Add(48*time.Hour), index.LastModified)
+		assert.Contains(t, string(index.Body), `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+
+		page, err := uc.Page(2)
+		assert.Nil(t, err)
+
+		var set urlSet
+		assert.NoError(t, xml.Unmarshal(page.Body, &set))
+		assert.Len(t, set.URLs, 1)
+		assert.Equal(t, "https://jobs.example/api/v1/openings/5", set.URLs[0].Loc)
+		assert.Equal(t, "2026-10-02T00:00:00Z", set.URLs[0].LastMod)
+
+		// Both answers came from a single build.
+		repo.AssertNumberOfCalls(t, "FindAfter", 1)
+	})
+
+	t.Run("ShouldRebuildOnlyAfterNotify", func(t *testing.T) {
+		uc, repo := setupUsecaseTest(10)
+		repo.On("FindAfter", schemas.OpeningFilter{}, uint(0), 1000).Return(openings, nil).Once()
+		repo.On("FindAfter", schemas.OpeningFilter{}, uint(0), 1000).Return(openings[:2], nil).Once()
+
+		first, _ := uc.Page(1)
+		cached, _ := uc.Page(1)
+		assert.Same(t, first, cached)
+
+		uc.Notify()
+		rebuilt, err := uc.Page(1)
+
+		assert.Nil(t, err)
+		assert.NotEqual(t, first.ETag, rebuilt.ETag)
+		assert.NotContains(t, string(rebuilt.Body), "/openings/5<")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldServeAnEmptyPageWithoutOpenings", func(t *testing.T) {
+		uc, repo := setupUsecaseTest(10)
+		repo.On("FindAfter", schemas.OpeningFilter{}, uint(0), 1000).Return([]schemas.Opening{}, nil).Once()
+
+		index, err := uc.Index()
+		assert.Nil(t, err)
+
+		var doc sitemapIndex
+		assert.NoError(t, xml.Unmarshal(index.Body, &doc))
+		assert.Len(t, doc.Sitemaps, 1)
+		assert.Empty(t, doc.Sitemaps[0].LastMod)
+
+		_, err = uc.Page(2)
+		assert.Equal(t, internal_error.NewNotFoundError("sitemap not found"), err)
+	})
+
+	t.Run("ShouldReadTheOpeningsInBatches", func(t *testing.T) {
+		uc, repo := setupUsecaseTest(0)
+		batch := make([]schemas.Opening, 1000)
+		for i := range batch {
+			batch[i] = schemas.Opening{Model: gorm.Model{ID: uint(i + 1), UpdatedAt: day}}
+		}
+		repo.On("FindAfter", schemas.OpeningFilter{}, uint(0), 1000).Return(batch, nil).Once()
+		repo.On("FindAfter", schemas.OpeningFilter{}, uint(1000), 1000).Return(openings[:0], nil).Once()
+
+		page, err := uc.Page(1)
+
+		assert.Nil(t, err)
+		var set urlSet
+		assert.NoError(t, xml.Unmarshal(page.Body, &set))
+		assert.Len(t, set.URLs, 1000)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnInternalErrorWhenTheRepositoryFails", func(t *testing.T) {
+		uc, repo := setupUsecaseTest(10)
+		repo.On("FindAfter", schemas.OpeningFilter{}, uint(0), 1000).Return([]schemas.Opening(nil), errors.New("db down")).Once()
+
+		_, err := uc.Index()
+
+		assert.Equal(t, internal_error.NewInternalServerError("error finding openings"), err)
+	})
+
+	t.Run("ShouldPointRobotsToTheSitemap", func(t *testing.T) {
+		uc, _ := setupUsecaseTest(10)
+
+		assert.Equal(t, "User-agent: *\n"+
+			"Disallow: /api/v1/applications/\n"+
+			"Disallow: /api/v1/candidates/\n"+
+			"Disallow: /api/v1/files/\n"+
+			"\n"+
+			"Sitemap: https://jobs.example/sitemap.xml\n", string(uc.Robots()))
+	})
+}
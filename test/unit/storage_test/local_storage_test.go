@@ -0,0 +1,67 @@
+package storage_test
+
+import (
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valdir-alves3000/go-opportunities/internal/storage"
+)
+
+func TestLocalStorage(t *testing.T) {
+	newStorage := func(t *testing.T) *storage.LocalStorage {
+		s, err := storage.NewLocalStorage(t.TempDir(), "http://localhost:8080/api/v1/", []byte("secret"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	t.Run("ShouldStoreReadAndDeleteAnObject", func(t *testing.T) {
+		s := newStorage(t)
+
+		err := s.Put("resumes/cv.pdf", strings.NewReader("%PDF-1.7"), 8, "application/pdf")
+		assert.NoError(t, err)
+
+		file, err := s.Get("resumes/cv.pdf")
+		assert.NoError(t, err)
+		content, _ := io.ReadAll(file)
+		file.Close()
+		assert.Equal(t, "%PDF-1.7", string(content))
+
+		assert.NoError(t, s.Delete("resumes/cv.pdf"))
+		_, err = s.Get("resumes/cv.pdf")
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+
+	t.Run("ShouldRejectKeysEscapingTheStorageDirectory", func(t *testing.T) {
+		s := newStorage(t)
+
+		err := s.Put("../outside.pdf", strings.NewReader("x"), 1, "application/pdf")
+		assert.Error(t, err)
+
+		_, err = s.SignedURL("/etc/passwd", time.Minute)
+		assert.Error(t, err)
+	})
+
+	t.Run("ShouldIssueSignedURLsThatExpire", func(t *testing.T) {
+		s := newStorage(t)
+
+		signed, err := s.SignedURL("resumes/cv.pdf", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(signed, "http://localhost:8080/api/v1/files/resumes/cv.pdf?"))
+
+		u, _ := url.Parse(signed)
+		expires, _ := strconv.ParseInt(u.Query().Get("expires"), 10, 64)
+		signature := u.Query().Get("signature")
+
+		assert.True(t, s.Verify("resumes/cv.pdf", expires, signature))
+		assert.False(t, s.Verify("resumes/other.pdf", expires, signature))
+		assert.False(t, s.Verify("resumes/cv.pdf", expires+60, signature))
+		assert.False(t, s.Verify("resumes/cv.pdf", time.Now().Add(-time.Second).Unix(), signature))
+	})
+}
@@ -0,0 +1,115 @@
+package webhook_usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+func TestCreateWebhookUsecase(t *testing.T) {
+	t.Run("ShouldCreateAWebhookForTheGivenEvents", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		expected := &schemas.Webhook{
+			URL:    "https://hooks.example.com/openings",
+			Secret: "s3cret",
+			Events: "opening.created,opening.deleted",
+			Active: true,
+		}
+
+		repo.On("Create", expected).Return(nil).Once()
+
+		webhook, err := uc.Create(schemas.CreateWebhookRequest{
+			URL:    " https://hooks.example.com/openings ",
+			Secret: "s3cret",
+			Events: []string{schemas.OpeningCreatedEvent, schemas.OpeningDeletedEvent},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, webhook)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldSubscribeToAllEventsByDefault", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		repo.On("Create", mock.MatchedBy(func(w *schemas.Webhook) bool {
+			return w.Events == schemas.WebhookAllEvents
+		})).Return(nil).Once()
+
+		webhook, err := uc.Create(schemas.CreateWebhookRequest{URL: "http://localhost:9000/hook", Secret: "s3cret"})
+
+		assert.Nil(t, err)
+		assert.True(t, webhook.Accepts(schemas.OpeningUpdatedEvent))
+		repo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name    string
+		request schemas.CreateWebhookRequest
+		message string
+	}{
+		{"ShouldRequireAURL", schemas.CreateWebhookRequest{Secret: "s3cret"}, "param: url (type: string) is required"},
+		{"ShouldRejectARelativeURL", schemas.CreateWebhookRequest{URL: "/hook", Secret: "s3cret"}, "url must be an absolute http or https URL"},
+		{"ShouldRejectOtherSchemes", schemas.CreateWebhookRequest{URL: "ftp://example.com/hook", Secret: "s3cret"}, "url must be an absolute http or https URL"},
+		{"ShouldRequireASecret", schemas.CreateWebhookRequest{URL: "https://example.com/hook"}, "param: secret (type: string) is required"},
+		{
+			"ShouldRejectUnknownEvents",
+			schemas.CreateWebhookRequest{URL: "https://example.com/hook", Secret: "s3cret", Events: []string{"opening.archived"}},
+			`unknown event "opening.archived", must be one of: opening.created, opening.updated, opening.deleted or *`,
+		},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, repo := setupUsecaseTest()
+
+			webhook, err := uc.Create(tc.request)
+
+			assert.Nil(t, webhook)
+			assert.NotNil(t, err)
+			assert.Equal(t, tc.message, err.Message)
+			assert.Equal(t, "bad_request", err.Err)
+			repo.AssertNotCalled(t, "Create", mock.Anything)
+		})
+	}
+
+	t.Run("ShouldReturnAnErrorIfTheDBFails", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		repo.On("Create", mock.Anything).Return(gorm.ErrInvalidDB).Once()
+
+		webhook, err := uc.Create(schemas.CreateWebhookRequest{URL: "https://example.com/hook", Secret: "s3cret"})
+
+		assert.Nil(t, webhook)
+		assert.Equal(t, "error creating webhook", err.Message)
+		assert.Equal(t, "internal_server_error", err.Err)
+	})
+}
+
+func TestDeleteWebhookUsecase(t *testing.T) {
+	t.Run("ShouldDeleteAnExistingWebhook", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		repo.On("FindByID", uint(1)).Return(&schemas.Webhook{}, nil).Once()
+		repo.On("Delete", uint(1)).Return(nil).Once()
+
+		err := uc.Delete(1)
+
+		assert.Nil(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownWebhook", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		repo.On("FindByID", uint(1)).Return((*schemas.Webhook)(nil), gorm.ErrRecordNotFound).Once()
+
+		err := uc.Delete(1)
+
+		assert.Equal(t, "webhook not found", err.Message)
+		assert.Equal(t, "not_found", err.Err)
+		repo.AssertNotCalled(t, "Delete", mock.Anything)
+	})
+}
@@ -0,0 +1,32 @@
+package webhook_usecase_test
+
+import (
+	"time"
+
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/webhook_usecase"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+)
+
+var policy = schemas.WebhookPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Minute,
+	MaxDelay:    10 * time.Minute,
+	Timeout:     time.Second,
+	// The tests call receivers on the loopback interface.
+	AllowPrivateHosts: true,
+}
+
+func setupUsecaseTest() (*webhook_usecase.WebhookUseCase, *mocks.WebhookRepositoryMock) {
+	repo := new(mocks.WebhookRepositoryMock)
+	uc := webhook_usecase.NewWebhookUseCase(repo)
+
+	return uc, repo
+}
+
+func setupDispatcherTest() (*webhook_usecase.Dispatcher, *mocks.WebhookRepositoryMock) {
+	repo := new(mocks.WebhookRepositoryMock)
+	d := webhook_usecase.NewDispatcher(repo, policy)
+
+	return d, repo
+}
@@ -0,0 +1,77 @@
+package webhook_usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"gorm.io/gorm"
+)
+
+func TestWebhookDeliveriesUsecase(t *testing.T) {
+	t.Run("ShouldListTheLatestDeliveries", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		deliveries := []schemas.WebhookDelivery{{Model: gorm.Model{ID: 2}}, {Model: gorm.Model{ID: 1}}}
+
+		repo.On("FindByID", uint(1)).Return(&schemas.Webhook{}, nil).Once()
+		repo.On("FindDeliveries", uint(1), 50).Return(deliveries, nil).Once()
+
+		result, err := uc.Deliveries(1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, deliveries, result)
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownWebhook", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		repo.On("FindByID", uint(1)).Return((*schemas.Webhook)(nil), gorm.ErrRecordNotFound).Once()
+
+		result, err := uc.Deliveries(1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, "webhook not found", err.Message)
+	})
+}
+
+func TestRedeliverWebhookUsecase(t *testing.T) {
+	t.Run("ShouldQueueAFailedDeliveryAgain", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+		delivery := &schemas.WebhookDelivery{
+			Model:     gorm.Model{ID: 7},
+			WebhookID: 1,
+			Status:    schemas.WebhookDeliveryFailed,
+			Attempts:  3,
+			LastError: "unexpected status 500 Internal Server Error",
+		}
+		before := time.Now()
+
+		repo.On("FindByID", uint(1)).Return(&schemas.Webhook{}, nil).Once()
+		repo.On("FindDelivery", uint(1), uint(7)).Return(delivery, nil).Once()
+		repo.On("UpdateDelivery", mock.MatchedBy(func(d schemas.WebhookDelivery) bool {
+			return d.ID == 7 && d.Status == schemas.WebhookDeliveryPending && d.Attempts == 0 &&
+				d.LastError == "" && d.NextAttemptAt != nil && !d.NextAttemptAt.Before(before.UTC().Truncate(time.Second))
+		})).Return(nil).Once()
+
+		result, err := uc.Redeliver(1, 7)
+
+		assert.Nil(t, err)
+		assert.Equal(t, schemas.WebhookDeliveryPending, result.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnNotFoundForAnUnknownDelivery", func(t *testing.T) {
+		uc, repo := setupUsecaseTest()
+
+		repo.On("FindByID", uint(1)).Return(&schemas.Webhook{}, nil).Once()
+		repo.On("FindDelivery", uint(1), uint(7)).Return((*schemas.WebhookDelivery)(nil), gorm.ErrRecordNotFound).Once()
+
+		result, err := uc.Redeliver(1, 7)
+
+		assert.Nil(t, result)
+		assert.Equal(t, "webhook delivery not found", err.Message)
+		repo.AssertNotCalled(t, "UpdateDelivery", mock.Anything)
+	})
+}
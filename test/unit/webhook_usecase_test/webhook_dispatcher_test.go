@@ -0,0 +1,272 @@
+package webhook_usecase_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/schemas"
+	"github.com/valdir-alves3000/go-opportunities/internal/core/usecases/webhook_usecase"
+	"github.com/valdir-alves3000/go-opportunities/internal/safehttp"
+	"github.com/valdir-alves3000/go-opportunities/test/mocks"
+	"gorm.io/gorm"
+)
+
+func TestWebhookPolicyBackoff(t *testing.T) {
+	t.Run("ShouldDoubleTheDelayUpToTheMaximum", func(t *testing.T) {
+		assert.Equal(t, time.Minute, policy.Backoff(1))
+		assert.Equal(t, 2*time.Minute, policy.Backoff(2))
+		assert.Equal(t, 8*time.Minute, policy.Backoff(4))
+		assert.Equal(t, 10*time.Minute, policy.Backoff(5))
+		assert.Equal(t, 10*time.Minute, policy.Backoff(60))
+	})
+}
+
+func TestWebhookDispatcherPublish(t *testing.T) {
+	event := schemas.OpeningEvent{
+		ID:         "evt-1",
+		Type:       schemas.OpeningUpdatedEvent,
+		OccurredAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Opening:    schemas.Opening{Model: gorm.Model{ID: 3}, Role: "Golang Developer"},
+	}
+
+	t.Run("ShouldQueueADeliveryForEverySubscribedWebhook", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		webhooks := []schemas.Webhook{
+			{Model: gorm.Model{ID: 1}, Events: schemas.WebhookAllEvents},
+			{Model: gorm.Model{ID: 2}, Events: "opening.created,opening.deleted"},
+			{Model: gorm.Model{ID: 3}, Events: "opening.created,opening.updated"},
+		}
+		payload, _ := json.Marshal(event)
+
+		repo.On("FindActive").Return(webhooks, nil).Once()
+		repo.On("CreateDeliveries", mock.MatchedBy(func(deliveries []schemas.WebhookDelivery) bool {
+			if len(deliveries) != 2 || deliveries[0].WebhookID != 1 || deliveries[1].WebhookID != 3 {
+				return false
+			}
+			for _, d := range deliveries {
+				if d.EventID != "evt-1" || d.EventType != schemas.OpeningUpdatedEvent || d.Payload != string(payload) ||
+					d.Status != schemas.WebhookDeliveryPending || d.NextAttemptAt == nil {
+					return false
+				}
+			}
+			return true
+		})).Return(nil).Once()
+
+		err := dispatcher.Publish(event)
+
+		assert.NoError(t, err)
+
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestWebhookDispatcherRunDue(t *testing.T) {
+	payload := `{"id":"evt-1","type":"opening.created"}`
+
+	t.Run("ShouldSendASignedRequestAndRecordTheAttempt", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.Write([]byte("thanks"))
+		}))
+		defer server.Close()
+
+		webhook := &schemas.Webhook{Model: gorm.Model{ID: 1}, URL: server.URL, Secret: "s3cret", Events: "*", Active: true}
+		delivery := schemas.WebhookDelivery{Model: gorm.Model{ID: 9}, WebhookID: 1, EventID: "evt-1", EventType: schemas.OpeningCreatedEvent, Payload: payload, Status: schemas.WebhookDeliveryPending}
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindByID", uint(1)).Return(webhook, nil).Once()
+		repo.On("RecordAttempt", mock.MatchedBy(func(d schemas.WebhookDelivery) bool {
+			return d.Status == schemas.WebhookDeliverySucceeded && d.Attempts == 1 && d.NextAttemptAt == nil && d.DeliveredAt != nil
+		}), mock.MatchedBy(func(a schemas.WebhookAttempt) bool {
+			return a.StatusCode == http.StatusOK && a.Error == ""
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		assert.Equal(t, payload, string(body))
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, schemas.OpeningCreatedEvent, received.Header.Get("X-Webhook-Event"))
+		assert.Equal(t, "evt-1", received.Header.Get("X-Webhook-ID"))
+		assert.Equal(t, "9", received.Header.Get("X-Webhook-Delivery"))
+
+		timestamp := received.Header.Get("X-Webhook-Timestamp")
+		_, errParse := strconv.ParseInt(timestamp, 10, 64)
+		assert.NoError(t, errParse)
+		assert.Equal(t, webhook_usecase.Sign("s3cret", timestamp, body), received.Header.Get("X-Webhook-Signature"))
+	})
+
+	t.Run("ShouldRetryWithBackoffWhenTheReceiverFails", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		webhook := &schemas.Webhook{Model: gorm.Model{ID: 1}, URL: server.URL, Secret: "s3cret"}
+		delivery := schemas.WebhookDelivery{Model: gorm.Model{ID: 9}, WebhookID: 1, Payload: payload, Status: schemas.WebhookDeliveryPending, Attempts: 1}
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindByID", uint(1)).Return(webhook, nil).Once()
+		repo.On("RecordAttempt", mock.MatchedBy(func(d schemas.WebhookDelivery) bool {
+			return d.Status == schemas.WebhookDeliveryPending && d.Attempts == 2 &&
+				d.LastError == "unexpected status 500 Internal Server Error" &&
+				d.NextAttemptAt != nil && !d.NextAttemptAt.Before(now.Add(2*time.Minute))
+		}), mock.MatchedBy(func(a schemas.WebhookAttempt) bool {
+			return a.StatusCode == http.StatusInternalServerError
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldGiveUpAfterTheLastAttempt", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		defer server.Close()
+
+		webhook := &schemas.Webhook{Model: gorm.Model{ID: 1}, URL: server.URL, Secret: "s3cret"}
+		delivery := schemas.WebhookDelivery{Model: gorm.Model{ID: 9}, WebhookID: 1, Payload: payload, Status: schemas.WebhookDeliveryPending, Attempts: 2}
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindByID", uint(1)).Return(webhook, nil).Once()
+		repo.On("RecordAttempt", mock.MatchedBy(func(d schemas.WebhookDelivery) bool {
+			return d.Status == schemas.WebhookDeliveryFailed && d.Attempts == 3 && d.NextAttemptAt == nil
+		}), mock.Anything).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldNotFollowRedirects", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		var followed bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/internal" {
+				followed = true
+				return
+			}
+			http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+		}))
+		defer server.Close()
+
+		webhook := &schemas.Webhook{Model: gorm.Model{ID: 1}, URL: server.URL, Secret: "s3cret"}
+		delivery := schemas.WebhookDelivery{Model: gorm.Model{ID: 9}, WebhookID: 1, Payload: payload, Status: schemas.WebhookDeliveryPending}
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindByID", uint(1)).Return(webhook, nil).Once()
+		repo.On("RecordAttempt", mock.MatchedBy(func(d schemas.WebhookDelivery) bool {
+			return d.Status == schemas.WebhookDeliveryPending && d.LastError == "unexpected status 307 Temporary Redirect"
+		}), mock.MatchedBy(func(a schemas.WebhookAttempt) bool {
+			return a.StatusCode == http.StatusTemporaryRedirect
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		assert.False(t, followed)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRefuseToCallAPrivateAddress", func(t *testing.T) {
+		repo := new(mocks.WebhookRepositoryMock)
+		strict := policy
+		strict.AllowPrivateHosts = false
+		dispatcher := webhook_usecase.NewDispatcher(repo, strict)
+		var called bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		webhook := &schemas.Webhook{Model: gorm.Model{ID: 1}, URL: server.URL, Secret: "s3cret"}
+		delivery := schemas.WebhookDelivery{Model: gorm.Model{ID: 9}, WebhookID: 1, Payload: payload, Status: schemas.WebhookDeliveryPending}
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindByID", uint(1)).Return(webhook, nil).Once()
+		repo.On("RecordAttempt", mock.Anything, mock.MatchedBy(func(a schemas.WebhookAttempt) bool {
+			return a.StatusCode == 0 && strings.Contains(a.Error, safehttp.ErrBlockedAddress.Error())
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		assert.False(t, called)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldRecordConnectionErrors", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		webhook := &schemas.Webhook{Model: gorm.Model{ID: 1}, URL: server.URL, Secret: "s3cret"}
+		delivery := schemas.WebhookDelivery{Model: gorm.Model{ID: 9}, WebhookID: 1, Payload: payload, Status: schemas.WebhookDeliveryPending}
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindByID", uint(1)).Return(webhook, nil).Once()
+		repo.On("RecordAttempt", mock.MatchedBy(func(d schemas.WebhookDelivery) bool {
+			return d.Status == schemas.WebhookDeliveryPending && d.Attempts == 1 && d.LastError != ""
+		}), mock.MatchedBy(func(a schemas.WebhookAttempt) bool {
+			return a.StatusCode == 0 && a.Error != ""
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldFailDeliveriesOfDeletedWebhooks", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		delivery := schemas.WebhookDelivery{Model: gorm.Model{ID: 9}, WebhookID: 1, Payload: payload, Status: schemas.WebhookDeliveryPending}
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindByID", uint(1)).Return((*schemas.Webhook)(nil), gorm.ErrRecordNotFound).Once()
+		repo.On("UpdateDelivery", mock.MatchedBy(func(d schemas.WebhookDelivery) bool {
+			return d.Status == schemas.WebhookDeliveryFailed && d.LastError == "webhook was deleted"
+		})).Return(nil).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ShouldReturnStorageErrors", func(t *testing.T) {
+		dispatcher, repo := setupDispatcherTest()
+		now := time.Now()
+
+		repo.On("FindDueDeliveries", now, mock.Anything).Return([]schemas.WebhookDelivery(nil), gorm.ErrInvalidDB).Once()
+
+		err := dispatcher.RunDue(now)
+
+		assert.ErrorIs(t, err, gorm.ErrInvalidDB)
+	})
+}